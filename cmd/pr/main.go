@@ -4,19 +4,29 @@ import (
 	"context"
 	"errors"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	graphqlDelivery "github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/graphql"
+	grpcDelivery "github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/grpc"
 	httpDelivery "github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/jobs"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/metrics"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/service"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/config"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/database"
 )
 
+const (
+	idempotencyPurgeInterval = time.Hour
+	metricsRefreshInterval   = 30 * time.Second
+)
+
 func main() {
 	cfg := config.Load()
 	log.Println("Configuration loaded successfully")
@@ -27,17 +37,42 @@ func main() {
 	store := postgres.NewStore(pool)
 	log.Println("Repository layer initialized")
 
+	appMetrics := metrics.New(pool, store.Stats())
+
 	teamService := service.NewTeamService(store)
 	userService := service.NewUserService(store)
-	prService := service.NewPRService(store)
-	statsService := service.NewStatsService(store.Stats())
+	prService := service.NewPRService(store, appMetrics)
+	statsService := service.NewStatsService(store.Stats(), store, cfg.StalePRAge)
+	idempotencyService := service.NewIdempotencyService(store.Idempotency(), cfg.IdempotencyKeyTTL)
+	readService := service.NewReadService(store)
+	eventService := service.NewEventService(store.Events())
 	log.Println("UseCase layer initialized")
 
-	handler := httpDelivery.NewHandler(teamService, userService, prService, statsService)
+	eventHub := httpDelivery.NewEventHub(eventService, cfg.EventsPollInterval)
+	handler := httpDelivery.NewHandler(teamService, userService, prService, statsService, idempotencyService, eventHub)
 
-	e := httpDelivery.NewRouter(handler)
+	e := httpDelivery.NewRouter(handler, graphqlDelivery.NewHandler(readService), appMetrics)
 	log.Println("HTTP handlers initialized")
 
+	grpcServer := grpcDelivery.NewServer(teamService, userService, prService, statsService)
+	log.Println("gRPC services initialized")
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	if cfg.StalePRCheckInterval > 0 {
+		flagger := jobs.NewStalePRFlagger(prService, cfg.StalePRAge, cfg.StalePRCheckInterval)
+		go flagger.Run(jobsCtx)
+		log.Printf("Stale PR check runs every %s", cfg.StalePRCheckInterval)
+	}
+
+	purger := jobs.NewIdempotencyKeyPurger(idempotencyService, idempotencyPurgeInterval)
+	go purger.Run(jobsCtx)
+
+	go eventHub.Run(jobsCtx)
+
+	go appMetrics.Run(jobsCtx, metricsRefreshInterval)
+
 	port := ":" + cfg.Port
 	go func() {
 		log.Printf("Starting server on %s", port)
@@ -46,11 +81,23 @@ func main() {
 		}
 	}()
 
+	grpcListener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	if err != nil {
+		log.Fatalf("Failed to listen for gRPC: %v", err)
+	}
+	go func() {
+		log.Printf("Starting gRPC server on :%s", cfg.GRPCPort)
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Fatalf("Failed to start gRPC server: %v", err)
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
 	<-quit
 	log.Println("Shutting down server...")
+	stopJobs()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -58,6 +105,7 @@ func main() {
 	if err := e.Shutdown(ctx); err != nil {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
+	grpcServer.GracefulStop()
 
 	log.Println("Server stopped gracefully")
 }
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/snapshot"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/service"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/config"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/database"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	if command != "export" && command != "import" {
+		usage()
+	}
+
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	path := flags.String("file", "-", "snapshot file path, - for stdin/stdout")
+	if err := flags.Parse(os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+
+	cfg := config.Load()
+
+	pool := database.NewConn(cfg.DBURL)
+	defer pool.Close()
+
+	snapshotService := service.NewSnapshotService(postgres.NewStore(pool))
+	ctx := context.Background()
+
+	switch command {
+	case "export":
+		if err := exportSnapshot(ctx, snapshotService, *path); err != nil {
+			log.Fatalf("Export failed: %v", err)
+		}
+		log.Println("Snapshot exported successfully")
+	case "import":
+		if err := importSnapshot(ctx, snapshotService, *path); err != nil {
+			log.Fatalf("Import failed: %v", err)
+		}
+		log.Println("Snapshot imported successfully")
+	}
+}
+
+func exportSnapshot(ctx context.Context, svc *service.SnapshotService, path string) error {
+	data, err := svc.Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("create file: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return snapshot.Write(w, data, time.Now())
+}
+
+func importSnapshot(ctx context.Context, svc *service.SnapshotService, path string) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("open file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	data, err := snapshot.Read(r)
+	if err != nil {
+		return err
+	}
+
+	return svc.Import(ctx, data)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: snapshot <export|import> [-file path]")
+	os.Exit(2)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/service"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/config"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/database"
+)
+
+func main() {
+	if len(os.Args) != 2 || os.Args[1] != "rebuild" {
+		usage()
+	}
+
+	cfg := config.Load()
+
+	pool := database.NewConn(cfg.DBURL)
+	defer pool.Close()
+
+	store := postgres.NewStore(pool)
+	statsService := service.NewStatsService(store.Stats(), store, cfg.StalePRAge)
+
+	if err := statsService.RebuildAggregates(context.Background()); err != nil {
+		log.Fatalf("Rebuild failed: %v", err)
+	}
+	log.Println("Stats aggregates rebuilt successfully")
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: stats rebuild")
+	os.Exit(2)
+}
@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN parent_team_name TEXT REFERENCES teams(team_name) ON DELETE SET NULL,
+    ADD CONSTRAINT teams_parent_not_self CHECK (parent_team_name <> team_name);
+
+CREATE INDEX idx_teams_parent_team_name ON teams(parent_team_name);
+
+-- +goose Down
+DROP INDEX idx_teams_parent_team_name;
+ALTER TABLE teams DROP COLUMN parent_team_name;
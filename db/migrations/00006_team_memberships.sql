@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE team_memberships (
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    role TEXT NOT NULL DEFAULT 'member',
+    PRIMARY KEY (team_name, user_id)
+);
+
+CREATE INDEX idx_team_memberships_user_id ON team_memberships(user_id);
+
+INSERT INTO team_memberships (team_name, user_id)
+SELECT team_name, user_id
+FROM users;
+
+-- +goose Down
+DROP TABLE team_memberships;
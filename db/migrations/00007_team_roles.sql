@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN require_lead_review BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE team_memberships
+    ADD CONSTRAINT team_memberships_role_check CHECK (role IN ('lead', 'member', 'observer'));
+
+CREATE INDEX idx_team_memberships_team_role ON team_memberships(team_name, role);
+
+-- +goose Down
+DROP INDEX idx_team_memberships_team_role;
+ALTER TABLE team_memberships DROP CONSTRAINT team_memberships_role_check;
+ALTER TABLE teams DROP COLUMN require_lead_review;
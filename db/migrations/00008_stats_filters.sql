@@ -0,0 +1,31 @@
+-- +goose Up
+ALTER TABLE pull_requests ADD COLUMN team_name TEXT;
+
+UPDATE pull_requests pr
+SET team_name = u.team_name
+FROM users u
+WHERE u.user_id = pr.author_id;
+
+ALTER TABLE pull_requests
+    ALTER COLUMN team_name SET NOT NULL,
+    ADD CONSTRAINT pull_requests_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(team_name);
+
+ALTER TABLE assigned_reviewers ADD COLUMN assigned_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+UPDATE assigned_reviewers ar
+SET assigned_at = pr.created_at
+FROM pull_requests pr
+WHERE pr.pull_request_id = ar.pr_id;
+
+CREATE INDEX idx_pull_requests_created_at ON pull_requests(created_at);
+CREATE INDEX idx_pull_requests_team_name_created_at ON pull_requests(team_name, created_at);
+CREATE INDEX idx_assigned_reviewers_assigned_at ON assigned_reviewers(assigned_at);
+CREATE INDEX idx_assigned_reviewers_reviewer_id_assigned_at ON assigned_reviewers(reviewer_id, assigned_at);
+
+-- +goose Down
+DROP INDEX idx_assigned_reviewers_reviewer_id_assigned_at;
+DROP INDEX idx_assigned_reviewers_assigned_at;
+DROP INDEX idx_pull_requests_team_name_created_at;
+DROP INDEX idx_pull_requests_created_at;
+ALTER TABLE assigned_reviewers DROP COLUMN assigned_at;
+ALTER TABLE pull_requests DROP COLUMN team_name;
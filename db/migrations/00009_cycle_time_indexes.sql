@@ -0,0 +1,7 @@
+-- +goose Up
+CREATE INDEX idx_pull_requests_merged_at ON pull_requests(merged_at) WHERE status = 'MERGED';
+CREATE INDEX idx_pull_requests_team_name_merged_at ON pull_requests(team_name, merged_at) WHERE status = 'MERGED';
+
+-- +goose Down
+DROP INDEX idx_pull_requests_team_name_merged_at;
+DROP INDEX idx_pull_requests_merged_at;
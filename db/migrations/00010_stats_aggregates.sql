@@ -0,0 +1,54 @@
+-- +goose Up
+CREATE TABLE stats_user_daily (
+    day DATE NOT NULL,
+    reviewer_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    assignments BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, reviewer_id, team_name)
+);
+
+CREATE INDEX idx_stats_user_daily_reviewer_id ON stats_user_daily(reviewer_id, day);
+CREATE INDEX idx_stats_user_daily_team_name ON stats_user_daily(team_name, day);
+
+CREATE TABLE stats_team_daily (
+    day DATE NOT NULL,
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    prs_opened BIGINT NOT NULL DEFAULT 0,
+    prs_opened_merged BIGINT NOT NULL DEFAULT 0,
+    prs_merged BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, team_name)
+);
+
+CREATE INDEX idx_stats_team_daily_team_name ON stats_team_daily(team_name, day);
+
+CREATE TABLE stats_freshness (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    updated_at TIMESTAMP NOT NULL,
+    rebuilt_at TIMESTAMP NOT NULL
+);
+
+INSERT INTO stats_user_daily (day, reviewer_id, team_name, assignments)
+SELECT ar.assigned_at::date, ar.reviewer_id, pr.team_name, COUNT(*)
+FROM assigned_reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+GROUP BY 1, 2, 3;
+
+INSERT INTO stats_team_daily (day, team_name, prs_opened, prs_opened_merged, prs_merged)
+SELECT day, team_name, SUM(opened), SUM(opened_merged), SUM(merged)
+FROM (
+    SELECT created_at::date AS day, team_name, 1 AS opened,
+           CASE WHEN status = 'MERGED' THEN 1 ELSE 0 END AS opened_merged, 0 AS merged
+    FROM pull_requests
+    UNION ALL
+    SELECT merged_at::date, team_name, 0, 0, 1
+    FROM pull_requests
+    WHERE status = 'MERGED' AND merged_at IS NOT NULL
+) events
+GROUP BY day, team_name;
+
+INSERT INTO stats_freshness (updated_at, rebuilt_at) VALUES (NOW(), NOW());
+
+-- +goose Down
+DROP TABLE stats_freshness;
+DROP TABLE stats_team_daily;
+DROP TABLE stats_user_daily;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN stale_after_seconds BIGINT NOT NULL DEFAULT 0 CHECK (stale_after_seconds >= 0);
+
+ALTER TABLE pull_requests
+    ADD COLUMN stale_flagged_at TIMESTAMP;
+
+CREATE INDEX idx_pull_requests_open_created_at ON pull_requests(created_at) WHERE status = 'OPEN';
+
+-- +goose Down
+DROP INDEX idx_pull_requests_open_created_at;
+ALTER TABLE pull_requests DROP COLUMN stale_flagged_at;
+ALTER TABLE teams DROP COLUMN stale_after_seconds;
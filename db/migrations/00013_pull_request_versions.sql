@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE pull_requests
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE pull_requests DROP COLUMN version;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE events (
+    id BIGSERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    team_name TEXT,
+    pull_request_id TEXT,
+    user_id TEXT,
+    previous_user_id TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_events_team_name ON events(team_name, id);
+CREATE INDEX idx_events_user_id ON events(user_id, id);
+CREATE INDEX idx_events_previous_user_id ON events(previous_user_id, id)
+    WHERE previous_user_id IS NOT NULL;
+
+-- +goose Down
+DROP TABLE events;
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE stats_user_daily
+    ADD COLUMN reviews_merged BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN review_seconds DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+INSERT INTO stats_user_daily (day, reviewer_id, team_name, reviews_merged, review_seconds)
+SELECT pr.merged_at::date, ar.reviewer_id, pr.team_name, COUNT(*), SUM(EXTRACT(EPOCH FROM pr.merged_at - ar.assigned_at))
+FROM assigned_reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+WHERE pr.status = 'MERGED' AND pr.merged_at IS NOT NULL
+GROUP BY 1, 2, 3
+ON CONFLICT (day, reviewer_id, team_name)
+DO UPDATE SET reviews_merged = EXCLUDED.reviews_merged,
+              review_seconds = EXCLUDED.review_seconds;
+
+-- +goose Down
+ALTER TABLE stats_user_daily
+    DROP COLUMN review_seconds,
+    DROP COLUMN reviews_merged;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE idempotency_keys
+    ADD COLUMN response_headers JSONB;
+
+-- +goose Down
+ALTER TABLE idempotency_keys
+    DROP COLUMN response_headers;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Every aggregate row records when it last changed, so writes no longer bump
+-- the single stats_freshness row and do not serialise on its lock.
+ALTER TABLE stats_user_daily
+    ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE stats_team_daily
+    ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+CREATE INDEX idx_stats_user_daily_updated_at ON stats_user_daily(updated_at);
+CREATE INDEX idx_stats_team_daily_updated_at ON stats_team_daily(updated_at);
+
+-- +goose Down
+DROP INDEX idx_stats_team_daily_updated_at;
+DROP INDEX idx_stats_user_daily_updated_at;
+
+ALTER TABLE stats_team_daily
+    DROP COLUMN updated_at;
+ALTER TABLE stats_user_daily
+    DROP COLUMN updated_at;
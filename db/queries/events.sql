@@ -0,0 +1,21 @@
+-- name: LockEventLog :exec
+SELECT pg_advisory_xact_lock(hashtext('events'));
+
+-- name: CopyEvents :copyfrom
+INSERT INTO events (type, team_name, pull_request_id, user_id, previous_user_id, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListEvents :many
+SELECT id, type, team_name, pull_request_id, user_id, previous_user_id, created_at
+FROM events
+WHERE id > sqlc.arg('after_id')
+  AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text)
+  AND (sqlc.narg('user_id')::text IS NULL
+       OR user_id = sqlc.narg('user_id')::text
+       OR previous_user_id = sqlc.narg('user_id')::text)
+ORDER BY id
+LIMIT sqlc.arg('max_events');
+
+-- name: GetLastEventID :one
+SELECT COALESCE(MAX(id), 0)::bigint
+FROM events;
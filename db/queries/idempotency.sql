@@ -0,0 +1,34 @@
+-- name: ReserveIdempotencyKey :execrows
+INSERT INTO idempotency_keys (key, request_hash, expires_at)
+VALUES (sqlc.arg('key'), sqlc.arg('request_hash'), NOW()::timestamp + make_interval(secs => sqlc.arg('ttl_seconds')::bigint))
+ON CONFLICT (key) DO UPDATE
+SET request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    content_type = NULL,
+    response_body = NULL,
+    response_headers = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW();
+
+-- name: GetIdempotencyKey :one
+SELECT key, request_hash, status_code, content_type, response_body, created_at, expires_at, response_headers
+FROM idempotency_keys
+WHERE key = $1;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET status_code = sqlc.arg('status_code'),
+    content_type = sqlc.arg('content_type'),
+    response_body = sqlc.arg('response_body'),
+    response_headers = sqlc.arg('response_headers'),
+    expires_at = NOW()::timestamp + make_interval(secs => sqlc.arg('ttl_seconds')::bigint)
+WHERE key = sqlc.arg('key');
+
+-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE key = $1 AND status_code IS NULL;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at <= NOW();
@@ -1,8 +1,17 @@
 -- name: CreatePullRequest :one
-INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status)
-VALUES ($1, $2, $3, 'OPEN')
+INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, team_name, status)
+VALUES ($1, $2, $3, $4, 'OPEN')
 RETURNING *;
 
+-- name: CopyPullRequests :copyfrom
+INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, team_name, status, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListExistingPullRequestIDs :many
+SELECT pull_request_id
+FROM pull_requests
+WHERE pull_request_id = ANY(sqlc.arg('ids')::text[]);
+
 -- name: PRExists :one
 SELECT EXISTS(SELECT 1 FROM pull_requests WHERE pull_request_id = $1);
 
@@ -16,16 +25,54 @@ SELECT *
 FROM pull_requests
 WHERE pull_request_id = $1;
 
+-- name: GetPullRequestForUpdate :one
+SELECT *
+FROM pull_requests
+WHERE pull_request_id = $1
+FOR UPDATE;
+
 -- name: MergePullRequest :one
 UPDATE pull_requests
 SET status = 'MERGED', 
-    merged_at = COALESCE(merged_at, NOW())
+    merged_at = COALESCE(merged_at, NOW()),
+    version = CASE WHEN status = 'MERGED' THEN version ELSE version + 1 END
 WHERE pull_request_id = $1
 RETURNING *;
 
+-- name: IncrementPullRequestVersion :one
+UPDATE pull_requests
+SET version = version + 1
+WHERE pull_request_id = $1
+RETURNING version;
+
 -- name: ListPullRequestsByReviewer :many
 SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
 FROM pull_requests pr
 JOIN assigned_reviewers ar ON pr.pull_request_id = ar.pr_id
 WHERE ar.reviewer_id = $1
-ORDER BY pr.created_at DESC;
\ No newline at end of file
+ORDER BY pr.created_at DESC;
+
+-- name: ListPullRequestsByReviewers :many
+SELECT ar.reviewer_id, pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.team_name, pr.version
+FROM assigned_reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+WHERE ar.reviewer_id = ANY(sqlc.arg('reviewer_ids')::text[])
+ORDER BY ar.reviewer_id, pr.created_at DESC;
+
+-- name: ListOpenPullRequestsByAuthor :many
+SELECT pull_request_id, pull_request_name, author_id, status
+FROM pull_requests
+WHERE author_id = $1 AND status = 'OPEN'
+ORDER BY created_at DESC;
+-- name: FlagStalePRs :many
+UPDATE pull_requests pr
+SET stale_flagged_at = NOW()
+FROM teams t
+WHERE t.team_name = pr.team_name
+  AND pr.status = 'OPEN'
+  AND pr.stale_flagged_at IS NULL
+  AND pr.created_at <= NOW()::timestamp - make_interval(secs => COALESCE(NULLIF(t.stale_after_seconds, 0), sqlc.arg('default_seconds')::bigint))
+RETURNING pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status;
+
+-- name: GetTransactionTime :one
+SELECT NOW()::timestamp AS now;
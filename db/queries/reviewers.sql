@@ -2,10 +2,20 @@
 INSERT INTO assigned_reviewers (pr_id, reviewer_id)
 VALUES ($1, $2);
 
+-- name: CopyReviewers :copyfrom
+INSERT INTO assigned_reviewers (pr_id, reviewer_id, assigned_at)
+VALUES ($1, $2, $3);
+
 -- name: RemoveReviewer :exec
 DELETE FROM assigned_reviewers
 WHERE pr_id = $1 AND reviewer_id = $2;
 
+-- name: ListAssignedReviewersByPRs :many
+SELECT pr_id, reviewer_id
+FROM assigned_reviewers
+WHERE pr_id = ANY(sqlc.arg('pr_ids')::text[])
+ORDER BY pr_id, reviewer_id;
+
 -- name: IsReviewerAssigned :one
 SELECT EXISTS (
   SELECT 1
@@ -15,11 +25,23 @@ SELECT EXISTS (
 
 -- name: ReplaceReviewer :exec
 UPDATE assigned_reviewers
-SET reviewer_id = $3
+SET reviewer_id = $3,
+    assigned_at = NOW()
 WHERE pr_id = $1 AND reviewer_id = $2;
 
 -- name: GetAssignedReviewers :many
 SELECT reviewer_id
 FROM assigned_reviewers
 WHERE pr_id = $1
-ORDER BY reviewer_id;
\ No newline at end of file
+ORDER BY reviewer_id;
+
+-- name: CountOpenReviews :one
+SELECT COUNT(*)
+FROM assigned_reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+WHERE ar.reviewer_id = $1 AND pr.status = 'OPEN';
+
+-- name: GetAssignedAt :one
+SELECT assigned_at
+FROM assigned_reviewers
+WHERE pr_id = $1 AND reviewer_id = $2;
@@ -0,0 +1,41 @@
+-- name: HasData :one
+SELECT EXISTS (
+  SELECT 1 FROM teams
+  UNION ALL
+  SELECT 1 FROM users
+  UNION ALL
+  SELECT 1 FROM pull_requests
+) AS has_data;
+
+-- name: ListTeams :many
+SELECT team_name, parent_team_name, require_lead_review, stale_after_seconds
+FROM teams
+ORDER BY team_name;
+
+-- name: ListUsers :many
+SELECT user_id, username, team_name, is_active
+FROM users
+ORDER BY user_id;
+
+-- name: ListTeamMemberships :many
+SELECT team_name, user_id, role
+FROM team_memberships
+ORDER BY team_name, user_id;
+
+-- name: ListPullRequests :many
+SELECT *
+FROM pull_requests
+ORDER BY created_at, pull_request_id;
+
+-- name: ListAssignedReviewers :many
+SELECT pr_id, reviewer_id, assigned_at
+FROM assigned_reviewers
+ORDER BY pr_id, reviewer_id;
+
+-- name: RestorePullRequest :exec
+INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, team_name, status, created_at, merged_at, stale_flagged_at, version)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: RestoreReviewer :exec
+INSERT INTO assigned_reviewers (pr_id, reviewer_id, assigned_at)
+VALUES ($1, $2, $3);
@@ -1,12 +1,36 @@
 -- name: GetUserAssignmentStats :many
+WITH reviews AS (
+    SELECT
+        ar.reviewer_id,
+        COUNT(*) AS reviews_merged,
+        AVG(EXTRACT(EPOCH FROM pr.merged_at - ar.assigned_at))::float8 AS mean_review_seconds
+    FROM assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+    WHERE pr.status = 'MERGED'
+      AND pr.merged_at IS NOT NULL
+      AND (sqlc.narg('from')::timestamp IS NULL OR pr.merged_at >= sqlc.narg('from')::timestamp)
+      AND (sqlc.narg('to')::timestamp IS NULL OR pr.merged_at < sqlc.narg('to')::timestamp)
+    GROUP BY ar.reviewer_id
+)
 SELECT 
     u.user_id,
     u.username,
     u.team_name,
-    COUNT(ar.pr_id) as assignments_count
+    COUNT(ar.pr_id) as assignments_count,
+    COALESCE(r.reviews_merged, 0)::bigint AS reviews_merged,
+    COALESCE(r.mean_review_seconds, 0)::float8 AS mean_review_seconds
 FROM users u
 LEFT JOIN assigned_reviewers ar ON u.user_id = ar.reviewer_id
-GROUP BY u.user_id, u.username, u.team_name
+    AND (sqlc.narg('from')::timestamp IS NULL OR ar.assigned_at >= sqlc.narg('from')::timestamp)
+    AND (sqlc.narg('to')::timestamp IS NULL OR ar.assigned_at < sqlc.narg('to')::timestamp)
+LEFT JOIN reviews r ON r.reviewer_id = u.user_id
+WHERE sqlc.narg('team_name')::text IS NULL
+   OR EXISTS (
+       SELECT 1
+       FROM team_memberships tm
+       WHERE tm.user_id = u.user_id AND tm.team_name = sqlc.narg('team_name')::text
+   )
+GROUP BY u.user_id, u.username, u.team_name, r.reviews_merged, r.mean_review_seconds
 ORDER BY assignments_count DESC, u.user_id;
 
 -- name: GetPRStats :one
@@ -14,17 +38,271 @@ SELECT
     COUNT(*) as total_prs,
     COUNT(*) FILTER (WHERE status = 'OPEN') as open_prs,
     COUNT(*) FILTER (WHERE status = 'MERGED') as merged_prs
-FROM pull_requests;
+FROM pull_requests
+WHERE (sqlc.narg('from')::timestamp IS NULL OR created_at >= sqlc.narg('from')::timestamp)
+  AND (sqlc.narg('to')::timestamp IS NULL OR created_at < sqlc.narg('to')::timestamp)
+  AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text);
 
 -- name: GetReviewerWorkload :many
 SELECT 
     u.user_id,
     u.username,
     u.team_name,
-    COUNT(ar.pr_id) as open_prs_count
+    COUNT(pr.pull_request_id) as open_prs_count,
+    COALESCE(EXTRACT(EPOCH FROM NOW()::timestamp - MIN(ar.assigned_at) FILTER (WHERE pr.pull_request_id IS NOT NULL)), 0)::float8 AS oldest_open_age_seconds,
+    COUNT(ar.pr_id) FILTER (WHERE ar.assigned_at >= NOW()::timestamp - INTERVAL '7 days') AS assignments_last_7_days
 FROM users u
 LEFT JOIN assigned_reviewers ar ON u.user_id = ar.reviewer_id
+    AND (sqlc.narg('from')::timestamp IS NULL OR ar.assigned_at >= sqlc.narg('from')::timestamp)
+    AND (sqlc.narg('to')::timestamp IS NULL OR ar.assigned_at < sqlc.narg('to')::timestamp)
 LEFT JOIN pull_requests pr ON ar.pr_id = pr.pull_request_id AND pr.status = 'OPEN'
 WHERE u.is_active = true
+  AND (
+      sqlc.narg('team_name')::text IS NULL
+      OR EXISTS (
+          SELECT 1
+          FROM team_memberships tm
+          WHERE tm.user_id = u.user_id AND tm.team_name = sqlc.narg('team_name')::text
+      )
+  )
 GROUP BY u.user_id, u.username, u.team_name
-ORDER BY open_prs_count DESC;
\ No newline at end of file
+ORDER BY
+    CASE WHEN sqlc.arg('sort_by')::text = 'username' THEN u.username END,
+    CASE WHEN sqlc.arg('sort_by')::text = 'oldest_open' THEN MIN(ar.assigned_at) FILTER (WHERE pr.pull_request_id IS NOT NULL) END NULLS LAST,
+    CASE WHEN sqlc.arg('sort_by')::text = 'recent_assignments' THEN COUNT(ar.pr_id) FILTER (WHERE ar.assigned_at >= NOW()::timestamp - INTERVAL '7 days') END DESC,
+    open_prs_count DESC,
+    u.user_id;
+
+-- name: GetTeamRollupStats :many
+WITH RECURSIVE team_tree AS (
+    SELECT team_name AS root_team, team_name
+    FROM teams
+    WHERE sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text
+    UNION ALL
+    SELECT tt.root_team, t.team_name
+    FROM teams t
+    JOIN team_tree tt ON t.parent_team_name = tt.team_name
+) CYCLE team_name SET is_cycle USING path,
+team_members AS (
+    SELECT team_name, COUNT(*) AS members_count
+    FROM team_memberships
+    GROUP BY team_name
+),
+team_assignments AS (
+    SELECT pr.team_name, COUNT(*) AS assignments_count
+    FROM assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+    WHERE (sqlc.narg('from')::timestamp IS NULL OR ar.assigned_at >= sqlc.narg('from')::timestamp)
+      AND (sqlc.narg('to')::timestamp IS NULL OR ar.assigned_at < sqlc.narg('to')::timestamp)
+    GROUP BY pr.team_name
+),
+team_prs AS (
+    SELECT
+        team_name,
+        COUNT(*) FILTER (WHERE status = 'OPEN') AS open_prs,
+        COUNT(*) FILTER (WHERE status = 'MERGED') AS merged_prs
+    FROM pull_requests
+    WHERE (sqlc.narg('from')::timestamp IS NULL OR created_at >= sqlc.narg('from')::timestamp)
+      AND (sqlc.narg('to')::timestamp IS NULL OR created_at < sqlc.narg('to')::timestamp)
+    GROUP BY team_name
+)
+SELECT
+    t.team_name,
+    t.parent_team_name,
+    COUNT(tt.team_name) AS teams_count,
+    COALESCE(SUM(m.members_count), 0)::bigint AS members_count,
+    COALESCE(SUM(a.assignments_count), 0)::bigint AS assignments_count,
+    COALESCE(SUM(p.open_prs), 0)::bigint AS open_prs,
+    COALESCE(SUM(p.merged_prs), 0)::bigint AS merged_prs
+FROM teams t
+JOIN team_tree tt ON tt.root_team = t.team_name AND NOT tt.is_cycle
+LEFT JOIN team_members m ON m.team_name = tt.team_name
+LEFT JOIN team_assignments a ON a.team_name = tt.team_name
+LEFT JOIN team_prs p ON p.team_name = tt.team_name
+GROUP BY t.team_name, t.parent_team_name
+ORDER BY t.team_name;
+
+-- name: GetTeamCycleTimeStats :many
+WITH merged AS (
+    SELECT team_name, EXTRACT(EPOCH FROM merged_at - created_at)::float8 AS seconds
+    FROM pull_requests
+    WHERE status = 'MERGED'
+      AND merged_at IS NOT NULL
+      AND (sqlc.narg('from')::timestamp IS NULL OR merged_at >= sqlc.narg('from')::timestamp)
+      AND (sqlc.narg('to')::timestamp IS NULL OR merged_at < sqlc.narg('to')::timestamp)
+      AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text)
+)
+SELECT
+    team_name,
+    COUNT(*) AS merged_prs,
+    AVG(seconds)::float8 AS mean_seconds,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds)::float8 AS median_seconds,
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds)::float8 AS p90_seconds,
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY seconds)::float8 AS p99_seconds
+FROM merged
+GROUP BY team_name
+ORDER BY team_name;
+
+-- name: GetAuthorCycleTimeStats :many
+WITH merged AS (
+    SELECT author_id, EXTRACT(EPOCH FROM merged_at - created_at)::float8 AS seconds
+    FROM pull_requests
+    WHERE status = 'MERGED'
+      AND merged_at IS NOT NULL
+      AND (sqlc.narg('from')::timestamp IS NULL OR merged_at >= sqlc.narg('from')::timestamp)
+      AND (sqlc.narg('to')::timestamp IS NULL OR merged_at < sqlc.narg('to')::timestamp)
+      AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text)
+)
+SELECT
+    u.user_id,
+    u.username,
+    u.team_name,
+    COUNT(*) AS merged_prs,
+    AVG(m.seconds)::float8 AS mean_seconds,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY m.seconds)::float8 AS median_seconds,
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY m.seconds)::float8 AS p90_seconds,
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY m.seconds)::float8 AS p99_seconds
+FROM merged m
+JOIN users u ON u.user_id = m.author_id
+GROUP BY u.user_id, u.username, u.team_name
+ORDER BY u.user_id;
+
+-- name: GetCycleTimeHistogram :many
+SELECT
+    width_bucket(EXTRACT(EPOCH FROM merged_at - created_at)::float8, sqlc.arg('bounds')::float8[]) AS bucket,
+    COUNT(*) AS prs_count
+FROM pull_requests
+WHERE status = 'MERGED'
+  AND merged_at IS NOT NULL
+  AND (sqlc.narg('from')::timestamp IS NULL OR merged_at >= sqlc.narg('from')::timestamp)
+  AND (sqlc.narg('to')::timestamp IS NULL OR merged_at < sqlc.narg('to')::timestamp)
+  AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text)
+GROUP BY bucket
+ORDER BY bucket;
+
+-- name: GetStatsTimeSeries :many
+WITH opened AS (
+    SELECT date_trunc(sqlc.arg('interval')::text, created_at)::timestamp AS bucket, COUNT(*) AS prs_opened
+    FROM pull_requests
+    WHERE (sqlc.narg('from')::timestamp IS NULL OR created_at >= sqlc.narg('from')::timestamp)
+      AND (sqlc.narg('to')::timestamp IS NULL OR created_at < sqlc.narg('to')::timestamp)
+      AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text)
+    GROUP BY 1
+),
+merged AS (
+    SELECT date_trunc(sqlc.arg('interval')::text, merged_at)::timestamp AS bucket, COUNT(*) AS prs_merged
+    FROM pull_requests
+    WHERE status = 'MERGED'
+      AND merged_at IS NOT NULL
+      AND (sqlc.narg('from')::timestamp IS NULL OR merged_at >= sqlc.narg('from')::timestamp)
+      AND (sqlc.narg('to')::timestamp IS NULL OR merged_at < sqlc.narg('to')::timestamp)
+      AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text)
+    GROUP BY 1
+),
+assignments AS (
+    SELECT date_trunc(sqlc.arg('interval')::text, ar.assigned_at)::timestamp AS bucket, COUNT(*) AS assignments
+    FROM assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+    WHERE (sqlc.narg('from')::timestamp IS NULL OR ar.assigned_at >= sqlc.narg('from')::timestamp)
+      AND (sqlc.narg('to')::timestamp IS NULL OR ar.assigned_at < sqlc.narg('to')::timestamp)
+      AND (sqlc.narg('team_name')::text IS NULL OR pr.team_name = sqlc.narg('team_name')::text)
+    GROUP BY 1
+),
+buckets AS (
+    SELECT bucket FROM opened
+    UNION
+    SELECT bucket FROM merged
+    UNION
+    SELECT bucket FROM assignments
+)
+SELECT
+    b.bucket::timestamp AS bucket,
+    COALESCE(o.prs_opened, 0)::bigint AS prs_opened,
+    COALESCE(m.prs_merged, 0)::bigint AS prs_merged,
+    COALESCE(a.assignments, 0)::bigint AS assignments
+FROM buckets b
+LEFT JOIN opened o ON o.bucket = b.bucket
+LEFT JOIN merged m ON m.bucket = b.bucket
+LEFT JOIN assignments a ON a.bucket = b.bucket
+ORDER BY b.bucket;
+
+-- name: GetTeamMemberAssignmentCounts :many
+SELECT
+    tm.team_name,
+    u.user_id,
+    u.username,
+    COUNT(ar.pr_id) AS assignments_count
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+LEFT JOIN (
+    assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+) ON ar.reviewer_id = tm.user_id
+    AND pr.team_name = tm.team_name
+    AND (sqlc.narg('from')::timestamp IS NULL OR ar.assigned_at >= sqlc.narg('from')::timestamp)
+    AND (sqlc.narg('to')::timestamp IS NULL OR ar.assigned_at < sqlc.narg('to')::timestamp)
+WHERE u.is_active = true
+  AND tm.role != 'observer'
+  AND (sqlc.narg('team_name')::text IS NULL OR tm.team_name = sqlc.narg('team_name')::text)
+GROUP BY tm.team_name, u.user_id, u.username
+ORDER BY tm.team_name, u.user_id;
+
+-- name: GetReviewerPairs :many
+SELECT
+    pr.author_id,
+    a.username AS author_username,
+    ar.reviewer_id,
+    r.username AS reviewer_username,
+    COUNT(*) AS reviews_count
+FROM assigned_reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+JOIN users a ON a.user_id = pr.author_id
+JOIN users r ON r.user_id = ar.reviewer_id
+WHERE (sqlc.narg('from')::timestamp IS NULL OR ar.assigned_at >= sqlc.narg('from')::timestamp)
+  AND (sqlc.narg('to')::timestamp IS NULL OR ar.assigned_at < sqlc.narg('to')::timestamp)
+  AND (sqlc.narg('team_name')::text IS NULL OR pr.team_name = sqlc.narg('team_name')::text)
+GROUP BY pr.author_id, a.username, ar.reviewer_id, r.username
+ORDER BY reviews_count DESC, pr.author_id, ar.reviewer_id;
+
+-- name: GetTeamOpenWorkload :many
+SELECT
+    t.team_name,
+    COUNT(DISTINCT pr.pull_request_id) AS open_prs,
+    COUNT(ar.reviewer_id) AS open_assignments
+FROM teams t
+LEFT JOIN pull_requests pr ON pr.team_name = t.team_name AND pr.status = 'OPEN'
+LEFT JOIN assigned_reviewers ar ON ar.pr_id = pr.pull_request_id
+GROUP BY t.team_name
+ORDER BY t.team_name;
+
+-- name: GetStalePRs :many
+WITH thresholds AS (
+    SELECT
+        team_name,
+        COALESCE(
+            sqlc.narg('older_than_seconds')::bigint,
+            NULLIF(stale_after_seconds, 0),
+            sqlc.arg('default_seconds')::bigint
+        )::bigint AS threshold_seconds
+    FROM teams
+)
+SELECT
+    pr.pull_request_id,
+    pr.pull_request_name,
+    pr.author_id,
+    pr.team_name,
+    pr.created_at,
+    pr.stale_flagged_at,
+    EXTRACT(EPOCH FROM NOW()::timestamp - pr.created_at)::float8 AS age_seconds,
+    t.threshold_seconds,
+    COALESCE(array_agg(ar.reviewer_id ORDER BY ar.reviewer_id) FILTER (WHERE ar.reviewer_id IS NOT NULL), '{}')::text[] AS reviewers
+FROM pull_requests pr
+JOIN thresholds t ON t.team_name = pr.team_name
+LEFT JOIN assigned_reviewers ar ON ar.pr_id = pr.pull_request_id
+WHERE pr.status = 'OPEN'
+  AND pr.created_at <= NOW()::timestamp - make_interval(secs => t.threshold_seconds)
+  AND (sqlc.narg('from')::timestamp IS NULL OR pr.created_at >= sqlc.narg('from')::timestamp)
+  AND (sqlc.narg('to')::timestamp IS NULL OR pr.created_at < sqlc.narg('to')::timestamp)
+  AND (sqlc.narg('team_name')::text IS NULL OR pr.team_name = sqlc.narg('team_name')::text)
+GROUP BY pr.pull_request_id, t.threshold_seconds
+ORDER BY pr.created_at, pr.pull_request_id;
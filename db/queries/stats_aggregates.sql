@@ -0,0 +1,163 @@
+-- name: UpsertUserDailyStats :exec
+INSERT INTO stats_user_daily (day, reviewer_id, team_name, assignments)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (day, reviewer_id, team_name)
+DO UPDATE SET assignments = stats_user_daily.assignments + EXCLUDED.assignments,
+              updated_at = NOW();
+
+-- name: RecordReviewTimes :exec
+INSERT INTO stats_user_daily (day, reviewer_id, team_name, reviews_merged, review_seconds)
+SELECT pr.merged_at::date, ar.reviewer_id, pr.team_name, 1, EXTRACT(EPOCH FROM pr.merged_at - ar.assigned_at)
+FROM assigned_reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+WHERE pr.pull_request_id = $1 AND pr.merged_at IS NOT NULL
+ORDER BY ar.reviewer_id
+ON CONFLICT (day, reviewer_id, team_name)
+DO UPDATE SET reviews_merged = stats_user_daily.reviews_merged + EXCLUDED.reviews_merged,
+              review_seconds = stats_user_daily.review_seconds + EXCLUDED.review_seconds,
+              updated_at = NOW();
+
+-- name: UpsertTeamDailyStats :exec
+INSERT INTO stats_team_daily (day, team_name, prs_opened, prs_opened_merged, prs_merged)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (day, team_name)
+DO UPDATE SET prs_opened = stats_team_daily.prs_opened + EXCLUDED.prs_opened,
+              prs_opened_merged = stats_team_daily.prs_opened_merged + EXCLUDED.prs_opened_merged,
+              prs_merged = stats_team_daily.prs_merged + EXCLUDED.prs_merged,
+              updated_at = NOW();
+
+-- name: MarkStatsRebuilt :exec
+UPDATE stats_freshness
+SET updated_at = NOW(),
+    rebuilt_at = NOW();
+
+-- name: GetStatsFreshness :one
+SELECT GREATEST(
+           f.updated_at,
+           (SELECT MAX(updated_at) FROM stats_user_daily),
+           (SELECT MAX(updated_at) FROM stats_team_daily)
+       )::timestamp AS updated_at,
+       f.rebuilt_at
+FROM stats_freshness f;
+
+-- name: DeleteUserDailyStats :exec
+DELETE FROM stats_user_daily;
+
+-- name: DeleteTeamDailyStats :exec
+DELETE FROM stats_team_daily;
+
+-- name: RebuildUserDailyStats :exec
+INSERT INTO stats_user_daily (day, reviewer_id, team_name, assignments, reviews_merged, review_seconds)
+SELECT day, reviewer_id, team_name, SUM(assigned), SUM(merged), SUM(seconds)
+FROM (
+    SELECT ar.assigned_at::date AS day, ar.reviewer_id, pr.team_name,
+           1 AS assigned, 0 AS merged, 0::float8 AS seconds
+    FROM assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+    UNION ALL
+    SELECT pr.merged_at::date, ar.reviewer_id, pr.team_name,
+           0, 1, EXTRACT(EPOCH FROM pr.merged_at - ar.assigned_at)::float8
+    FROM assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+    WHERE pr.status = 'MERGED' AND pr.merged_at IS NOT NULL
+) events
+GROUP BY day, reviewer_id, team_name;
+
+-- name: RebuildTeamDailyStats :exec
+INSERT INTO stats_team_daily (day, team_name, prs_opened, prs_opened_merged, prs_merged)
+SELECT day, team_name, SUM(opened), SUM(opened_merged), SUM(merged)
+FROM (
+    SELECT created_at::date AS day, team_name, 1 AS opened,
+           CASE WHEN status = 'MERGED' THEN 1 ELSE 0 END AS opened_merged, 0 AS merged
+    FROM pull_requests
+    UNION ALL
+    SELECT merged_at::date, team_name, 0, 0, 1
+    FROM pull_requests
+    WHERE status = 'MERGED' AND merged_at IS NOT NULL
+) events
+GROUP BY day, team_name;
+
+-- name: GetAggregatedUserAssignmentStats :many
+SELECT
+    u.user_id,
+    u.username,
+    u.team_name,
+    COALESCE(SUM(s.assignments), 0)::bigint AS assignments_count,
+    COALESCE(SUM(s.reviews_merged), 0)::bigint AS reviews_merged,
+    COALESCE(SUM(s.review_seconds) / NULLIF(SUM(s.reviews_merged), 0), 0)::float8 AS mean_review_seconds
+FROM users u
+LEFT JOIN stats_user_daily s ON s.reviewer_id = u.user_id
+    AND (sqlc.narg('from')::date IS NULL OR s.day >= sqlc.narg('from')::date)
+    AND (sqlc.narg('to')::date IS NULL OR s.day < sqlc.narg('to')::date)
+WHERE sqlc.narg('team_name')::text IS NULL
+   OR EXISTS (
+       SELECT 1
+       FROM team_memberships tm
+       WHERE tm.user_id = u.user_id AND tm.team_name = sqlc.narg('team_name')::text
+   )
+GROUP BY u.user_id, u.username, u.team_name
+ORDER BY assignments_count DESC, u.user_id;
+
+-- name: GetAggregatedPRStats :one
+SELECT
+    COALESCE(SUM(prs_opened), 0)::bigint AS total_prs,
+    COALESCE(SUM(prs_opened - prs_opened_merged), 0)::bigint AS open_prs,
+    COALESCE(SUM(prs_opened_merged), 0)::bigint AS merged_prs
+FROM stats_team_daily
+WHERE (sqlc.narg('from')::date IS NULL OR day >= sqlc.narg('from')::date)
+  AND (sqlc.narg('to')::date IS NULL OR day < sqlc.narg('to')::date)
+  AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text);
+
+-- name: GetAggregatedTimeSeries :many
+WITH prs AS (
+    SELECT date_trunc(sqlc.arg('interval')::text, day::timestamp)::timestamp AS bucket,
+           SUM(prs_opened) AS prs_opened,
+           SUM(prs_merged) AS prs_merged
+    FROM stats_team_daily
+    WHERE (sqlc.narg('from')::date IS NULL OR day >= sqlc.narg('from')::date)
+      AND (sqlc.narg('to')::date IS NULL OR day < sqlc.narg('to')::date)
+      AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text)
+    GROUP BY 1
+),
+assignments AS (
+    SELECT date_trunc(sqlc.arg('interval')::text, day::timestamp)::timestamp AS bucket,
+           SUM(assignments) AS assignments
+    FROM stats_user_daily
+    WHERE (sqlc.narg('from')::date IS NULL OR day >= sqlc.narg('from')::date)
+      AND (sqlc.narg('to')::date IS NULL OR day < sqlc.narg('to')::date)
+      AND (sqlc.narg('team_name')::text IS NULL OR team_name = sqlc.narg('team_name')::text)
+    GROUP BY 1
+),
+buckets AS (
+    SELECT bucket FROM prs
+    UNION
+    SELECT bucket FROM assignments
+)
+SELECT
+    b.bucket::timestamp AS bucket,
+    COALESCE(p.prs_opened, 0)::bigint AS prs_opened,
+    COALESCE(p.prs_merged, 0)::bigint AS prs_merged,
+    COALESCE(a.assignments, 0)::bigint AS assignments
+FROM buckets b
+LEFT JOIN prs p ON p.bucket = b.bucket
+LEFT JOIN assignments a ON a.bucket = b.bucket
+WHERE COALESCE(p.prs_opened, 0) + COALESCE(p.prs_merged, 0) + COALESCE(a.assignments, 0) > 0
+ORDER BY b.bucket;
+
+-- name: GetAggregatedTeamMemberAssignmentCounts :many
+SELECT
+    tm.team_name,
+    u.user_id,
+    u.username,
+    COALESCE(SUM(s.assignments), 0)::bigint AS assignments_count
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+LEFT JOIN stats_user_daily s ON s.reviewer_id = tm.user_id
+    AND s.team_name = tm.team_name
+    AND (sqlc.narg('from')::date IS NULL OR s.day >= sqlc.narg('from')::date)
+    AND (sqlc.narg('to')::date IS NULL OR s.day < sqlc.narg('to')::date)
+WHERE u.is_active = true
+  AND tm.role != 'observer'
+  AND (sqlc.narg('team_name')::text IS NULL OR tm.team_name = sqlc.narg('team_name')::text)
+GROUP BY tm.team_name, u.user_id, u.username
+ORDER BY tm.team_name, u.user_id;
@@ -4,9 +4,70 @@ VALUES ($1)
 RETURNING team_name;
 
 -- name: GetTeam :one
-SELECT team_name
+SELECT team_name, parent_team_name, require_lead_review, stale_after_seconds
 FROM teams
 WHERE team_name = $1;
 
 -- name: TeamExists :one
 SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1);
+
+-- name: SetParentTeam :execrows
+UPDATE teams
+SET parent_team_name = $2
+WHERE team_name = $1;
+
+-- name: LockTeamHierarchy :exec
+SELECT pg_advisory_xact_lock(hashtext('team_hierarchy'));
+
+-- name: GetTeamAncestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT t.parent_team_name, 1 AS depth
+    FROM teams t
+    WHERE t.team_name = $1
+    UNION ALL
+    SELECT t.parent_team_name, a.depth + 1
+    FROM teams t
+    JOIN ancestors a ON t.team_name = a.parent_team_name
+) CYCLE parent_team_name SET is_cycle USING path
+SELECT parent_team_name::text AS team_name
+FROM ancestors
+WHERE parent_team_name IS NOT NULL AND NOT is_cycle
+ORDER BY depth;
+
+-- name: AddTeamMember :exec
+INSERT INTO team_memberships (team_name, user_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_name, user_id) DO UPDATE SET role = EXCLUDED.role;
+
+-- name: IsTeamMember :one
+SELECT EXISTS(SELECT 1 FROM team_memberships WHERE team_name = $1 AND user_id = $2);
+
+-- name: GetTeamMembers :many
+SELECT u.user_id, u.username, u.team_name, u.is_active, tm.role
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1
+ORDER BY u.user_id;
+
+-- name: ListTeamMembersByTeams :many
+SELECT tm.team_name AS member_of, u.user_id, u.username, u.team_name, u.is_active, tm.role
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = ANY(sqlc.arg('team_names')::text[])
+ORDER BY tm.team_name, u.user_id;
+
+-- name: GetTeamMemberRole :one
+SELECT role
+FROM team_memberships
+WHERE team_name = $1 AND user_id = $2;
+
+-- name: CountTeamLeads :one
+SELECT COUNT(*)
+FROM team_memberships
+WHERE team_name = $1 AND role = 'lead';
+
+-- name: UpdateTeamSettings :execrows
+UPDATE teams
+SET require_lead_review = $2,
+    stale_after_seconds = $3
+WHERE team_name = $1;
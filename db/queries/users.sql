@@ -10,8 +10,7 @@ SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1);
 UPDATE users
 SET
     username = $2,
-    team_name = $3,
-    is_active = $4
+    is_active = $3
 WHERE user_id = $1;
 
 -- name: GetUser :one
@@ -19,12 +18,25 @@ SELECT user_id, username, team_name, is_active
 FROM users
 WHERE user_id = $1;
 
--- name: GetUsersByTeam :many
+-- name: GetUserForUpdate :one
+SELECT user_id, username, team_name, is_active
+FROM users
+WHERE user_id = $1
+FOR UPDATE;
+
+-- name: GetUsersByIDs :many
 SELECT user_id, username, team_name, is_active
 FROM users
-WHERE team_name = $1
+WHERE user_id = ANY(sqlc.arg('user_ids')::text[])
 ORDER BY user_id;
 
+-- name: GetUsersByTeam :many
+SELECT u.user_id, u.username, u.team_name, u.is_active
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1
+ORDER BY u.user_id;
+
 -- name: SetUserActivity :one
 UPDATE users
 SET is_active = $2
@@ -32,24 +44,63 @@ WHERE user_id = $1
 RETURNING *;
 
 -- name: GetActiveCandidatesForPR :many
-SELECT user_id, username
-FROM users
-WHERE team_name = $1 
-  AND is_active = true 
-  AND user_id != $2
+SELECT u.user_id, u.username
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1 
+  AND tm.role != 'observer'
+  AND u.is_active = true 
+  AND u.user_id != $2
 ORDER BY RANDOM()
 LIMIT 2;
 
+-- name: GetActiveLeadCandidatesForPR :many
+SELECT u.user_id
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1
+  AND tm.role = 'lead'
+  AND u.is_active = true
+  AND u.user_id != $2
+ORDER BY RANDOM()
+LIMIT 1;
+
+-- name: ListReviewerCandidates :many
+SELECT u.user_id, tm.role, COUNT(pr.pull_request_id) AS open_reviews
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+LEFT JOIN assigned_reviewers ar ON ar.reviewer_id = u.user_id
+LEFT JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id AND pr.status = 'OPEN'
+WHERE tm.team_name = $1
+  AND tm.role != 'observer'
+  AND u.is_active = true
+GROUP BY u.user_id, tm.role
+ORDER BY u.user_id;
+
 -- name: GetActiveCandidatesForReassignment :many
-SELECT user_id
-FROM users
-WHERE team_name = $1 
-  AND is_active = true 
-  AND user_id != $2
-  AND user_id NOT IN (
+SELECT u.user_id
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1 
+  AND tm.role != 'observer'
+  AND u.is_active = true 
+  AND u.user_id != $2
+  AND u.user_id NOT IN (
     SELECT reviewer_id 
     FROM assigned_reviewers 
     WHERE pr_id = $3
   )
 ORDER BY RANDOM()
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+
+-- name: UpdateUsername :one
+UPDATE users
+SET username = $2
+WHERE user_id = $1
+RETURNING *;
+
+-- name: ListUserTeams :many
+SELECT team_name
+FROM team_memberships
+WHERE user_id = $1
+ORDER BY team_name;
\ No newline at end of file
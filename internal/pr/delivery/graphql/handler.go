@@ -0,0 +1,76 @@
+package graphql
+
+import (
+	_ "embed"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/labstack/echo/v4"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// maxQueryDepth allows teams → members → user → reviews → reviewers → reviews
+// and stops clients from walking the graph indefinitely.
+const maxQueryDepth = 8
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// NewHandler serves read-only GraphQL queries over readUC. Nested lists are
+// fetched with one call per level, see loaders.
+func NewHandler(readUC usecase.ReadUseCase) echo.HandlerFunc {
+	schema := graphql.MustParseSchema(schemaSDL, &queryResolver{readUC: readUC},
+		graphql.MaxDepth(maxQueryDepth))
+
+	return func(c echo.Context) error {
+		var req request
+		if err := c.Bind(&req); err != nil {
+			return c.JSON(http.StatusBadRequest, &graphql.Response{
+				Errors: []*gqlerrors.QueryError{gqlerrors.Errorf("invalid request body")},
+			})
+		}
+
+		ctx := withLoaders(c.Request().Context(), newLoaders(readUC))
+		resp := schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+		for _, err := range resp.Errors {
+			sanitizeError(c, err)
+		}
+
+		return c.JSON(http.StatusOK, resp)
+	}
+}
+
+// sanitizeError keeps validation messages and replaces other resolver errors
+// with a generic one after logging them, like the REST internalError does.
+func sanitizeError(c echo.Context, err *gqlerrors.QueryError) {
+	if err.ResolverError == nil {
+		return
+	}
+
+	var validationErr *domain.ValidationError
+	if errors.As(err.ResolverError, &validationErr) {
+		err.Extensions = map[string]any{"code": dto.ErrCodeInvalidInput}
+		return
+	}
+
+	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log.Printf("request %s: graphql %v: %v", requestID, err.Path, err.ResolverError)
+
+	err.Message = "internal server error"
+	err.Extensions = map[string]any{
+		"code":       dto.ErrCodeInternal,
+		"request_id": requestID,
+	}
+}
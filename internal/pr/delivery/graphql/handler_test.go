@@ -0,0 +1,193 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+// fakeReadUseCase serves a fixed dataset and counts the calls of every
+// method to catch N+1 lookups.
+type fakeReadUseCase struct {
+	teams     []domain.Team
+	members   map[string][]domain.TeamMember
+	users     map[string]*domain.User
+	reviewers map[string][]string
+	prs       map[string][]domain.PullRequest
+	err       error
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (f *fakeReadUseCase) called(method string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.calls == nil {
+		f.calls = make(map[string]int)
+	}
+	f.calls[method]++
+}
+
+func (f *fakeReadUseCase) ListTeams(context.Context) ([]domain.Team, error) {
+	f.called("ListTeams")
+	return f.teams, f.err
+}
+
+func (f *fakeReadUseCase) GetTeam(_ context.Context, teamName string) (*domain.Team, error) {
+	f.called("GetTeam")
+	for _, team := range f.teams {
+		if team.TeamName == teamName {
+			team.Members = f.members[teamName]
+			return &team, nil
+		}
+	}
+	return nil, domain.ErrTeamNotFound
+}
+
+func (f *fakeReadUseCase) GetPR(context.Context, string) (*domain.PullRequest, error) {
+	f.called("GetPR")
+	return nil, domain.ErrPRNotFound
+}
+
+func (f *fakeReadUseCase) GetUsers(_ context.Context, userIDs []string) (map[string]*domain.User, error) {
+	f.called("GetUsers")
+	result := make(map[string]*domain.User)
+	for _, id := range userIDs {
+		if user, ok := f.users[id]; ok {
+			result[id] = user
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeReadUseCase) GetTeamMembers(_ context.Context, teamNames []string) (map[string][]domain.TeamMember, error) {
+	f.called("GetTeamMembers")
+	result := make(map[string][]domain.TeamMember)
+	for _, name := range teamNames {
+		result[name] = f.members[name]
+	}
+	return result, nil
+}
+
+func (f *fakeReadUseCase) GetPRReviewers(_ context.Context, prIDs []string) (map[string][]string, error) {
+	f.called("GetPRReviewers")
+	result := make(map[string][]string)
+	for _, id := range prIDs {
+		result[id] = f.reviewers[id]
+	}
+	return result, nil
+}
+
+func (f *fakeReadUseCase) GetReviewerPRs(_ context.Context, reviewerIDs []string) (map[string][]domain.PullRequest, error) {
+	f.called("GetReviewerPRs")
+	result := make(map[string][]domain.PullRequest)
+	for _, id := range reviewerIDs {
+		result[id] = f.prs[id]
+	}
+	return result, nil
+}
+
+func newFakeReadUseCase() *fakeReadUseCase {
+	users := map[string]*domain.User{}
+	members := map[string][]domain.TeamMember{}
+	prs := map[string][]domain.PullRequest{}
+	reviewers := map[string][]string{}
+
+	var teams []domain.Team
+	for _, teamName := range []string{"backend", "frontend", "mobile"} {
+		teams = append(teams, domain.Team{TeamName: teamName})
+		for _, suffix := range []string{"-1", "-2", "-3", "-4"} {
+			user := domain.User{UserID: teamName + suffix, Username: teamName + suffix, TeamName: teamName, IsActive: true}
+			users[user.UserID] = &user
+			members[teamName] = append(members[teamName], domain.TeamMember{User: user, Role: domain.TeamRoleMember})
+
+			prID := "pr-" + user.UserID
+			prs[user.UserID] = []domain.PullRequest{{
+				PullRequestID: prID, PullRequestName: prID, AuthorID: "author",
+				TeamName: teamName, Status: domain.PRStatusOpen, Version: 1,
+			}}
+			reviewers[prID] = []string{user.UserID, "gone"}
+		}
+	}
+	users["author"] = &domain.User{UserID: "author", Username: "Author", TeamName: "backend", IsActive: true}
+
+	return &fakeReadUseCase{teams: teams, members: members, users: users, reviewers: reviewers, prs: prs}
+}
+
+func execute(t *testing.T, readUC *fakeReadUseCase, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	e := echo.New()
+	e.POST("/graphql", NewHandler(readUC))
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_NestedQueryIsBatchedPerLevel(t *testing.T) {
+	readUC := newFakeReadUseCase()
+
+	rec := execute(t, readUC, `{"query": "{ teams { name members { role user { id reviews(status: OPEN) { id author { id } reviewers { id username } } } } } }"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), `"errors"`)
+	assert.Contains(t, rec.Body.String(), `{"role":"MEMBER","user":{"id":"frontend-2","reviews":[{"id":"pr-frontend-2","author":{"id":"author"},"reviewers":[{"id":"frontend-2","username":"frontend-2"}]}]}}`)
+
+	assert.Equal(t, map[string]int{
+		"ListTeams":      1,
+		"GetTeamMembers": 1,
+		"GetReviewerPRs": 1,
+		"GetPRReviewers": 1,
+		"GetUsers":       1,
+	}, readUC.calls)
+}
+
+func TestHandler_SingleTeam(t *testing.T) {
+	readUC := newFakeReadUseCase()
+
+	rec := execute(t, readUC, `{"query": "query($name: String!) { team(name: $name) { name members { user { reviews { id } } } } }", "variables": {"name": "mobile"}}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"name":"mobile"`)
+	assert.Contains(t, rec.Body.String(), `"pr-mobile-4"`)
+	assert.Equal(t, map[string]int{"GetTeam": 1, "GetReviewerPRs": 1}, readUC.calls,
+		"members come with the team and must not be fetched again")
+}
+
+func TestHandler_NotFoundIsNull(t *testing.T) {
+	rec := execute(t, newFakeReadUseCase(), `{"query": "{ team(name: \"qa\") { name } user(id: \"ghost\") { id } pullRequest(id: \"pr-x\") { id } }"}`)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data": {"team": null, "user": null, "pullRequest": null}}`, rec.Body.String())
+}
+
+func TestHandler_HidesInternalErrors(t *testing.T) {
+	readUC := newFakeReadUseCase()
+	readUC.err = errors.New("connection refused")
+
+	rec := execute(t, readUC, `{"query": "{ teams { name } }"}`)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"message":"internal server error"`)
+	assert.Contains(t, rec.Body.String(), `"code":"INTERNAL_ERROR"`)
+	assert.NotContains(t, rec.Body.String(), "connection refused")
+}
+
+func TestHandler_InvalidBody(t *testing.T) {
+	rec := execute(t, newFakeReadUseCase(), `{"query":`)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid request body")
+}
@@ -0,0 +1,196 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+// loader batches and caches lookups by key for one request.
+//
+// Resolvers of list elements run concurrently but at most a few at a time, so
+// waiting for keys to pile up would only batch a handful of them. Instead the
+// keys are queued up front, usually by the batch of the parent level that
+// produced them, and the first load of any key fetches all queued keys in a
+// single call. A nested query thus costs one fetch per level.
+type loader[V any] struct {
+	fetch func(ctx context.Context, keys []string) (map[string]V, error)
+
+	mu      sync.Mutex
+	queued  map[string]struct{}
+	fetched map[string]V
+	done    map[string]struct{}
+}
+
+func newLoader[V any](fetch func(ctx context.Context, keys []string) (map[string]V, error)) *loader[V] {
+	return &loader[V]{
+		fetch:   fetch,
+		queued:  make(map[string]struct{}),
+		fetched: make(map[string]V),
+		done:    make(map[string]struct{}),
+	}
+}
+
+// queue schedules keys for the next fetch.
+func (l *loader[V]) queue(keys ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, key := range keys {
+		if _, ok := l.done[key]; !ok {
+			l.queued[key] = struct{}{}
+		}
+	}
+}
+
+// prime caches a value that is already known, such as the members returned
+// together with a team.
+func (l *loader[V]) prime(key string, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.queued, key)
+	l.done[key] = struct{}{}
+	l.fetched[key] = value
+}
+
+// load returns the value for key, fetching it together with every queued key
+// unless it is cached. Keys missing from the fetch result get the zero value.
+func (l *loader[V]) load(ctx context.Context, key string) (V, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.done[key]; !ok {
+		l.queued[key] = struct{}{}
+		if err := l.fetchQueued(ctx); err != nil {
+			var zero V
+			return zero, err
+		}
+	}
+
+	return l.fetched[key], nil
+}
+
+// flush fetches the queued keys, if any, so that the keys they reveal reach
+// the next level before it is loaded.
+func (l *loader[V]) flush(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.fetchQueued(ctx)
+}
+
+func (l *loader[V]) fetchQueued(ctx context.Context) error {
+	if len(l.queued) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(l.queued))
+	for k := range l.queued {
+		keys = append(keys, k)
+	}
+
+	values, err := l.fetch(ctx, keys)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		l.done[k] = struct{}{}
+		if v, ok := values[k]; ok {
+			l.fetched[k] = v
+		}
+	}
+	clear(l.queued)
+	return nil
+}
+
+func (l *loader[V]) loadMany(ctx context.Context, keys []string) ([]V, error) {
+	l.queue(keys...)
+
+	values := make([]V, len(keys))
+	for i, key := range keys {
+		v, err := l.load(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// loaders holds the per-request loaders. Every fetch queues the keys it
+// reveals in the loader of the next level: team members queue their PRs,
+// PRs queue their reviewers and authors, reviewers queue their users.
+type loaders struct {
+	users       *loader[*domain.User]
+	members     *loader[[]domain.TeamMember]
+	reviewers   *loader[[]string]
+	reviewerPRs *loader[[]domain.PullRequest]
+}
+
+func newLoaders(readUC usecase.ReadUseCase) *loaders {
+	l := &loaders{}
+
+	l.users = newLoader(readUC.GetUsers)
+
+	l.members = newLoader(func(ctx context.Context, teamNames []string) (map[string][]domain.TeamMember, error) {
+		members, err := readUC.GetTeamMembers(ctx, teamNames)
+		if err != nil {
+			return nil, err
+		}
+		for _, teamMembers := range members {
+			l.primeMembers(teamMembers)
+		}
+		return members, nil
+	})
+
+	l.reviewers = newLoader(func(ctx context.Context, prIDs []string) (map[string][]string, error) {
+		reviewers, err := readUC.GetPRReviewers(ctx, prIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, reviewerIDs := range reviewers {
+			l.users.queue(reviewerIDs...)
+		}
+		return reviewers, nil
+	})
+
+	l.reviewerPRs = newLoader(func(ctx context.Context, reviewerIDs []string) (map[string][]domain.PullRequest, error) {
+		prs, err := readUC.GetReviewerPRs(ctx, reviewerIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, reviewerPRs := range prs {
+			l.primePRs(reviewerPRs)
+		}
+		return prs, nil
+	})
+
+	return l
+}
+
+func (l *loaders) primeMembers(members []domain.TeamMember) {
+	for _, member := range members {
+		l.reviewerPRs.queue(member.UserID)
+	}
+}
+
+func (l *loaders) primePRs(prs []domain.PullRequest) {
+	for _, pr := range prs {
+		l.reviewers.queue(pr.PullRequestID)
+		l.users.queue(pr.AuthorID)
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
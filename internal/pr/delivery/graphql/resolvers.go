@@ -0,0 +1,233 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+type queryResolver struct {
+	readUC usecase.ReadUseCase
+}
+
+func (r *queryResolver) Teams(ctx context.Context) ([]*teamResolver, error) {
+	teams, err := r.readUC.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l := loadersFrom(ctx)
+	result := make([]*teamResolver, len(teams))
+	for i := range teams {
+		l.members.queue(teams[i].TeamName)
+		result[i] = &teamResolver{team: &teams[i]}
+	}
+	return result, nil
+}
+
+func (r *queryResolver) Team(ctx context.Context, args struct{ Name string }) (*teamResolver, error) {
+	team, err := r.readUC.GetTeam(ctx, args.Name)
+	if errors.Is(err, domain.ErrTeamNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l := loadersFrom(ctx)
+	l.members.prime(team.TeamName, team.Members)
+	l.primeMembers(team.Members)
+	return &teamResolver{team: team}, nil
+}
+
+func (r *queryResolver) User(ctx context.Context, args struct{ ID graphql.ID }) (*userResolver, error) {
+	l := loadersFrom(ctx)
+	l.reviewerPRs.queue(string(args.ID))
+
+	user, err := l.users.load(ctx, string(args.ID))
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return &userResolver{user: user}, nil
+}
+
+func (r *queryResolver) PullRequest(ctx context.Context, args struct{ ID graphql.ID }) (*pullRequestResolver, error) {
+	pr, err := r.readUC.GetPR(ctx, string(args.ID))
+	if errors.Is(err, domain.ErrPRNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l := loadersFrom(ctx)
+	l.reviewers.prime(pr.PullRequestID, pr.AssignedReviewers)
+	l.users.queue(pr.AssignedReviewers...)
+	l.users.queue(pr.AuthorID)
+	return &pullRequestResolver{pr: pr}, nil
+}
+
+type teamResolver struct {
+	team *domain.Team
+}
+
+func (r *teamResolver) Name() string {
+	return r.team.TeamName
+}
+
+func (r *teamResolver) ParentName() *string {
+	if r.team.ParentTeamName == "" {
+		return nil
+	}
+	return &r.team.ParentTeamName
+}
+
+func (r *teamResolver) RequireLeadReview() bool {
+	return r.team.Settings.RequireLeadReview
+}
+
+func (r *teamResolver) Members(ctx context.Context) ([]*teamMemberResolver, error) {
+	members, err := loadersFrom(ctx).members.load(ctx, r.team.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*teamMemberResolver, len(members))
+	for i := range members {
+		result[i] = &teamMemberResolver{member: &members[i]}
+	}
+	return result, nil
+}
+
+type teamMemberResolver struct {
+	member *domain.TeamMember
+}
+
+func (r *teamMemberResolver) Role() string {
+	return strings.ToUpper(string(r.member.Role))
+}
+
+func (r *teamMemberResolver) User() *userResolver {
+	return &userResolver{user: &r.member.User}
+}
+
+type userResolver struct {
+	user *domain.User
+}
+
+func (r *userResolver) ID() graphql.ID {
+	return graphql.ID(r.user.UserID)
+}
+
+func (r *userResolver) Username() string {
+	return r.user.Username
+}
+
+func (r *userResolver) TeamName() string {
+	return r.user.TeamName
+}
+
+func (r *userResolver) IsActive() bool {
+	return r.user.IsActive
+}
+
+func (r *userResolver) Reviews(ctx context.Context, args struct{ Status *string }) ([]*pullRequestResolver, error) {
+	prs, err := loadersFrom(ctx).reviewerPRs.load(ctx, r.user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pullRequestResolver, 0, len(prs))
+	for i := range prs {
+		if args.Status != nil && string(prs[i].Status) != *args.Status {
+			continue
+		}
+		result = append(result, &pullRequestResolver{pr: &prs[i]})
+	}
+	return result, nil
+}
+
+type pullRequestResolver struct {
+	pr *domain.PullRequest
+}
+
+func (r *pullRequestResolver) ID() graphql.ID {
+	return graphql.ID(r.pr.PullRequestID)
+}
+
+func (r *pullRequestResolver) Name() string {
+	return r.pr.PullRequestName
+}
+
+func (r *pullRequestResolver) Status() string {
+	return string(r.pr.Status)
+}
+
+func (r *pullRequestResolver) TeamName() string {
+	return r.pr.TeamName
+}
+
+func (r *pullRequestResolver) Version() int32 {
+	return int32(r.pr.Version)
+}
+
+func (r *pullRequestResolver) CreatedAt() *string {
+	return formatTime(r.pr.CreatedAt)
+}
+
+func (r *pullRequestResolver) MergedAt() *string {
+	return formatTime(r.pr.MergedAt)
+}
+
+func (r *pullRequestResolver) Author(ctx context.Context) (*userResolver, error) {
+	l := loadersFrom(ctx)
+
+	// Fetching the queued reviewers first lets their users share a batch
+	// with the authors.
+	if err := l.reviewers.flush(ctx); err != nil {
+		return nil, err
+	}
+
+	author, err := l.users.load(ctx, r.pr.AuthorID)
+	if err != nil || author == nil {
+		return nil, err
+	}
+	return &userResolver{user: author}, nil
+}
+
+// Reviewers skips reviewers whose user record no longer exists.
+func (r *pullRequestResolver) Reviewers(ctx context.Context) ([]*userResolver, error) {
+	l := loadersFrom(ctx)
+
+	reviewerIDs, err := l.reviewers.load(ctx, r.pr.PullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := l.users.loadMany(ctx, reviewerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*userResolver, 0, len(users))
+	for _, user := range users {
+		if user != nil {
+			result = append(result, &userResolver{user: user})
+		}
+	}
+	return result, nil
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+const requestIDHeader = "x-request-id"
+
+// mapDomainError is the gRPC counterpart of the HTTP mapDomainError: not
+// found errors become NotFound, state conflicts FailedPrecondition. Unknown
+// errors are returned as is and hidden by errorInterceptor.
+func mapDomainError(err error) error {
+	var validationErr *domain.ValidationError
+
+	switch {
+	case errors.As(err, &validationErr):
+		return validationStatus(validationErr)
+
+	case errors.Is(err, domain.ErrTeamAlreadyExists):
+		return status.Error(codes.AlreadyExists, "team_name already exists")
+
+	case errors.Is(err, domain.ErrTeamNotFound):
+		return status.Error(codes.NotFound, "team not found")
+
+	case errors.Is(err, domain.ErrParentTeamNotFound):
+		return status.Error(codes.NotFound, "parent team not found")
+
+	case errors.Is(err, domain.ErrTeamHierarchyCycle):
+		return status.Error(codes.FailedPrecondition, "team cannot be nested under itself or its descendant")
+
+	case errors.Is(err, domain.ErrNotTeamLead):
+		return status.Error(codes.PermissionDenied, "only team leads can change team settings")
+
+	case errors.Is(err, domain.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+
+	case errors.Is(err, domain.ErrNotTeamMember):
+		return status.Error(codes.FailedPrecondition, "user is not a member of the team")
+
+	case errors.Is(err, domain.ErrPRAlreadyExists):
+		return status.Error(codes.AlreadyExists, "PR id already exists")
+
+	case errors.Is(err, domain.ErrPRNotFound):
+		return status.Error(codes.NotFound, "pull request not found")
+
+	case errors.Is(err, domain.ErrPRMerged):
+		return status.Error(codes.FailedPrecondition, "cannot reassign on merged PR")
+
+	case errors.Is(err, domain.ErrReviewerNotAssigned):
+		return status.Error(codes.FailedPrecondition, "reviewer is not assigned to this PR")
+
+	case errors.Is(err, domain.ErrPRVersionMismatch):
+		return status.Error(codes.Aborted, "pull request was modified, fetch it again and retry with the new version")
+
+	case errors.Is(err, domain.ErrNoCandidates):
+		return status.Error(codes.FailedPrecondition, "no active replacement candidate in team")
+
+	default:
+		return err
+	}
+}
+
+// errorInterceptor is the gRPC counterpart of the HTTP RequestID middleware
+// and internalError: it takes the request ID from the x-request-id metadata or
+// generates one, echoes it in the response header and replaces any error that
+// is not a gRPC status with a generic Internal one after logging it.
+func errorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	requestID := incomingRequestID(ctx)
+	// Fails only if the header has already been sent, which cannot happen
+	// before the handler runs.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+	resp, err := handler(ctx, req)
+	if _, ok := status.FromError(err); ok {
+		return resp, err
+	}
+
+	log.Printf("request %s: %s: %v", requestID, info.FullMethod, err)
+	return nil, status.Errorf(codes.Internal, "internal server error (request_id %s)", requestID)
+}
+
+func incomingRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestIDHeader); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// validationStatus carries the failed field and rule as BadRequest details,
+// as the HTTP API lists them in the error body.
+func validationStatus(err *domain.ValidationError) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       err.Field,
+			Description: err.Message,
+			Reason:      err.Rule,
+		}},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func invalidArgument(msg string) error {
+	return status.Error(codes.InvalidArgument, msg)
+}
@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+func TestMapDomainError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+		msg  string
+	}{
+		{name: "team not found", err: domain.ErrTeamNotFound, code: codes.NotFound, msg: "team not found"},
+		{name: "wrapped user not found", err: fmt.Errorf("get user: %w", domain.ErrUserNotFound), code: codes.NotFound, msg: "user not found"},
+		{name: "PR not found", err: domain.ErrPRNotFound, code: codes.NotFound, msg: "pull request not found"},
+		{name: "team exists", err: domain.ErrTeamAlreadyExists, code: codes.AlreadyExists, msg: "team_name already exists"},
+		{name: "PR exists", err: domain.ErrPRAlreadyExists, code: codes.AlreadyExists, msg: "PR id already exists"},
+		{name: "merged PR", err: domain.ErrPRMerged, code: codes.FailedPrecondition, msg: "cannot reassign on merged PR"},
+		{name: "no candidates", err: domain.ErrNoCandidates, code: codes.FailedPrecondition, msg: "no active replacement candidate in team"},
+		{name: "hierarchy cycle", err: domain.ErrTeamHierarchyCycle, code: codes.FailedPrecondition},
+		{name: "not a lead", err: domain.ErrNotTeamLead, code: codes.PermissionDenied},
+		{name: "version mismatch", err: domain.ErrPRVersionMismatch, code: codes.Aborted},
+		{name: "validation", err: domain.RequiredError("team_name"), code: codes.InvalidArgument, msg: "team_name is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(mapDomainError(tt.err))
+			require.True(t, ok)
+			assert.Equal(t, tt.code, st.Code())
+			if tt.msg != "" {
+				assert.Equal(t, tt.msg, st.Message())
+			}
+		})
+	}
+
+	t.Run("validation error carries field details", func(t *testing.T) {
+		err := mapDomainError(domain.NewValidationError("username", "max", "must be at most 100 characters"))
+
+		st := status.Convert(err)
+		require.Len(t, st.Details(), 1)
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.GetFieldViolations(), 1)
+		violation := badRequest.GetFieldViolations()[0]
+		assert.Equal(t, "username", violation.GetField())
+		assert.Equal(t, "max", violation.GetReason())
+		assert.Equal(t, "must be at most 100 characters", violation.GetDescription())
+	})
+
+	t.Run("unknown errors are left for the interceptor", func(t *testing.T) {
+		err := errors.New("connection refused")
+
+		assert.Equal(t, err, mapDomainError(err))
+	})
+}
+
+func TestErrorInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/pr.v1.TeamService/GetTeam"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDHeader, "req-1"))
+
+	t.Run("status errors pass through", func(t *testing.T) {
+		handler := func(context.Context, any) (any, error) {
+			return nil, status.Error(codes.NotFound, "team not found")
+		}
+
+		_, err := errorInterceptor(ctx, nil, info, handler)
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("other errors become internal without the cause", func(t *testing.T) {
+		handler := func(context.Context, any) (any, error) {
+			return "partial", errors.New("pq: password authentication failed")
+		}
+
+		resp, err := errorInterceptor(ctx, nil, info, handler)
+
+		assert.Nil(t, resp)
+		st := status.Convert(err)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, "internal server error (request_id req-1)", st.Message())
+		assert.NotContains(t, st.Message(), "password")
+	})
+
+	t.Run("successful calls are returned as is", func(t *testing.T) {
+		handler := func(context.Context, any) (any, error) {
+			return "ok", nil
+		}
+
+		resp, err := errorInterceptor(ctx, nil, info, handler)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/api/prv1"
+)
+
+type pullRequestServer struct {
+	prv1.UnimplementedPullRequestServiceServer
+
+	prUC usecase.PRUseCase
+}
+
+func (s *pullRequestServer) CreatePullRequest(ctx context.Context, req *prv1.CreatePullRequestRequest) (*prv1.PullRequest, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, invalidArgument("pull_request_id is required")
+	}
+	if req.GetPullRequestName() == "" {
+		return nil, invalidArgument("pull_request_name is required")
+	}
+	if req.GetAuthorId() == "" {
+		return nil, invalidArgument("author_id is required")
+	}
+
+	pr, err := s.prUC.CreatePR(ctx, usecase.CreatePRRequest{
+		PullRequestID:   req.GetPullRequestId(),
+		PullRequestName: req.GetPullRequestName(),
+		AuthorID:        req.GetAuthorId(),
+		TeamName:        req.GetTeamName(),
+	})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return toPullRequest(pr), nil
+}
+
+func (s *pullRequestServer) MergePullRequest(ctx context.Context, req *prv1.MergePullRequestRequest) (*prv1.PullRequest, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, invalidArgument("pull_request_id is required")
+	}
+
+	pr, err := s.prUC.MergePR(ctx, usecase.MergePRRequest{
+		PullRequestID:   req.GetPullRequestId(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return toPullRequest(pr), nil
+}
+
+func (s *pullRequestServer) ReassignReviewer(ctx context.Context, req *prv1.ReassignReviewerRequest) (*prv1.ReassignReviewerResponse, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, invalidArgument("pull_request_id is required")
+	}
+	if req.GetOldReviewerId() == "" {
+		return nil, invalidArgument("old_reviewer_id is required")
+	}
+
+	result, err := s.prUC.ReassignReviewer(ctx, usecase.ReassignReviewerRequest{
+		PullRequestID:   req.GetPullRequestId(),
+		OldReviewerID:   req.GetOldReviewerId(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return &prv1.ReassignReviewerResponse{
+		PullRequest: toPullRequest(result.PullRequest),
+		ReplacedBy:  result.ReplacedBy,
+	}, nil
+}
+
+func toPRStatus(status domain.PRStatus) prv1.PullRequestStatus {
+	switch status {
+	case domain.PRStatusOpen:
+		return prv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case domain.PRStatusMerged:
+		return prv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	default:
+		return prv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+func toPullRequest(pr *domain.PullRequest) *prv1.PullRequest {
+	return &prv1.PullRequest{
+		PullRequestId:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorID,
+		TeamName:          pr.TeamName,
+		Status:            toPRStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         toTimestamp(pr.CreatedAt),
+		MergedAt:          toTimestamp(pr.MergedAt),
+		Version:           pr.Version,
+	}
+}
+
+func toPullRequestShortList(prs []domain.PullRequestShort) []*prv1.PullRequestShort {
+	result := make([]*prv1.PullRequestShort, len(prs))
+	for i, pr := range prs {
+		result[i] = &prv1.PullRequestShort{
+			PullRequestId:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorID,
+			Status:          toPRStatus(pr.Status),
+		}
+	}
+	return result
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
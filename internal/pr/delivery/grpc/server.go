@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/api/prv1"
+)
+
+// NewServer registers the pr.v1 services on top of the same usecases the
+// HTTP handlers use.
+func NewServer(teamUC usecase.TeamUseCase, userUC usecase.UserUseCase, prUC usecase.PRUseCase, statsUC usecase.StatsUseCase) *grpc.Server {
+	s := grpc.NewServer(grpc.UnaryInterceptor(errorInterceptor))
+
+	prv1.RegisterTeamServiceServer(s, &teamServer{teamUC: teamUC})
+	prv1.RegisterUserServiceServer(s, &userServer{userUC: userUC, prUC: prUC})
+	prv1.RegisterPullRequestServiceServer(s, &pullRequestServer{prUC: prUC})
+	prv1.RegisterStatsServiceServer(s, &statsServer{statsUC: statsUC})
+
+	reflection.Register(s)
+
+	return s
+}
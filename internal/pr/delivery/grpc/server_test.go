@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/api/prv1"
+)
+
+// serverPRUseCase creates any PR except pr-taken and fails every merge with an
+// unexpected error.
+type serverPRUseCase struct {
+	usecase.PRUseCase
+}
+
+func (u *serverPRUseCase) CreatePR(_ context.Context, req usecase.CreatePRRequest) (*domain.PullRequest, error) {
+	if req.PullRequestID == "pr-taken" {
+		return nil, domain.ErrPRAlreadyExists
+	}
+	createdAt := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+	return &domain.PullRequest{
+		PullRequestID:     req.PullRequestID,
+		PullRequestName:   req.PullRequestName,
+		AuthorID:          req.AuthorID,
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: []string{"u2", "u3"},
+		CreatedAt:         &createdAt,
+		Version:           1,
+	}, nil
+}
+
+func (u *serverPRUseCase) MergePR(context.Context, usecase.MergePRRequest) (*domain.PullRequest, error) {
+	return nil, errors.New("connection reset by peer")
+}
+
+func TestServer(t *testing.T) {
+	listener := bufconn.Listen(1 << 20)
+	server := NewServer(nil, nil, &serverPRUseCase{}, nil)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	client := prv1.NewPullRequestServiceClient(conn)
+	ctx := context.Background()
+
+	t.Run("create returns the PR and the request ID", func(t *testing.T) {
+		var header metadata.MD
+		callCtx := metadata.AppendToOutgoingContext(ctx, requestIDHeader, "req-42")
+
+		pr, err := client.CreatePullRequest(callCtx, &prv1.CreatePullRequestRequest{
+			PullRequestId:   "pr-1",
+			PullRequestName: "Add search",
+			AuthorId:        "u1",
+		}, grpc.Header(&header))
+
+		require.NoError(t, err)
+		assert.Equal(t, "pr-1", pr.GetPullRequestId())
+		assert.Equal(t, prv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN, pr.GetStatus())
+		assert.Equal(t, []string{"u2", "u3"}, pr.GetAssignedReviewers())
+		assert.Equal(t, int64(1), pr.GetVersion())
+		assert.Equal(t, []string{"req-42"}, header.Get(requestIDHeader))
+	})
+
+	t.Run("missing field is an invalid argument", func(t *testing.T) {
+		_, err := client.CreatePullRequest(ctx, &prv1.CreatePullRequestRequest{PullRequestName: "Add search", AuthorId: "u1"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("domain error is mapped", func(t *testing.T) {
+		_, err := client.CreatePullRequest(ctx, &prv1.CreatePullRequestRequest{
+			PullRequestId:   "pr-taken",
+			PullRequestName: "Add search",
+			AuthorId:        "u1",
+		})
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("unexpected error is hidden", func(t *testing.T) {
+		_, err := client.MergePullRequest(ctx, &prv1.MergePullRequestRequest{PullRequestId: "pr-broken"})
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.NotContains(t, st.Message(), "connection reset")
+	})
+}
@@ -0,0 +1,367 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/api/prv1"
+)
+
+type statsServer struct {
+	prv1.UnimplementedStatsServiceServer
+
+	statsUC usecase.StatsUseCase
+}
+
+func (s *statsServer) GetUserStats(ctx context.Context, req *prv1.GetUserStatsRequest) (*prv1.GetUserStatsResponse, error) {
+	filter, err := fromStatsFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsUC.GetUserAssignmentStats(ctx, filter)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	users := toUserAssignmentStats(stats)
+	for i, s := range stats {
+		users[i].ReviewsMerged = s.ReviewsMerged
+		users[i].MeanTimeInReview = durationpb.New(s.MeanTimeInReview)
+	}
+	return &prv1.GetUserStatsResponse{Users: users}, nil
+}
+
+func (s *statsServer) GetPullRequestStats(ctx context.Context, req *prv1.GetPullRequestStatsRequest) (*prv1.PullRequestStats, error) {
+	filter, err := fromStatsFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsUC.GetPRStats(ctx, filter)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return &prv1.PullRequestStats{
+		Total:  stats.TotalPRs,
+		Open:   stats.OpenPRs,
+		Merged: stats.MergedPRs,
+	}, nil
+}
+
+func (s *statsServer) GetReviewerWorkload(ctx context.Context, req *prv1.GetReviewerWorkloadRequest) (*prv1.GetReviewerWorkloadResponse, error) {
+	filter, err := fromStatsFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	var sort domain.WorkloadSort
+	switch req.GetSort() {
+	case prv1.WorkloadSort_WORKLOAD_SORT_UNSPECIFIED, prv1.WorkloadSort_WORKLOAD_SORT_OPEN_PRS:
+		sort = domain.WorkloadSortOpenPRs
+	case prv1.WorkloadSort_WORKLOAD_SORT_OLDEST_OPEN:
+		sort = domain.WorkloadSortOldestOpen
+	case prv1.WorkloadSort_WORKLOAD_SORT_RECENT_ASSIGNMENTS:
+		sort = domain.WorkloadSortRecentAssignments
+	case prv1.WorkloadSort_WORKLOAD_SORT_USERNAME:
+		sort = domain.WorkloadSortUsername
+	default:
+		return nil, invalidArgument("unknown sort")
+	}
+
+	workload, err := s.statsUC.GetReviewerWorkload(ctx, filter, sort)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	out := make([]*prv1.ReviewerWorkload, len(workload))
+	for i, w := range workload {
+		out[i] = &prv1.ReviewerWorkload{
+			UserId:                w.UserID,
+			Username:              w.Username,
+			TeamName:              w.TeamName,
+			OpenPrsCount:          w.OpenPRsCount,
+			OldestOpenAge:         durationpb.New(w.OldestOpenAge),
+			AssignmentsLast_7Days: w.AssignmentsLast7Days,
+		}
+	}
+	return &prv1.GetReviewerWorkloadResponse{Reviewers: out}, nil
+}
+
+func (s *statsServer) GetTeamStats(ctx context.Context, req *prv1.GetTeamStatsRequest) (*prv1.GetTeamStatsResponse, error) {
+	filter, err := fromStatsFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsUC.GetTeamRollupStats(ctx, filter)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	out := make([]*prv1.TeamRollupStats, len(stats))
+	for i, t := range stats {
+		out[i] = &prv1.TeamRollupStats{
+			TeamName:         t.TeamName,
+			ParentTeamName:   t.ParentTeamName,
+			TeamsCount:       t.TeamsCount,
+			MembersCount:     t.MembersCount,
+			AssignmentsCount: t.AssignmentsCount,
+			OpenPrs:          t.OpenPRs,
+			MergedPrs:        t.MergedPRs,
+		}
+	}
+	return &prv1.GetTeamStatsResponse{Teams: out}, nil
+}
+
+func (s *statsServer) GetReviewerPairs(ctx context.Context, req *prv1.GetReviewerPairsRequest) (*prv1.GetReviewerPairsResponse, error) {
+	filter, err := fromStatsFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := s.statsUC.GetReviewerPairs(ctx, filter)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	out := make([]*prv1.ReviewerPair, len(pairs))
+	for i, p := range pairs {
+		out[i] = &prv1.ReviewerPair{
+			AuthorId:         p.AuthorID,
+			AuthorUsername:   p.AuthorUsername,
+			ReviewerId:       p.ReviewerID,
+			ReviewerUsername: p.ReviewerUsername,
+			ReviewsCount:     p.ReviewsCount,
+		}
+	}
+	return &prv1.GetReviewerPairsResponse{Pairs: out}, nil
+}
+
+func (s *statsServer) GetFairnessReport(ctx context.Context, req *prv1.GetFairnessReportRequest) (*prv1.GetFairnessReportResponse, error) {
+	filter, err := fromStatsFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := float64(domain.DefaultFairnessThresholdPct)
+	if req.ThresholdPct != nil {
+		threshold = req.GetThresholdPct()
+		if threshold < 0 || math.IsInf(threshold, 0) || math.IsNaN(threshold) {
+			return nil, invalidArgument("threshold_pct must be a non-negative number")
+		}
+	}
+
+	report, err := s.statsUC.GetFairnessReport(ctx, filter, threshold)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	out := make([]*prv1.TeamFairness, len(report))
+	for i, t := range report {
+		out[i] = &prv1.TeamFairness{
+			TeamName:          t.TeamName,
+			MembersCount:      int64(t.MembersCount),
+			TotalAssignments:  t.TotalAssignments,
+			MinAssignments:    t.Min,
+			MaxAssignments:    t.Max,
+			MeanAssignments:   t.Mean,
+			StddevAssignments: t.StdDev,
+			Gini:              t.Gini,
+			OverloadedMembers: toUserAssignmentStats(t.Overloaded),
+		}
+	}
+	return &prv1.GetFairnessReportResponse{
+		ThresholdPct: threshold,
+		Teams:        out,
+	}, nil
+}
+
+func (s *statsServer) GetCycleTimeStats(ctx context.Context, req *prv1.GetCycleTimeStatsRequest) (*prv1.CycleTimeStats, error) {
+	filter, err := fromStatsFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetBuckets()) > domain.MaxCycleTimeBuckets {
+		return nil, invalidArgument(fmt.Sprintf("at most %d buckets are allowed", domain.MaxCycleTimeBuckets))
+	}
+	var buckets []time.Duration
+	for i, b := range req.GetBuckets() {
+		d := b.AsDuration()
+		if b.CheckValid() != nil || d <= 0 {
+			return nil, invalidArgument(fmt.Sprintf("invalid bucket at index %d", i))
+		}
+		if i > 0 && d <= buckets[i-1] {
+			return nil, invalidArgument("buckets must be in ascending order")
+		}
+		buckets = append(buckets, d)
+	}
+
+	stats, err := s.statsUC.GetCycleTimeStats(ctx, filter, buckets)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	out := &prv1.CycleTimeStats{
+		Teams:     make([]*prv1.TeamCycleTime, len(stats.Teams)),
+		Authors:   make([]*prv1.AuthorCycleTime, len(stats.Authors)),
+		Histogram: make([]*prv1.CycleTimeBucket, len(stats.Histogram)),
+	}
+	for i, t := range stats.Teams {
+		out.Teams[i] = &prv1.TeamCycleTime{
+			TeamName: t.TeamName,
+			Summary:  toCycleTimeSummary(t.CycleTimeSummary),
+		}
+	}
+	for i, a := range stats.Authors {
+		out.Authors[i] = &prv1.AuthorCycleTime{
+			UserId:   a.UserID,
+			Username: a.Username,
+			TeamName: a.TeamName,
+			Summary:  toCycleTimeSummary(a.CycleTimeSummary),
+		}
+	}
+	for i, b := range stats.Histogram {
+		out.Histogram[i] = &prv1.CycleTimeBucket{
+			From:  durationpb.New(b.From),
+			Count: b.Count,
+		}
+		if b.To != nil {
+			out.Histogram[i].To = durationpb.New(*b.To)
+		}
+	}
+	return out, nil
+}
+
+func (s *statsServer) GetTimeSeries(ctx context.Context, req *prv1.GetTimeSeriesRequest) (*prv1.GetTimeSeriesResponse, error) {
+	filter, err := fromStatsFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	interval := req.GetInterval()
+	var domainInterval domain.StatsInterval
+	switch interval {
+	case prv1.StatsInterval_STATS_INTERVAL_UNSPECIFIED, prv1.StatsInterval_STATS_INTERVAL_DAY:
+		interval = prv1.StatsInterval_STATS_INTERVAL_DAY
+		domainInterval = domain.StatsIntervalDay
+	case prv1.StatsInterval_STATS_INTERVAL_WEEK:
+		domainInterval = domain.StatsIntervalWeek
+	case prv1.StatsInterval_STATS_INTERVAL_MONTH:
+		domainInterval = domain.StatsIntervalMonth
+	default:
+		return nil, invalidArgument("unknown interval")
+	}
+
+	points, err := s.statsUC.GetTimeSeries(ctx, filter, domainInterval)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	out := make([]*prv1.TimeSeriesPoint, len(points))
+	for i, p := range points {
+		out[i] = &prv1.TimeSeriesPoint{
+			Bucket:      timestamppb.New(p.Bucket),
+			PrsOpened:   p.PRsOpened,
+			PrsMerged:   p.PRsMerged,
+			Assignments: p.Assignments,
+		}
+	}
+	return &prv1.GetTimeSeriesResponse{
+		Interval: interval,
+		Points:   out,
+	}, nil
+}
+
+func (s *statsServer) GetStalePullRequests(ctx context.Context, req *prv1.GetStalePullRequestsRequest) (*prv1.GetStalePullRequestsResponse, error) {
+	filter, err := fromStatsFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	var olderThan time.Duration
+	if d := req.GetOlderThan(); d != nil {
+		olderThan = d.AsDuration()
+		if d.CheckValid() != nil || olderThan <= 0 {
+			return nil, invalidArgument("older_than must be a positive duration")
+		}
+	}
+
+	prs, err := s.statsUC.GetStalePRs(ctx, filter, olderThan)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	out := make([]*prv1.StalePullRequest, len(prs))
+	for i, pr := range prs {
+		out[i] = &prv1.StalePullRequest{
+			PullRequestId:     pr.PullRequestID,
+			PullRequestName:   pr.PullRequestName,
+			AuthorId:          pr.AuthorID,
+			TeamName:          pr.TeamName,
+			AssignedReviewers: pr.AssignedReviewers,
+			CreatedAt:         timestamppb.New(pr.CreatedAt),
+			Age:               durationpb.New(pr.Age),
+			Threshold:         durationpb.New(pr.Threshold),
+			FlaggedAt:         toTimestamp(pr.FlaggedAt),
+		}
+	}
+	return &prv1.GetStalePullRequestsResponse{PullRequests: out}, nil
+}
+
+func fromStatsFilter(filter *prv1.StatsFilter) (domain.StatsFilter, error) {
+	result := domain.StatsFilter{
+		TeamName: filter.GetTeamName(),
+	}
+
+	if ts := filter.GetFrom(); ts != nil {
+		if err := ts.CheckValid(); err != nil {
+			return result, invalidArgument("from must be a valid timestamp")
+		}
+		from := ts.AsTime()
+		result.From = &from
+	}
+	if ts := filter.GetTo(); ts != nil {
+		if err := ts.CheckValid(); err != nil {
+			return result, invalidArgument("to must be a valid timestamp")
+		}
+		to := ts.AsTime()
+		result.To = &to
+	}
+
+	if result.From != nil && result.To != nil && !result.From.Before(*result.To) {
+		return result, invalidArgument("from must be before to")
+	}
+
+	return result, nil
+}
+
+func toUserAssignmentStats(stats []domain.UserAssignmentStats) []*prv1.UserAssignmentStats {
+	result := make([]*prv1.UserAssignmentStats, len(stats))
+	for i, s := range stats {
+		result[i] = &prv1.UserAssignmentStats{
+			UserId:           s.UserID,
+			Username:         s.Username,
+			TeamName:         s.TeamName,
+			AssignmentsCount: s.AssignmentsCount,
+		}
+	}
+	return result
+}
+
+func toCycleTimeSummary(s domain.CycleTimeSummary) *prv1.CycleTimeSummary {
+	return &prv1.CycleTimeSummary{
+		MergedPrs: s.MergedPRs,
+		Mean:      durationpb.New(s.Mean),
+		Median:    durationpb.New(s.Median),
+		P90:       durationpb.New(s.P90),
+		P99:       durationpb.New(s.P99),
+	}
+}
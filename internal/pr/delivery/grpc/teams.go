@@ -0,0 +1,212 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/api/prv1"
+)
+
+type teamServer struct {
+	prv1.UnimplementedTeamServiceServer
+
+	teamUC usecase.TeamUseCase
+}
+
+func (s *teamServer) CreateTeam(ctx context.Context, req *prv1.CreateTeamRequest) (*prv1.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+	if len(req.GetMembers()) == 0 {
+		return nil, invalidArgument("members are required")
+	}
+
+	settings, err := fromTeamSettings(req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
+
+	usecaseReq := usecase.CreateTeamRequest{
+		TeamName:       req.GetTeamName(),
+		ParentTeamName: req.GetParentTeamName(),
+		Settings:       settings,
+		Members:        make([]usecase.CreateTeamMember, len(req.GetMembers())),
+	}
+	for i, m := range req.GetMembers() {
+		if m.GetUserId() == "" {
+			return nil, invalidArgument(fmt.Sprintf("member user_id is required at index %d", i))
+		}
+		if m.GetUsername() == "" {
+			return nil, invalidArgument(fmt.Sprintf("member username is required at index %d", i))
+		}
+		usecaseReq.Members[i] = usecase.CreateTeamMember{
+			UserID:   m.GetUserId(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+			Role:     fromTeamRole(m.GetRole()),
+		}
+	}
+
+	team, err := s.teamUC.CreateTeam(ctx, usecaseReq)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return toTeam(team), nil
+}
+
+func (s *teamServer) GetTeam(ctx context.Context, req *prv1.GetTeamRequest) (*prv1.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+
+	team, err := s.teamUC.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return toTeam(team), nil
+}
+
+func (s *teamServer) GetTeamTree(ctx context.Context, req *prv1.GetTeamTreeRequest) (*prv1.GetTeamTreeResponse, error) {
+	tree, err := s.teamUC.GetTeamTree(ctx, req.GetRootTeamName())
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return &prv1.GetTeamTreeResponse{Teams: toTeamTreeNodes(tree)}, nil
+}
+
+func (s *teamServer) SetParentTeam(ctx context.Context, req *prv1.SetParentTeamRequest) (*prv1.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+
+	team, err := s.teamUC.SetParentTeam(ctx, usecase.SetParentTeamRequest{
+		TeamName:       req.GetTeamName(),
+		ParentTeamName: req.GetParentTeamName(),
+		ActorID:        req.GetActorId(),
+	})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return toTeam(team), nil
+}
+
+func (s *teamServer) AddTeamMember(ctx context.Context, req *prv1.AddTeamMemberRequest) (*prv1.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	team, err := s.teamUC.AddMember(ctx, usecase.AddTeamMemberRequest{
+		TeamName: req.GetTeamName(),
+		UserID:   req.GetUserId(),
+		Role:     fromTeamRole(req.GetRole()),
+		ActorID:  req.GetActorId(),
+	})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return toTeam(team), nil
+}
+
+func (s *teamServer) UpdateTeamSettings(ctx context.Context, req *prv1.UpdateTeamSettingsRequest) (*prv1.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+
+	settings, err := fromTeamSettings(req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamUC.UpdateSettings(ctx, usecase.UpdateTeamSettingsRequest{
+		TeamName: req.GetTeamName(),
+		Settings: settings,
+		ActorID:  req.GetActorId(),
+	})
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return toTeam(team), nil
+}
+
+func fromTeamSettings(settings *prv1.TeamSettings) (domain.TeamSettings, error) {
+	result := domain.TeamSettings{
+		RequireLeadReview: settings.GetRequireLeadReview(),
+	}
+	if d := settings.GetStaleAfter(); d != nil {
+		if err := d.CheckValid(); err != nil || d.AsDuration() < 0 {
+			return result, invalidArgument("stale_after must be a non-negative duration")
+		}
+		result.StaleAfter = d.AsDuration()
+	}
+	return result, nil
+}
+
+func fromTeamRole(role prv1.TeamRole) domain.TeamRole {
+	switch role {
+	case prv1.TeamRole_TEAM_ROLE_LEAD:
+		return domain.TeamRoleLead
+	case prv1.TeamRole_TEAM_ROLE_MEMBER:
+		return domain.TeamRoleMember
+	case prv1.TeamRole_TEAM_ROLE_OBSERVER:
+		return domain.TeamRoleObserver
+	default:
+		return ""
+	}
+}
+
+func toTeamRole(role domain.TeamRole) prv1.TeamRole {
+	switch role {
+	case domain.TeamRoleLead:
+		return prv1.TeamRole_TEAM_ROLE_LEAD
+	case domain.TeamRoleMember:
+		return prv1.TeamRole_TEAM_ROLE_MEMBER
+	case domain.TeamRoleObserver:
+		return prv1.TeamRole_TEAM_ROLE_OBSERVER
+	default:
+		return prv1.TeamRole_TEAM_ROLE_UNSPECIFIED
+	}
+}
+
+func toTeam(team *domain.Team) *prv1.Team {
+	members := make([]*prv1.TeamMember, len(team.Members))
+	for i, m := range team.Members {
+		members[i] = &prv1.TeamMember{
+			UserId:   m.UserID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+			Role:     toTeamRole(m.Role),
+		}
+	}
+
+	settings := &prv1.TeamSettings{
+		RequireLeadReview: team.Settings.RequireLeadReview,
+	}
+	if team.Settings.StaleAfter > 0 {
+		settings.StaleAfter = durationpb.New(team.Settings.StaleAfter)
+	}
+
+	return &prv1.Team{
+		TeamName:       team.TeamName,
+		ParentTeamName: team.ParentTeamName,
+		Settings:       settings,
+		Members:        members,
+	}
+}
+
+func toTeamTreeNodes(nodes []domain.TeamTreeNode) []*prv1.TeamTreeNode {
+	result := make([]*prv1.TeamTreeNode, len(nodes))
+	for i, n := range nodes {
+		result[i] = &prv1.TeamTreeNode{
+			TeamName: n.TeamName,
+			Children: toTeamTreeNodes(n.Children),
+		}
+	}
+	return result
+}
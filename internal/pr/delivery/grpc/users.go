@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/api/prv1"
+)
+
+type userServer struct {
+	prv1.UnimplementedUserServiceServer
+
+	userUC usecase.UserUseCase
+	prUC   usecase.PRUseCase
+}
+
+func (s *userServer) GetUser(ctx context.Context, req *prv1.GetUserRequest) (*prv1.UserProfile, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	profile, err := s.userUC.GetUserProfile(ctx, req.GetUserId())
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return &prv1.UserProfile{
+		User:                     toUser(&profile.User),
+		Teams:                    profile.Teams,
+		OpenReviewsCount:         profile.OpenReviewsCount,
+		AuthoredOpenPullRequests: toPullRequestShortList(profile.AuthoredOpenPRs),
+	}, nil
+}
+
+// UpdateUser validates every field that is set before writing any of them,
+// like PATCH /v1/users/{id}.
+func (s *userServer) UpdateUser(ctx context.Context, req *prv1.UpdateUserRequest) (*prv1.User, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+	if req.Username == nil && req.IsActive == nil {
+		return nil, invalidArgument("username or is_active is required")
+	}
+
+	var username string
+	if req.Username != nil {
+		var err error
+		username, err = domain.NormalizeUsername(req.GetUsername())
+		if err != nil {
+			return nil, mapDomainError(err)
+		}
+	}
+
+	var user *domain.User
+	if req.Username != nil {
+		var err error
+		user, err = s.userUC.UpdateUser(ctx, usecase.UpdateUserRequest{
+			UserID:   req.GetUserId(),
+			Username: username,
+		})
+		if err != nil {
+			return nil, mapDomainError(err)
+		}
+	}
+	if req.IsActive != nil {
+		var err error
+		user, err = s.userUC.SetIsActive(ctx, usecase.SetUserIsActiveRequest{
+			UserID:   req.GetUserId(),
+			IsActive: req.GetIsActive(),
+		})
+		if err != nil {
+			return nil, mapDomainError(err)
+		}
+	}
+
+	return toUser(user), nil
+}
+
+func (s *userServer) GetReviewerPullRequests(ctx context.Context, req *prv1.GetReviewerPullRequestsRequest) (*prv1.GetReviewerPullRequestsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	prs, err := s.prUC.GetReviewerPRs(ctx, req.GetUserId())
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	return &prv1.GetReviewerPullRequestsResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: toPullRequestShortList(prs),
+	}, nil
+}
+
+func toUser(user *domain.User) *prv1.User {
+	return &prv1.User{
+		UserId:   user.UserID,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	}
+}
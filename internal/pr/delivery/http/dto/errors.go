@@ -1,14 +1,30 @@
 package dto
 
+import "strings"
+
 type ErrorResponse struct {
 	Error ErrorDetail `json:"error"`
 }
 
 type ErrorDetail struct {
-	Code    string `json:"code"`
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Details []FieldError `json:"details,omitempty"`
+	// RequestID is set on INTERNAL_ERROR so that the failure can be found in
+	// the server log.
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// FieldError describes one failed validation rule of a request field. Field
+// is the JSON path of the value, e.g. members[0].user_id.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
 	Message string `json:"message"`
 }
 
+// Error codes are part of the API contract: clients branch on them, so an
+// existing code is never renamed or reused for a different condition.
 const (
 	ErrCodeTeamExists   = "TEAM_EXISTS"
 	ErrCodePRExists     = "PR_EXISTS"
@@ -17,8 +33,37 @@ const (
 	ErrCodeNoCandidate  = "NO_CANDIDATE"
 	ErrCodeNotFound     = "NOT_FOUND"
 	ErrCodeInvalidInput = "INVALID_INPUT"
+
+	ErrCodeHierarchyCycle = "HIERARCHY_CYCLE"
+	ErrCodeNotMember      = "NOT_MEMBER"
+	ErrCodeNotTeamLead    = "NOT_TEAM_LEAD"
+
+	ErrCodeVersionMismatch = "VERSION_MISMATCH"
+
+	ErrCodeIdempotencyKeyReused     = "IDEMPOTENCY_KEY_REUSED"
+	ErrCodeIdempotencyKeyInProgress = "IDEMPOTENCY_KEY_IN_PROGRESS"
+
+	ErrCodeInternal = "INTERNAL_ERROR"
 )
 
+// ErrorCodes is the catalog of every code the API returns.
+var ErrorCodes = []string{
+	ErrCodeTeamExists,
+	ErrCodePRExists,
+	ErrCodePRMerged,
+	ErrCodeNotAssigned,
+	ErrCodeNoCandidate,
+	ErrCodeNotFound,
+	ErrCodeInvalidInput,
+	ErrCodeHierarchyCycle,
+	ErrCodeNotMember,
+	ErrCodeNotTeamLead,
+	ErrCodeVersionMismatch,
+	ErrCodeIdempotencyKeyReused,
+	ErrCodeIdempotencyKeyInProgress,
+	ErrCodeInternal,
+}
+
 func NewErrorResponse(code, message string) ErrorResponse {
 	return ErrorResponse{
 		Error: ErrorDetail{
@@ -27,3 +72,28 @@ func NewErrorResponse(code, message string) ErrorResponse {
 		},
 	}
 }
+
+func NewValidationErrorResponse(details []FieldError) ErrorResponse {
+	messages := make([]string, len(details))
+	for i, d := range details {
+		messages[i] = d.Field + " " + d.Message
+	}
+
+	return ErrorResponse{
+		Error: ErrorDetail{
+			Code:    ErrCodeInvalidInput,
+			Message: strings.Join(messages, "; "),
+			Details: details,
+		},
+	}
+}
+
+func NewInternalErrorResponse(requestID string) ErrorResponse {
+	return ErrorResponse{
+		Error: ErrorDetail{
+			Code:      ErrCodeInternal,
+			Message:   "internal server error",
+			RequestID: requestID,
+		},
+	}
+}
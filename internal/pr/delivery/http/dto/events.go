@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+type Event struct {
+	ID             int64     `json:"id"`
+	Type           string    `json:"type"`
+	TeamName       string    `json:"team_name,omitempty"`
+	PullRequestID  string    `json:"pull_request_id,omitempty"`
+	UserID         string    `json:"user_id,omitempty"`
+	PreviousUserID string    `json:"previous_user_id,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func ToEvent(e domain.Event) Event {
+	return Event{
+		ID:             e.ID,
+		Type:           string(e.Type),
+		TeamName:       e.TeamName,
+		PullRequestID:  e.PullRequestID,
+		UserID:         e.UserID,
+		PreviousUserID: e.PreviousUserID,
+		CreatedAt:      e.CreatedAt.UTC(),
+	}
+}
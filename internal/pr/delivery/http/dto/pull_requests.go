@@ -1,24 +1,30 @@
 package dto
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 )
 
 type CreatePRRequest struct {
-	PullRequestID   string `json:"pull_request_id" validate:"required"`
-	PullRequestName string `json:"pull_request_name" validate:"required"`
-	AuthorID        string `json:"author_id" validate:"required"`
+	PullRequestID   string `json:"pull_request_id" validate:"required,id"`
+	PullRequestName string `json:"pull_request_name" validate:"required,max=255"`
+	AuthorID        string `json:"author_id" validate:"required,id"`
+	TeamName        string `json:"team_name,omitempty" validate:"omitempty,max=100"`
+}
+
+type BatchCreatePRsRequest struct {
+	PullRequests []CreatePRRequest `json:"pull_requests" validate:"required,min=1,max=1000"`
 }
 
 type MergePRRequest struct {
-	PullRequestID string `json:"pull_request_id" validate:"required"`
+	PullRequestID string `json:"pull_request_id" validate:"required,id"`
 }
 
 type ReassignReviewerRequest struct {
-	PullRequestID string `json:"pull_request_id" validate:"required"`
-	OldReviewerID string `json:"old_reviewer_id" validate:"required"`
+	PullRequestID string `json:"pull_request_id" validate:"required,id"`
+	OldReviewerID string `json:"old_reviewer_id" validate:"required,id"`
 }
 
 type PRResponse struct {
@@ -40,6 +46,23 @@ type ReassignReviewerResponse struct {
 	ReplacedBy string      `json:"replaced_by"`
 }
 
+// BatchCreatePRsResponse lists the outcome of every item in request order.
+type BatchCreatePRsResponse struct {
+	Created int                   `json:"created"`
+	Failed  int                   `json:"failed"`
+	Results []BatchCreatePRResult `json:"results"`
+}
+
+// BatchCreatePRResult carries either the created PR or the error the item
+// would get from /pullRequest/create, with Status being that response code.
+type BatchCreatePRResult struct {
+	Index         int          `json:"index"`
+	PullRequestID string       `json:"pull_request_id"`
+	Status        int          `json:"status"`
+	PR            *PullRequest `json:"pr,omitempty"`
+	Error         *ErrorDetail `json:"error,omitempty"`
+}
+
 type GetReviewerPRsResponse struct {
 	UserID       string             `json:"user_id"`
 	PullRequests []PullRequestShort `json:"pull_requests"`
@@ -89,7 +112,45 @@ func ToReassignResponse(pr *domain.PullRequest, replacedBy string) ReassignRevie
 	}
 }
 
+func BatchCreated(index int, pr *domain.PullRequest) BatchCreatePRResult {
+	created := toPullRequest(pr)
+	return BatchCreatePRResult{
+		Index:         index,
+		PullRequestID: pr.PullRequestID,
+		Status:        http.StatusCreated,
+		PR:            &created,
+	}
+}
+
+func BatchFailed(index int, prID string, status int, resp ErrorResponse) BatchCreatePRResult {
+	return BatchCreatePRResult{
+		Index:         index,
+		PullRequestID: prID,
+		Status:        status,
+		Error:         &resp.Error,
+	}
+}
+
+func ToBatchCreatePRsResponse(results []BatchCreatePRResult) BatchCreatePRsResponse {
+	response := BatchCreatePRsResponse{Results: results}
+	for _, result := range results {
+		if result.PR != nil {
+			response.Created++
+		} else {
+			response.Failed++
+		}
+	}
+	return response
+}
+
 func ToGetReviewerPRsResponse(userID string, prs []domain.PullRequestShort) GetReviewerPRsResponse {
+	return GetReviewerPRsResponse{
+		UserID:       userID,
+		PullRequests: toPullRequestShortList(prs),
+	}
+}
+
+func toPullRequestShortList(prs []domain.PullRequestShort) []PullRequestShort {
 	prList := make([]PullRequestShort, len(prs))
 	for i, pr := range prs {
 		prList[i] = PullRequestShort{
@@ -99,9 +160,5 @@ func ToGetReviewerPRsResponse(userID string, prs []domain.PullRequestShort) GetR
 			Status:          string(pr.Status),
 		}
 	}
-
-	return GetReviewerPRsResponse{
-		UserID:       userID,
-		PullRequests: prList,
-	}
+	return prList
 }
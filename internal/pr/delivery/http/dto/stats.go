@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 type UserAssignmentStats struct {
 	UserID           string `json:"user_id"`
 	Username         string `json:"username"`
@@ -7,6 +9,14 @@ type UserAssignmentStats struct {
 	AssignmentsCount int64  `json:"assignments_count"`
 }
 
+// UserStats extends the assignment counts with the time the user's reviews
+// took, from assignment to merge, over PRs merged in the range.
+type UserStats struct {
+	UserAssignmentStats
+	ReviewsMerged           int64   `json:"reviews_merged"`
+	MeanTimeInReviewSeconds float64 `json:"mean_time_in_review_seconds"`
+}
+
 type PRStats struct {
 	TotalPRs  int64 `json:"total_prs"`
 	OpenPRs   int64 `json:"open_prs"`
@@ -14,8 +24,91 @@ type PRStats struct {
 }
 
 type ReviewerWorkload struct {
-	UserID       string `json:"user_id"`
-	Username     string `json:"username"`
-	TeamName     string `json:"team_name"`
-	OpenPRsCount int64  `json:"open_prs_count"`
+	UserID               string  `json:"user_id"`
+	Username             string  `json:"username"`
+	TeamName             string  `json:"team_name"`
+	OpenPRsCount         int64   `json:"open_prs_count"`
+	OldestOpenAgeSeconds float64 `json:"oldest_open_age_seconds"`
+	AssignmentsLast7Days int64   `json:"assignments_last_7_days"`
+}
+
+type TeamRollupStats struct {
+	TeamName         string `json:"team_name"`
+	ParentTeamName   string `json:"parent_team_name,omitempty"`
+	TeamsCount       int64  `json:"teams_count"`
+	MembersCount     int64  `json:"members_count"`
+	AssignmentsCount int64  `json:"assignments_count"`
+	OpenPRs          int64  `json:"open_prs"`
+	MergedPRs        int64  `json:"merged_prs"`
+}
+
+type ReviewerPair struct {
+	AuthorID         string `json:"author_id"`
+	AuthorUsername   string `json:"author_username"`
+	ReviewerID       string `json:"reviewer_id"`
+	ReviewerUsername string `json:"reviewer_username"`
+	ReviewsCount     int64  `json:"reviews_count"`
+}
+
+type TeamFairness struct {
+	TeamName         string                `json:"team_name"`
+	MembersCount     int                   `json:"members_count"`
+	TotalAssignments int64                 `json:"total_assignments"`
+	Min              int64                 `json:"min_assignments"`
+	Max              int64                 `json:"max_assignments"`
+	Mean             float64               `json:"mean_assignments"`
+	StdDev           float64               `json:"stddev_assignments"`
+	Gini             float64               `json:"gini"`
+	Overloaded       []UserAssignmentStats `json:"overloaded_members"`
+}
+
+type CycleTimeSummary struct {
+	MergedPRs     int64   `json:"merged_prs"`
+	MeanSeconds   float64 `json:"mean_seconds"`
+	MedianSeconds float64 `json:"median_seconds"`
+	P90Seconds    float64 `json:"p90_seconds"`
+	P99Seconds    float64 `json:"p99_seconds"`
+}
+
+type TeamCycleTime struct {
+	TeamName string `json:"team_name"`
+	CycleTimeSummary
+}
+
+type AuthorCycleTime struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	CycleTimeSummary
+}
+
+type CycleTimeBucket struct {
+	FromSeconds float64  `json:"from_seconds"`
+	ToSeconds   *float64 `json:"to_seconds,omitempty"`
+	Count       int64    `json:"count"`
+}
+
+type CycleTimeStats struct {
+	Teams     []TeamCycleTime   `json:"teams"`
+	Authors   []AuthorCycleTime `json:"authors"`
+	Histogram []CycleTimeBucket `json:"histogram"`
+}
+
+type TimeSeriesPoint struct {
+	Bucket      time.Time `json:"bucket"`
+	PRsOpened   int64     `json:"prs_opened"`
+	PRsMerged   int64     `json:"prs_merged"`
+	Assignments int64     `json:"assignments"`
+}
+
+type StalePR struct {
+	PullRequestID     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	TeamName          string     `json:"team_name"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	CreatedAt         time.Time  `json:"created_at"`
+	AgeSeconds        float64    `json:"age_seconds"`
+	ThresholdSeconds  float64    `json:"threshold_seconds"`
+	FlaggedAt         *time.Time `json:"flagged_at,omitempty"`
 }
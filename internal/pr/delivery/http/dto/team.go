@@ -1,16 +1,44 @@
 package dto
 
-import "github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+import (
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
 
 type CreateTeamRequest struct {
-	TeamName string       `json:"team_name" validate:"required"`
-	Members  []TeamMember `json:"members" validate:"required,min=1"`
+	TeamName          string       `json:"team_name" validate:"required,max=100"`
+	ParentTeamName    string       `json:"parent_team_name,omitempty" validate:"omitempty,max=100"`
+	RequireLeadReview bool         `json:"require_lead_review,omitempty"`
+	StaleAfterHours   int64        `json:"stale_after_hours,omitempty" validate:"min=0"`
+	Members           []TeamMember `json:"members" validate:"required,min=1,dive"`
+}
+
+type AddTeamMemberRequest struct {
+	TeamName string `json:"team_name" validate:"required,max=100"`
+	UserID   string `json:"user_id" validate:"required,id"`
+	Role     string `json:"role,omitempty" validate:"omitempty,oneof=lead member observer"`
+	ActorID  string `json:"actor_id,omitempty" validate:"omitempty,id"`
+}
+
+type SetParentTeamRequest struct {
+	TeamName       string `json:"team_name" validate:"required,max=100"`
+	ParentTeamName string `json:"parent_team_name" validate:"omitempty,max=100"`
+	ActorID        string `json:"actor_id,omitempty" validate:"omitempty,id"`
+}
+
+type UpdateTeamSettingsRequest struct {
+	TeamName          string `json:"team_name" validate:"required,max=100"`
+	RequireLeadReview bool   `json:"require_lead_review"`
+	StaleAfterHours   int64  `json:"stale_after_hours" validate:"min=0"`
+	ActorID           string `json:"actor_id,omitempty" validate:"omitempty,id"`
 }
 
 type TeamMember struct {
-	UserID   string `json:"user_id" validate:"required"`
-	Username string `json:"username" validate:"required"`
+	UserID   string `json:"user_id" validate:"required,id"`
+	Username string `json:"username" validate:"required,max=100"`
 	IsActive bool   `json:"is_active"`
+	Role     string `json:"role,omitempty" validate:"omitempty,oneof=lead member observer"`
 }
 
 type TeamResponse struct {
@@ -18,8 +46,20 @@ type TeamResponse struct {
 }
 
 type Team struct {
-	TeamName string       `json:"team_name"`
-	Members  []TeamMember `json:"members"`
+	TeamName          string       `json:"team_name"`
+	ParentTeamName    string       `json:"parent_team_name,omitempty"`
+	RequireLeadReview bool         `json:"require_lead_review"`
+	StaleAfterHours   int64        `json:"stale_after_hours,omitempty"`
+	Members           []TeamMember `json:"members"`
+}
+
+type TeamTreeResponse struct {
+	Teams []TeamTreeNode `json:"teams"`
+}
+
+type TeamTreeNode struct {
+	TeamName string         `json:"team_name"`
+	Children []TeamTreeNode `json:"children"`
 }
 
 func ToTeamResponse(team *domain.Team) TeamResponse {
@@ -29,13 +69,34 @@ func ToTeamResponse(team *domain.Team) TeamResponse {
 			UserID:   m.UserID,
 			Username: m.Username,
 			IsActive: m.IsActive,
+			Role:     string(m.Role),
 		}
 	}
 
 	return TeamResponse{
 		Team: Team{
-			TeamName: team.TeamName,
-			Members:  members,
+			TeamName:          team.TeamName,
+			ParentTeamName:    team.ParentTeamName,
+			RequireLeadReview: team.Settings.RequireLeadReview,
+			StaleAfterHours:   int64(team.Settings.StaleAfter / time.Hour),
+			Members:           members,
 		},
 	}
 }
+
+func ToTeamTreeResponse(tree []domain.TeamTreeNode) TeamTreeResponse {
+	return TeamTreeResponse{
+		Teams: toTeamTreeNodes(tree),
+	}
+}
+
+func toTeamTreeNodes(nodes []domain.TeamTreeNode) []TeamTreeNode {
+	result := make([]TeamTreeNode, len(nodes))
+	for i, n := range nodes {
+		result[i] = TeamTreeNode{
+			TeamName: n.TeamName,
+			Children: toTeamTreeNodes(n.Children),
+		}
+	}
+	return result
+}
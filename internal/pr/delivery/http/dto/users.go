@@ -3,10 +3,22 @@ package dto
 import "github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 
 type SetUserIsActiveRequest struct {
-	UserID   string `json:"user_id" validate:"required"`
+	UserID   string `json:"user_id" validate:"required,id"`
 	IsActive bool   `json:"is_active"`
 }
 
+type UpdateUserRequest struct {
+	UserID   string `json:"user_id" validate:"required,id"`
+	Username string `json:"username" validate:"required,max=100"`
+}
+
+// PatchUserRequest is the body of PATCH /v1/users/{id}; only the fields that
+// are present are changed.
+type PatchUserRequest struct {
+	Username *string `json:"username,omitempty" validate:"omitempty,max=100"`
+	IsActive *bool   `json:"is_active,omitempty"`
+}
+
 type UserResponse struct {
 	User User `json:"user"`
 }
@@ -28,3 +40,31 @@ func ToUserResponse(user *domain.User) UserResponse {
 		},
 	}
 }
+
+type UserProfileResponse struct {
+	User UserProfile `json:"user"`
+}
+
+type UserProfile struct {
+	UserID                   string             `json:"user_id"`
+	Username                 string             `json:"username"`
+	TeamName                 string             `json:"team_name"`
+	Teams                    []string           `json:"teams"`
+	IsActive                 bool               `json:"is_active"`
+	OpenReviewsCount         int64              `json:"open_reviews_count"`
+	AuthoredOpenPullRequests []PullRequestShort `json:"authored_open_pull_requests"`
+}
+
+func ToUserProfileResponse(profile *domain.UserProfile) UserProfileResponse {
+	return UserProfileResponse{
+		User: UserProfile{
+			UserID:                   profile.UserID,
+			Username:                 profile.Username,
+			TeamName:                 profile.TeamName,
+			Teams:                    profile.Teams,
+			IsActive:                 profile.IsActive,
+			OpenReviewsCount:         profile.OpenReviewsCount,
+			AuthoredOpenPullRequests: toPullRequestShortList(profile.AuthoredOpenPRs),
+		},
+	}
+}
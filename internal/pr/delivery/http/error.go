@@ -2,6 +2,7 @@ package http
 
 import (
 	"errors"
+	"log"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -11,59 +12,130 @@ import (
 )
 
 func mapDomainError(c echo.Context, err error) error {
+	status, resp, ok := domainErrorResponse(err)
+	if !ok {
+		return internalError(c, err)
+	}
+	return c.JSON(status, resp)
+}
+
+// domainErrorResponse translates a domain error into its HTTP status and body;
+// ok is false for errors that are not part of the API contract.
+func domainErrorResponse(err error) (status int, resp dto.ErrorResponse, ok bool) {
+	var validationErr *domain.ValidationError
+
 	switch {
+	case errors.As(err, &validationErr):
+		return http.StatusBadRequest, dto.NewValidationErrorResponse([]dto.FieldError{{
+			Field:   validationErr.Field,
+			Rule:    validationErr.Rule,
+			Message: validationErr.Message,
+		}}), true
+
 	case errors.Is(err, domain.ErrTeamAlreadyExists):
-		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+		return http.StatusBadRequest, dto.NewErrorResponse(
 			dto.ErrCodeTeamExists,
 			"team_name already exists",
-		))
+		), true
 
 	case errors.Is(err, domain.ErrTeamNotFound):
-		return c.JSON(http.StatusNotFound, dto.NewErrorResponse(
+		return http.StatusNotFound, dto.NewErrorResponse(
 			dto.ErrCodeNotFound,
 			"team not found",
-		))
+		), true
+
+	case errors.Is(err, domain.ErrParentTeamNotFound):
+		return http.StatusNotFound, dto.NewErrorResponse(
+			dto.ErrCodeNotFound,
+			"parent team not found",
+		), true
+
+	case errors.Is(err, domain.ErrTeamHierarchyCycle):
+		return http.StatusConflict, dto.NewErrorResponse(
+			dto.ErrCodeHierarchyCycle,
+			"team cannot be nested under itself or its descendant",
+		), true
+
+	case errors.Is(err, domain.ErrNotTeamLead):
+		return http.StatusForbidden, dto.NewErrorResponse(
+			dto.ErrCodeNotTeamLead,
+			"only team leads can change team settings",
+		), true
 
 	case errors.Is(err, domain.ErrUserNotFound):
-		return c.JSON(http.StatusNotFound, dto.NewErrorResponse(
+		return http.StatusNotFound, dto.NewErrorResponse(
 			dto.ErrCodeNotFound,
 			"user not found",
-		))
+		), true
+
+	case errors.Is(err, domain.ErrNotTeamMember):
+		return http.StatusConflict, dto.NewErrorResponse(
+			dto.ErrCodeNotMember,
+			"user is not a member of the team",
+		), true
 
 	case errors.Is(err, domain.ErrPRAlreadyExists):
-		return c.JSON(http.StatusConflict, dto.NewErrorResponse(
+		return http.StatusConflict, dto.NewErrorResponse(
 			dto.ErrCodePRExists,
 			"PR id already exists",
-		))
+		), true
 
 	case errors.Is(err, domain.ErrPRNotFound):
-		return c.JSON(http.StatusNotFound, dto.NewErrorResponse(
+		return http.StatusNotFound, dto.NewErrorResponse(
 			dto.ErrCodeNotFound,
 			"pull request not found",
-		))
+		), true
 
 	case errors.Is(err, domain.ErrPRMerged):
-		return c.JSON(http.StatusConflict, dto.NewErrorResponse(
+		return http.StatusConflict, dto.NewErrorResponse(
 			dto.ErrCodePRMerged,
 			"cannot reassign on merged PR",
-		))
+		), true
 
 	case errors.Is(err, domain.ErrReviewerNotAssigned):
-		return c.JSON(http.StatusConflict, dto.NewErrorResponse(
+		return http.StatusConflict, dto.NewErrorResponse(
 			dto.ErrCodeNotAssigned,
 			"reviewer is not assigned to this PR",
-		))
+		), true
 
 	case errors.Is(err, domain.ErrNoCandidates):
-		return c.JSON(http.StatusConflict, dto.NewErrorResponse(
+		return http.StatusConflict, dto.NewErrorResponse(
 			dto.ErrCodeNoCandidate,
 			"no active replacement candidate in team",
-		))
+		), true
+
+	case errors.Is(err, domain.ErrPRVersionMismatch):
+		return http.StatusPreconditionFailed, dto.NewErrorResponse(
+			dto.ErrCodeVersionMismatch,
+			"pull request was modified, fetch it again and retry with the new ETag",
+		), true
+
+	case errors.Is(err, domain.ErrIdempotencyKeyReused):
+		return http.StatusUnprocessableEntity, dto.NewErrorResponse(
+			dto.ErrCodeIdempotencyKeyReused,
+			"Idempotency-Key was already used for a different request",
+		), true
+
+	case errors.Is(err, domain.ErrIdempotencyKeyInProgress):
+		return http.StatusConflict, dto.NewErrorResponse(
+			dto.ErrCodeIdempotencyKeyInProgress,
+			"request with this Idempotency-Key is still in progress",
+		), true
 
 	default:
-		return c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(
-			"INTERNAL_ERROR",
-			err.Error(),
-		))
+		return 0, dto.ErrorResponse{}, false
 	}
 }
+
+// internalError logs err together with the request ID and hides it from the
+// client, which only gets the ID to quote in a bug report.
+func internalError(c echo.Context, err error) error {
+	return c.JSON(http.StatusInternalServerError, internalErrorResponse(c, err))
+}
+
+// internalErrorResponse logs err and returns the generic body that hides it.
+func internalErrorResponse(c echo.Context, err error) dto.ErrorResponse {
+	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log.Printf("request %s: %s %s: %v", requestID, c.Request().Method, c.Path(), err)
+	return dto.NewInternalErrorResponse(requestID)
+}
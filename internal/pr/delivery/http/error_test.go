@@ -0,0 +1,59 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+func TestMapDomainError(t *testing.T) {
+	serve := func(err error) (*httptest.ResponseRecorder, dto.ErrorResponse) {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/pullRequest/create", nil), rec)
+		c.Response().Header().Set(echo.HeaderXRequestID, "req-42")
+
+		require.NoError(t, mapDomainError(c, err))
+
+		var resp dto.ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return rec, resp
+	}
+
+	t.Run("validation error", func(t *testing.T) {
+		rec, resp := serve(fmt.Errorf("create PR: %w", domain.RequiredError("author_id")))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, dto.ErrCodeInvalidInput, resp.Error.Code)
+		assert.Equal(t, "author_id is required", resp.Error.Message)
+		assert.Equal(t, []dto.FieldError{
+			{Field: "author_id", Rule: "required", Message: "is required"},
+		}, resp.Error.Details)
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		rec, resp := serve(fmt.Errorf("merge PR: %w", domain.ErrPRVersionMismatch))
+
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+		assert.Equal(t, dto.ErrCodeVersionMismatch, resp.Error.Code)
+	})
+
+	t.Run("internal error hides the cause", func(t *testing.T) {
+		rec, resp := serve(errors.New(`pq: relation "pull_requests" does not exist`))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, dto.ErrCodeInternal, resp.Error.Code)
+		assert.Equal(t, "internal server error", resp.Error.Message)
+		assert.Equal(t, "req-42", resp.Error.RequestID)
+		assert.NotContains(t, rec.Body.String(), "pull_requests")
+	})
+}
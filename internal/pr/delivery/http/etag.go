@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// setPRETag exposes the PR version as a strong entity tag, e.g. "3".
+func setPRETag(c echo.Context, pr *domain.PullRequest) {
+	c.Response().Header().Set(headerETag, `"`+strconv.FormatInt(pr.Version, 10)+`"`)
+}
+
+// ifMatchVersion returns the PR version required by the If-Match header, or
+// zero when the header is absent or "*". Only a single ETag previously
+// returned by the API is accepted.
+func ifMatchVersion(c echo.Context) (int64, bool) {
+	raw := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	if raw == "" || raw == "*" {
+		return 0, true
+	}
+
+	if len(raw) < 3 || raw[0] != '"' || raw[len(raw)-1] != '"' {
+		return 0, false
+	}
+	version, err := strconv.ParseInt(raw[1:len(raw)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+	return version, true
+}
+
+func invalidIfMatch(c echo.Context) error {
+	return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+		dto.ErrCodeInvalidInput,
+		`If-Match must be a single ETag returned by the API, e.g. "3"`,
+	))
+}
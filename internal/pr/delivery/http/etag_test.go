@@ -0,0 +1,42 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		header  string
+		version int64
+		ok      bool
+	}{
+		{header: "", version: 0, ok: true},
+		{header: "*", version: 0, ok: true},
+		{header: `"3"`, version: 3, ok: true},
+		{header: ` "12" `, version: 12, ok: true},
+		{header: "3", ok: false},
+		{header: `W/"3"`, ok: false},
+		{header: `"3", "4"`, ok: false},
+		{header: `"0"`, ok: false},
+		{header: `"abc"`, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/merge", nil)
+			if tt.header != "" {
+				req.Header.Set(headerIfMatch, tt.header)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			version, ok := ifMatchVersion(c)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.version, version)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package http
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+// DefaultEventPollInterval is used when NewEventHub gets no interval.
+const DefaultEventPollInterval = time.Second
+
+// eventBuffer is the number of events a stream may fall behind before the
+// hub drops it; the client then reconnects and catches up from the log.
+const eventBuffer = 256
+
+// EventHub polls the event log and fans new events out to the /events
+// streams, so the log is read once per interval however many clients listen.
+type EventHub struct {
+	eventUC  usecase.EventUseCase
+	interval time.Duration
+
+	mu      sync.Mutex
+	started bool
+	stopped bool
+	lastID  int64
+	subs    map[*eventSubscription]struct{}
+}
+
+type eventSubscription struct {
+	filter domain.EventFilter
+	// afterID is the last event the hub had read when the subscription was
+	// made; events receives every later event that matches filter.
+	afterID int64
+	events  chan domain.Event
+}
+
+// NewEventHub polls every interval; zero or less means
+// DefaultEventPollInterval.
+func NewEventHub(eventUC usecase.EventUseCase, interval time.Duration) *EventHub {
+	if interval <= 0 {
+		interval = DefaultEventPollInterval
+	}
+	return &EventHub{
+		eventUC:  eventUC,
+		interval: interval,
+		subs:     make(map[*eventSubscription]struct{}),
+	}
+}
+
+// Run polls the log every interval until ctx is done, then closes every
+// stream so that graceful shutdown does not wait for the clients.
+func (h *EventHub) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := h.poll(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to poll events: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			h.stop()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// stop closes and removes every subscription. Later subscriptions get a
+// closed stream, so their clients reconnect to a running instance.
+func (h *EventHub) stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.stopped = true
+	for sub := range h.subs {
+		close(sub.events)
+		delete(h.subs, sub)
+	}
+}
+
+func (h *EventHub) subscribe(ctx context.Context, filter domain.EventFilter) (*eventSubscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.stopped {
+		sub := &eventSubscription{filter: filter, events: make(chan domain.Event)}
+		close(sub.events)
+		return sub, nil
+	}
+
+	if err := h.start(ctx); err != nil {
+		return nil, err
+	}
+
+	sub := &eventSubscription{
+		filter:  filter,
+		afterID: h.lastID,
+		events:  make(chan domain.Event, eventBuffer),
+	}
+	h.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// listEvents reads the log directly, for streams that resume after a
+// reconnect.
+func (h *EventHub) listEvents(ctx context.Context, filter domain.EventFilter, afterID int64) ([]domain.Event, error) {
+	return h.eventUC.ListEvents(ctx, filter, afterID)
+}
+
+func (h *EventHub) unsubscribe(sub *eventSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.subs, sub)
+}
+
+// start skips the events logged before the hub came up; clients that need
+// them resume from the log with Last-Event-ID. Callers hold h.mu.
+func (h *EventHub) start(ctx context.Context) error {
+	if h.started {
+		return nil
+	}
+
+	lastID, err := h.eventUC.LastEventID(ctx)
+	if err != nil {
+		return err
+	}
+	h.lastID = lastID
+	h.started = true
+	return nil
+}
+
+func (h *EventHub) poll(ctx context.Context) error {
+	h.mu.Lock()
+	err := h.start(ctx)
+	afterID := h.lastID
+	h.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for {
+		events, err := h.eventUC.ListEvents(ctx, domain.EventFilter{}, afterID)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		h.broadcast(events)
+		afterID = events[len(events)-1].ID
+
+		if len(events) < domain.MaxEventPage {
+			return nil
+		}
+	}
+}
+
+// broadcast delivers events to the matching subscriptions. A subscription
+// whose buffer is full is closed and removed instead of blocking the others.
+func (h *EventHub) broadcast(events []domain.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, event := range events {
+		for sub := range h.subs {
+			if !sub.filter.Matches(event) {
+				continue
+			}
+			select {
+			case sub.events <- event:
+			default:
+				close(sub.events)
+				delete(h.subs, sub)
+			}
+		}
+		h.lastID = event.ID
+	}
+}
@@ -0,0 +1,150 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+const (
+	headerLastEventID = "Last-Event-ID"
+
+	// eventsKeepAlive is how often an idle stream sends a comment so that
+	// proxies do not close it.
+	eventsKeepAlive = 15 * time.Second
+	// eventsRetry is the reconnection delay suggested to clients.
+	eventsRetry = 3 * time.Second
+)
+
+// StreamEvents streams the event log as Server-Sent Events, optionally only
+// the events of a team or a user. A client that reconnects with Last-Event-ID
+// first receives the events it missed from the log and then the live ones.
+func (h *Handler) StreamEvents(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter := domain.EventFilter{
+		TeamName: c.QueryParam("team_name"),
+		UserID:   c.QueryParam("user_id"),
+	}
+
+	lastEventID, resume, ok := parseLastEventID(c)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+			dto.ErrCodeInvalidInput,
+			"Last-Event-ID must be the id of an event received from the stream",
+		))
+	}
+
+	sub, err := h.eventHub.subscribe(ctx, filter)
+	if err != nil {
+		return internalError(c, fmt.Errorf("subscribe to events: %w", err))
+	}
+	defer h.eventHub.unsubscribe(sub)
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	fmt.Fprintf(res, "retry: %d\n\n", eventsRetry.Milliseconds())
+	res.Flush()
+
+	cursor := sub.afterID
+	if resume {
+		cursor = lastEventID
+		for {
+			events, err := h.eventHub.listEvents(ctx, filter, cursor)
+			if err != nil {
+				logStreamError(c, fmt.Errorf("replay events: %w", err))
+				return nil
+			}
+			for _, event := range events {
+				if err := writeEvent(res, event); err != nil {
+					return nil
+				}
+				cursor = event.ID
+			}
+			if len(events) < domain.MaxEventPage {
+				break
+			}
+		}
+	}
+
+	keepAlive := time.NewTicker(eventsKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-sub.events:
+			if !ok {
+				// The stream fell too far behind or the server is shutting
+				// down; the client reconnects and resumes from the log.
+				return nil
+			}
+			// Already sent while replaying.
+			if event.ID <= cursor {
+				continue
+			}
+			if err := writeEvent(res, event); err != nil {
+				return nil
+			}
+			cursor = event.ID
+
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
+
+// parseLastEventID reads the Last-Event-ID header, or the last_event_id query
+// parameter for clients that cannot set headers. resume is false when
+// neither is present.
+func parseLastEventID(c echo.Context) (id int64, resume, ok bool) {
+	raw := strings.TrimSpace(c.Request().Header.Get(headerLastEventID))
+	if raw == "" {
+		raw = c.QueryParam("last_event_id")
+	}
+	if raw == "" {
+		return 0, false, true
+	}
+
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id < 0 {
+		return 0, false, false
+	}
+	return id, true, true
+}
+
+func writeEvent(res *echo.Response, event domain.Event) error {
+	data, err := json.Marshal(dto.ToEvent(event))
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(res, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
+
+// logStreamError logs a failure after the stream has started, when the
+// status can no longer be changed; the client reconnects and resumes.
+func logStreamError(c echo.Context, err error) {
+	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	log.Printf("request %s: %s %s: %v", requestID, c.Request().Method, c.Path(), err)
+}
@@ -0,0 +1,205 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+// memoryEventLog is an in-memory event log numbered from 1.
+type memoryEventLog struct {
+	mu     sync.Mutex
+	events []domain.Event
+}
+
+func (l *memoryEventLog) append(events ...domain.Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, event := range events {
+		event.ID = int64(len(l.events) + 1)
+		l.events = append(l.events, event)
+	}
+}
+
+func (l *memoryEventLog) ListEvents(_ context.Context, filter domain.EventFilter, afterID int64) ([]domain.Event, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var events []domain.Event
+	for _, event := range l.events {
+		if event.ID > afterID && filter.Matches(event) && len(events) < domain.MaxEventPage {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (l *memoryEventLog) LastEventID(context.Context) (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int64(len(l.events)), nil
+}
+
+// nextEvent reads SSE messages up to the next one with an id and returns its
+// id and event lines.
+func nextEvent(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+
+	var message []string
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimRight(line, "\n")
+
+		if line != "" {
+			message = append(message, line)
+			continue
+		}
+		if len(message) > 0 && strings.HasPrefix(message[0], "id: ") {
+			return message[0] + " " + message[1]
+		}
+		message = nil
+	}
+}
+
+func TestHandler_StreamEvents(t *testing.T) {
+	eventLog := &memoryEventLog{}
+	eventLog.append(
+		domain.Event{Type: domain.EventPRCreated, TeamName: "backend", PullRequestID: "pr-1", UserID: "u1"},
+		domain.Event{Type: domain.EventReviewerAssigned, TeamName: "backend", PullRequestID: "pr-1", UserID: "u2"},
+		domain.Event{Type: domain.EventPRCreated, TeamName: "frontend", PullRequestID: "pr-2", UserID: "u5"},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hub := NewEventHub(eventLog, 10*time.Millisecond)
+	go hub.Run(ctx)
+
+	e := echo.New()
+	e.GET("/events", (&Handler{eventHub: hub}).StreamEvents)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	stream := func(t *testing.T, query, lastEventID string) *bufio.Reader {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/events"+query, nil)
+		require.NoError(t, err)
+		if lastEventID != "" {
+			req.Header.Set(headerLastEventID, lastEventID)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get(echo.HeaderContentType))
+		return bufio.NewReader(resp.Body)
+	}
+
+	t.Run("resumes from the log, then streams live events", func(t *testing.T) {
+		r := stream(t, "?team_name=backend", "1")
+
+		assert.Equal(t, "id: 2 event: reviewer.assigned", nextEvent(t, r))
+
+		eventLog.append(
+			domain.Event{Type: domain.EventPRCreated, TeamName: "frontend", PullRequestID: "pr-3", UserID: "u5"},
+			domain.Event{Type: domain.EventPRMerged, TeamName: "backend", PullRequestID: "pr-1", UserID: "u1"},
+		)
+		assert.Equal(t, "id: 5 event: pr.merged", nextEvent(t, r))
+	})
+
+	t.Run("filters by user", func(t *testing.T) {
+		r := stream(t, "?user_id=u2", "")
+
+		eventLog.append(
+			domain.Event{Type: domain.EventUserDeactivated, TeamName: "backend", UserID: "u3"},
+			domain.Event{Type: domain.EventReviewerReassigned, TeamName: "backend", PullRequestID: "pr-1", UserID: "u4", PreviousUserID: "u2"},
+		)
+		assert.Equal(t, "id: 7 event: reviewer.reassigned", nextEvent(t, r))
+	})
+
+	t.Run("invalid Last-Event-ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/events", nil)
+		req.Header.Set(headerLastEventID, "abc")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "INVALID_INPUT")
+	})
+}
+
+func TestEventHub_DropsSlowSubscriptions(t *testing.T) {
+	hub := NewEventHub(&memoryEventLog{}, time.Second)
+	slow := &eventSubscription{events: make(chan domain.Event, 1)}
+	hub.subs[slow] = struct{}{}
+
+	hub.broadcast([]domain.Event{{ID: 1}, {ID: 2}})
+
+	assert.Empty(t, hub.subs)
+	assert.Equal(t, int64(2), hub.lastID)
+
+	event, ok := <-slow.events
+	require.True(t, ok)
+	assert.Equal(t, int64(1), event.ID)
+	_, ok = <-slow.events
+	assert.False(t, ok, "the stream must be closed so the client reconnects")
+}
+
+func TestHandler_StreamEvents_HubShutdown(t *testing.T) {
+	ctx, stopHub := context.WithCancel(context.Background())
+	defer stopHub()
+
+	hub := NewEventHub(&memoryEventLog{}, 10*time.Millisecond)
+	done := make(chan struct{})
+	go func() {
+		hub.Run(ctx)
+		close(done)
+	}()
+
+	e := echo.New()
+	e.GET("/events", (&Handler{eventHub: hub}).StreamEvents)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/events")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	stopHub()
+	<-done
+
+	finished := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(io.Discard, resp.Body)
+		finished <- err
+	}()
+	select {
+	case err := <-finished:
+		assert.NoError(t, err, "the stream must end cleanly")
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stream is still open after the hub stopped")
+	}
+
+	t.Run("streams opened after shutdown end at once", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, hub.subs)
+	})
+}
@@ -5,17 +5,21 @@ import (
 )
 
 type Handler struct {
-	teamUC  usecase.TeamUseCase
-	userUC  usecase.UserUseCase
-	prUC    usecase.PRUseCase
-	statsUC usecase.StatsUseCase
+	teamUC        usecase.TeamUseCase
+	userUC        usecase.UserUseCase
+	prUC          usecase.PRUseCase
+	statsUC       usecase.StatsUseCase
+	idempotencyUC usecase.IdempotencyUseCase
+	eventHub      *EventHub
 }
 
-func NewHandler(teamUC usecase.TeamUseCase, userUC usecase.UserUseCase, prUC usecase.PRUseCase, statsUC usecase.StatsUseCase) *Handler {
+func NewHandler(teamUC usecase.TeamUseCase, userUC usecase.UserUseCase, prUC usecase.PRUseCase, statsUC usecase.StatsUseCase, idempotencyUC usecase.IdempotencyUseCase, eventHub *EventHub) *Handler {
 	return &Handler{
-		teamUC:  teamUC,
-		userUC:  userUC,
-		prUC:    prUC,
-		statsUC: statsUC,
+		teamUC:        teamUC,
+		userUC:        userUC,
+		prUC:          prUC,
+		statsUC:       statsUC,
+		idempotencyUC: idempotencyUC,
+		eventHub:      eventHub,
 	}
 }
@@ -0,0 +1,137 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+const (
+	headerIdempotencyKey     = "Idempotency-Key"
+	headerIdempotentReplayed = "Idempotent-Replayed"
+)
+
+// idempotency makes POST requests that carry an Idempotency-Key safe to retry.
+// The first response for a key is stored and replayed for retries with the
+// same method, URL and body; reusing the key for another request is rejected.
+// A server error is stored only if the request committed changes first;
+// otherwise the key is released so that the request can be retried for real.
+// GraphQL is left out: its POSTs are reads.
+func (h *Handler) idempotency(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		key := req.Header.Get(headerIdempotencyKey)
+		if req.Method != http.MethodPost || key == "" || c.Path() == "/graphql" {
+			return next(c)
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+				dto.ErrCodeInvalidInput,
+				"failed to read request body",
+			))
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		stored, err := h.idempotencyUC.Begin(req.Context(), key, requestHash(req, body))
+		if err != nil {
+			return mapDomainError(c, err)
+		}
+		if stored != nil {
+			header := c.Response().Header()
+			for name, values := range stored.Headers {
+				header[name] = values
+			}
+			header.Set(headerIdempotentReplayed, "true")
+			return c.Blob(stored.StatusCode, stored.ContentType, stored.Body)
+		}
+
+		// Headers set before this point, such as X-Request-Id, belong to
+		// this request only and are not stored.
+		outer := c.Response().Header().Clone()
+
+		recorder := &bodyRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+
+		// The outcome must be saved even if the client has gone away.
+		ctx := context.WithoutCancel(req.Context())
+
+		trackedCtx, committed := usecase.TrackCommits(req.Context())
+		c.SetRequest(req.WithContext(trackedCtx))
+
+		err = next(c)
+		res := c.Response()
+		failed := err != nil || !res.Committed || res.Status >= http.StatusInternalServerError
+		if failed && !committed() {
+			if releaseErr := h.idempotencyUC.Release(ctx, key); releaseErr != nil {
+				log.Printf("failed to release idempotency key %q: %v", key, releaseErr)
+			}
+			return err
+		}
+		if err != nil || !res.Committed {
+			// Changes were committed but there is no response to store; the
+			// key stays reserved until its lease runs out.
+			log.Printf("request with idempotency key %q failed after committing changes: %v", key, err)
+			return err
+		}
+
+		err = h.idempotencyUC.Complete(ctx, &domain.IdempotencyRecord{
+			Key:         key,
+			StatusCode:  res.Status,
+			ContentType: res.Header().Get(echo.HeaderContentType),
+			Headers:     storedHeaders(res.Header(), outer),
+			Body:        recorder.body.Bytes(),
+		})
+		if err != nil {
+			log.Printf("failed to store response for idempotency key %q: %v", key, err)
+		}
+		return nil
+	}
+}
+
+func requestHash(req *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(req.Method + " " + req.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// storedHeaders returns the response headers the handler chain added or
+// changed on top of outer. Content-Type is stored on its own and
+// Content-Length is recomputed on replay.
+func storedHeaders(header, outer http.Header) map[string][]string {
+	stored := map[string][]string{}
+	for name, values := range header {
+		if name == echo.HeaderContentType || name == echo.HeaderContentLength {
+			continue
+		}
+		if slices.Equal(values, outer[name]) {
+			continue
+		}
+		stored[name] = slices.Clone(values)
+	}
+	return stored
+}
+
+// bodyRecorder copies everything written to the response.
+type bodyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,172 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+// memoryIdempotency mimics IdempotencyService on top of a map.
+type memoryIdempotency struct {
+	records map[string]*domain.IdempotencyRecord
+}
+
+func (m *memoryIdempotency) Begin(_ context.Context, key, requestHash string) (*domain.IdempotencyRecord, error) {
+	record, ok := m.records[key]
+	switch {
+	case !ok:
+		m.records[key] = &domain.IdempotencyRecord{Key: key, RequestHash: requestHash}
+		return nil, nil
+	case record.RequestHash != requestHash:
+		return nil, domain.ErrIdempotencyKeyReused
+	case !record.Completed():
+		return nil, domain.ErrIdempotencyKeyInProgress
+	default:
+		return record, nil
+	}
+}
+
+func (m *memoryIdempotency) Complete(_ context.Context, record *domain.IdempotencyRecord) error {
+	record.RequestHash = m.records[record.Key].RequestHash
+	m.records[record.Key] = record
+	return nil
+}
+
+func (m *memoryIdempotency) Release(_ context.Context, key string) error {
+	if !m.records[key].Completed() {
+		delete(m.records, key)
+	}
+	return nil
+}
+
+func (m *memoryIdempotency) PurgeExpired(context.Context) (int64, error) {
+	return 0, nil
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	var calls int
+	status := http.StatusCreated
+
+	h := &Handler{idempotencyUC: &memoryIdempotency{records: map[string]*domain.IdempotencyRecord{}}}
+	e := echo.New()
+	e.Use(middleware.RequestID())
+	e.Use(h.idempotency)
+	e.POST("/pullRequest/create", func(c echo.Context) error {
+		calls++
+		c.Response().Header().Set(headerETag, fmt.Sprintf(`"%d"`, calls))
+		return c.JSON(status, map[string]int{"call": calls})
+	}, deprecated("/v1/pull-requests"))
+	e.POST("/pullRequest/batch", func(c echo.Context) error {
+		calls++
+		usecase.RecordCommit(c.Request().Context())
+		return c.JSON(status, map[string]int{"call": calls})
+	})
+	e.POST("/graphql", func(c echo.Context) error {
+		calls++
+		return c.JSON(http.StatusOK, map[string]int{"call": calls})
+	})
+
+	postTo := func(target, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if key != "" {
+			req.Header.Set(headerIdempotencyKey, key)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	post := func(key, body string) *httptest.ResponseRecorder {
+		return postTo("/pullRequest/create", key, body)
+	}
+
+	first := post("key-1", `{"pull_request_id":"pr-1"}`)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(headerIdempotentReplayed))
+
+	t.Run("retry replays the stored response", func(t *testing.T) {
+		rec := post("key-1", `{"pull_request_id":"pr-1"}`)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, first.Body.String(), rec.Body.String())
+		assert.Equal(t, "true", rec.Header().Get(headerIdempotentReplayed))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("retry replays the handler's headers but not per-request ones", func(t *testing.T) {
+		rec := post("key-1", `{"pull_request_id":"pr-1"}`)
+
+		assert.Equal(t, `"1"`, rec.Header().Get(headerETag))
+		assert.Equal(t, "true", rec.Header().Get("Deprecation"))
+		assert.Equal(t, first.Header().Get("Link"), rec.Header().Get("Link"))
+		assert.NotEmpty(t, rec.Header().Get(echo.HeaderXRequestID))
+		assert.NotEqual(t, first.Header().Get(echo.HeaderXRequestID), rec.Header().Get(echo.HeaderXRequestID))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("reuse with a different query string is rejected", func(t *testing.T) {
+		rec := postTo("/pullRequest/create?dry_run=true", "key-1", `{"pull_request_id":"pr-1"}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("reuse with a different body is rejected", func(t *testing.T) {
+		rec := post("key-1", `{"pull_request_id":"pr-2"}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), dto.ErrCodeIdempotencyKeyReused)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("requests without a key always run", func(t *testing.T) {
+		post("", `{"pull_request_id":"pr-1"}`)
+		post("", `{"pull_request_id":"pr-1"}`)
+
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("server errors without committed changes are not stored", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		assert.Equal(t, http.StatusInternalServerError, post("key-2", `{}`).Code)
+
+		status = http.StatusCreated
+		rec := post("key-2", `{}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Empty(t, rec.Header().Get(headerIdempotentReplayed))
+	})
+
+	t.Run("server errors after committed changes are stored", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		defer func() { status = http.StatusCreated }()
+		first := postTo("/pullRequest/batch", "key-3", `{}`)
+		before := calls
+
+		rec := postTo("/pullRequest/batch", "key-3", `{}`)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, first.Body.String(), rec.Body.String())
+		assert.Equal(t, "true", rec.Header().Get(headerIdempotentReplayed))
+		assert.Equal(t, before, calls)
+	})
+
+	t.Run("graphql ignores the key", func(t *testing.T) {
+		before := calls
+		postTo("/graphql", "key-gql", `{"query":"{ teams { name } }"}`)
+		rec := postTo("/graphql", "key-gql", `{"query":"{ teams { name } }"}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(headerIdempotentReplayed))
+		assert.Equal(t, before+2, calls)
+	})
+}
@@ -0,0 +1,264 @@
+package http
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/metrics"
+)
+
+type openAPIDocument struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]openAPISchema `json:"schemas"`
+	} `json:"components"`
+}
+
+type openAPIOperation struct {
+	RequestBody struct {
+		Content map[string]struct {
+			Schema openAPISchema `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+}
+
+type openAPISchema struct {
+	Ref        string                   `json:"$ref"`
+	Type       string                   `json:"type"`
+	Format     string                   `json:"format"`
+	Enum       []string                 `json:"enum"`
+	Required   []string                 `json:"required"`
+	Properties map[string]openAPISchema `json:"properties"`
+	Items      *openAPISchema           `json:"items"`
+}
+
+// schemaTypes lists the dto struct behind every component schema.
+var schemaTypes = map[string]any{
+	"ErrorResponse": dto.ErrorResponse{},
+	"ErrorDetail":   dto.ErrorDetail{},
+	"FieldError":    dto.FieldError{},
+
+	"CreateTeamRequest":         dto.CreateTeamRequest{},
+	"AddTeamMemberRequest":      dto.AddTeamMemberRequest{},
+	"SetParentTeamRequest":      dto.SetParentTeamRequest{},
+	"UpdateTeamSettingsRequest": dto.UpdateTeamSettingsRequest{},
+	"TeamMember":                dto.TeamMember{},
+	"TeamResponse":              dto.TeamResponse{},
+	"Team":                      dto.Team{},
+	"TeamTreeResponse":          dto.TeamTreeResponse{},
+	"TeamTreeNode":              dto.TeamTreeNode{},
+
+	"SetUserIsActiveRequest": dto.SetUserIsActiveRequest{},
+	"UpdateUserRequest":      dto.UpdateUserRequest{},
+	"PatchUserRequest":       dto.PatchUserRequest{},
+	"UserResponse":           dto.UserResponse{},
+	"User":                   dto.User{},
+	"UserProfileResponse":    dto.UserProfileResponse{},
+	"UserProfile":            dto.UserProfile{},
+
+	"CreatePRRequest":          dto.CreatePRRequest{},
+	"BatchCreatePRsRequest":    dto.BatchCreatePRsRequest{},
+	"BatchCreatePRsResponse":   dto.BatchCreatePRsResponse{},
+	"BatchCreatePRResult":      dto.BatchCreatePRResult{},
+	"MergePRRequest":           dto.MergePRRequest{},
+	"ReassignReviewerRequest":  dto.ReassignReviewerRequest{},
+	"PRResponse":               dto.PRResponse{},
+	"PullRequest":              dto.PullRequest{},
+	"ReassignReviewerResponse": dto.ReassignReviewerResponse{},
+	"GetReviewerPRsResponse":   dto.GetReviewerPRsResponse{},
+	"PullRequestShort":         dto.PullRequestShort{},
+
+	"UserAssignmentStats": dto.UserAssignmentStats{},
+	"UserStats":           dto.UserStats{},
+	"PRStats":             dto.PRStats{},
+	"ReviewerWorkload":    dto.ReviewerWorkload{},
+	"TeamRollupStats":     dto.TeamRollupStats{},
+	"ReviewerPair":        dto.ReviewerPair{},
+	"TeamFairness":        dto.TeamFairness{},
+	"TeamCycleTime":       dto.TeamCycleTime{},
+	"AuthorCycleTime":     dto.AuthorCycleTime{},
+	"CycleTimeBucket":     dto.CycleTimeBucket{},
+	"CycleTimeStats":      dto.CycleTimeStats{},
+	"TimeSeriesPoint":     dto.TimeSeriesPoint{},
+	"StalePR":             dto.StalePR{},
+
+	"Event": dto.Event{},
+}
+
+var echoPathParam = regexp.MustCompile(`:([^/]+)`)
+
+func loadOpenAPI(t *testing.T) openAPIDocument {
+	t.Helper()
+
+	var doc openAPIDocument
+	require.NoError(t, json.Unmarshal(openAPISpec, &doc))
+	return doc
+}
+
+func TestOpenAPI_CoversRoutes(t *testing.T) {
+	doc := loadOpenAPI(t)
+
+	graphqlHandler := func(echo.Context) error { return nil }
+	e := NewRouter(NewHandler(nil, nil, nil, nil, nil, nil), graphqlHandler, metrics.New(nil, nil))
+
+	registered := make(map[string]bool)
+	for _, route := range e.Routes() {
+		path := echoPathParam.ReplaceAllString(route.Path, "{$1}")
+		method := strings.ToLower(route.Method)
+		registered[method+" "+path] = true
+
+		_, ok := doc.Paths[path][method]
+		assert.True(t, ok, "route %s %s is missing from openapi.json", route.Method, path)
+	}
+
+	for path, ops := range doc.Paths {
+		for method := range ops {
+			assert.True(t, registered[method+" "+path], "openapi.json documents unregistered route %s %s", strings.ToUpper(method), path)
+		}
+	}
+}
+
+func TestOpenAPI_SchemasMatchDTOs(t *testing.T) {
+	doc := loadOpenAPI(t)
+
+	for name := range doc.Components.Schemas {
+		assert.Contains(t, schemaTypes, name, "schema %s has no dto struct in schemaTypes", name)
+	}
+
+	requestBodies := make(map[string]bool)
+	for _, ops := range doc.Paths {
+		for _, raw := range ops {
+			var op openAPIOperation
+			require.NoError(t, json.Unmarshal(raw, &op))
+			for _, content := range op.RequestBody.Content {
+				requestBodies[strings.TrimPrefix(content.Schema.Ref, "#/components/schemas/")] = true
+			}
+		}
+	}
+
+	for name, v := range schemaTypes {
+		t.Run(name, func(t *testing.T) {
+			schema, ok := doc.Components.Schemas[name]
+			require.True(t, ok, "schema %s is missing from openapi.json", name)
+
+			fields := jsonFields(reflect.TypeOf(v), requestBodies[name])
+
+			names := make([]string, 0, len(fields))
+			var required []string
+			for _, f := range fields {
+				names = append(names, f.name)
+				if f.required {
+					required = append(required, f.name)
+				}
+			}
+			assert.ElementsMatch(t, names, mapKeys(schema.Properties), "properties")
+			assert.ElementsMatch(t, required, schema.Required, "required")
+
+			for _, f := range fields {
+				prop, ok := schema.Properties[f.name]
+				if !ok {
+					continue
+				}
+				assertSchemaType(t, f.name, f.typ, prop)
+			}
+		})
+	}
+}
+
+func TestOpenAPI_ErrorCodes(t *testing.T) {
+	doc := loadOpenAPI(t)
+
+	code := doc.Components.Schemas["ErrorDetail"].Properties["code"]
+	assert.ElementsMatch(t, dto.ErrorCodes, code.Enum)
+}
+
+type jsonField struct {
+	name     string
+	typ      reflect.Type
+	required bool
+}
+
+// jsonFields returns the fields encoding/json writes for t, with embedded
+// structs flattened. Request body fields are required when validation demands
+// them; other fields when they are never omitted.
+func jsonFields(t reflect.Type, isRequest bool) []jsonField {
+	var fields []jsonField
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if f.Anonymous && tag == "" {
+			fields = append(fields, jsonFields(f.Type, isRequest)...)
+			continue
+		}
+		if !f.IsExported() || tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+
+		var required bool
+		if isRequest {
+			required = slices.Contains(strings.Split(f.Tag.Get("validate"), ","), "required")
+		} else {
+			required = opts != "omitempty" && f.Type.Kind() != reflect.Pointer
+		}
+
+		fields = append(fields, jsonField{name: name, typ: f.Type, required: required})
+	}
+	return fields
+}
+
+func assertSchemaType(t *testing.T, field string, typ reflect.Type, schema openAPISchema) {
+	t.Helper()
+
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == reflect.TypeOf(time.Time{}) {
+		assert.Equal(t, "string", schema.Type, field)
+		assert.Equal(t, "date-time", schema.Format, field)
+		return
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		assert.Equal(t, "string", schema.Type, field)
+	case reflect.Bool:
+		assert.Equal(t, "boolean", schema.Type, field)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		assert.Equal(t, "integer", schema.Type, field)
+	case reflect.Float32, reflect.Float64:
+		assert.Equal(t, "number", schema.Type, field)
+	case reflect.Slice:
+		assert.Equal(t, "array", schema.Type, field)
+		if assert.NotNil(t, schema.Items, field) {
+			assertSchemaType(t, field+"[]", typ.Elem(), *schema.Items)
+		}
+	case reflect.Struct:
+		assert.Equal(t, "#/components/schemas/"+typ.Name(), schema.Ref, field)
+	default:
+		t.Errorf("%s: unsupported Go type %s", field, typ)
+	}
+}
+
+func mapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
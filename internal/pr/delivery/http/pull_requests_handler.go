@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -18,29 +19,15 @@ func (h *Handler) CreatePR(c echo.Context) error {
 		))
 	}
 
-	if req.PullRequestID == "" {
-		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-			dto.ErrCodeInvalidInput,
-			"pull_request_id is required",
-		))
-	}
-	if req.PullRequestName == "" {
-		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-			dto.ErrCodeInvalidInput,
-			"pull_request_name is required",
-		))
-	}
-	if req.AuthorID == "" {
-		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-			dto.ErrCodeInvalidInput,
-			"author_id is required",
-		))
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
 	}
 
 	usecaseReq := usecase.CreatePRRequest{
 		PullRequestID:   req.PullRequestID,
 		PullRequestName: req.PullRequestName,
 		AuthorID:        req.AuthorID,
+		TeamName:        req.TeamName,
 	}
 
 	pr, err := h.prUC.CreatePR(c.Request().Context(), usecaseReq)
@@ -48,12 +35,15 @@ func (h *Handler) CreatePR(c echo.Context) error {
 		return mapDomainError(c, err)
 	}
 
+	setPRETag(c, pr)
 	response := dto.ToPRResponse(pr)
 	return c.JSON(http.StatusCreated, response)
 }
 
-func (h *Handler) MergePR(c echo.Context) error {
-	var req dto.MergePRRequest
+// BatchCreatePRs creates many PRs in one request. Every item is validated
+// and reported on its own, so one bad item does not fail the batch.
+func (h *Handler) BatchCreatePRs(c echo.Context) error {
+	var req dto.BatchCreatePRsRequest
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
 			dto.ErrCodeInvalidInput,
@@ -61,15 +51,83 @@ func (h *Handler) MergePR(c echo.Context) error {
 		))
 	}
 
-	if req.PullRequestID == "" {
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	results := make([]dto.BatchCreatePRResult, len(req.PullRequests))
+	items := make([]usecase.CreatePRRequest, 0, len(req.PullRequests))
+	positions := make([]int, 0, len(req.PullRequests))
+
+	for i, item := range req.PullRequests {
+		if err := c.Validate(&item); err != nil {
+			var ve *validationError
+			if !errors.As(err, &ve) {
+				return internalError(c, err)
+			}
+			results[i] = dto.BatchFailed(i, item.PullRequestID, http.StatusBadRequest, dto.NewValidationErrorResponse(ve.details))
+			continue
+		}
+
+		items = append(items, usecase.CreatePRRequest{
+			PullRequestID:   item.PullRequestID,
+			PullRequestName: item.PullRequestName,
+			AuthorID:        item.AuthorID,
+			TeamName:        item.TeamName,
+		})
+		positions = append(positions, i)
+	}
+
+	if len(items) > 0 {
+		created, err := h.prUC.BatchCreatePRs(c.Request().Context(), items)
+		if err != nil {
+			return mapDomainError(c, err)
+		}
+
+		for j, result := range created {
+			i := positions[j]
+			if result.Err == nil {
+				results[i] = dto.BatchCreated(i, result.PullRequest)
+				continue
+			}
+
+			status, resp, ok := domainErrorResponse(result.Err)
+			if !ok {
+				// The other items are committed by now, so the failure
+				// is reported on its own item rather than for the batch.
+				status, resp = http.StatusInternalServerError, internalErrorResponse(c, result.Err)
+			}
+			results[i] = dto.BatchFailed(i, items[j].PullRequestID, status, resp)
+		}
+	}
+
+	return c.JSON(http.StatusOK, dto.ToBatchCreatePRsResponse(results))
+}
+
+func (h *Handler) MergePR(c echo.Context) error {
+	var req dto.MergePRRequest
+	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
 			dto.ErrCodeInvalidInput,
-			"pull_request_id is required",
+			"invalid JSON: "+err.Error(),
 		))
 	}
+	return h.mergePR(c, req)
+}
+
+func (h *Handler) mergePR(c echo.Context, req dto.MergePRRequest) error {
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return invalidIfMatch(c)
+	}
 
 	usecaseReq := usecase.MergePRRequest{
-		PullRequestID: req.PullRequestID,
+		PullRequestID:   req.PullRequestID,
+		ExpectedVersion: expectedVersion,
 	}
 
 	pr, err := h.prUC.MergePR(c.Request().Context(), usecaseReq)
@@ -77,6 +135,7 @@ func (h *Handler) MergePR(c echo.Context) error {
 		return mapDomainError(c, err)
 	}
 
+	setPRETag(c, pr)
 	response := dto.ToPRResponse(pr)
 	return c.JSON(http.StatusOK, response)
 }
@@ -89,23 +148,23 @@ func (h *Handler) ReassignReviewer(c echo.Context) error {
 			"invalid JSON: "+err.Error(),
 		))
 	}
+	return h.reassignReviewer(c, req)
+}
 
-	if req.PullRequestID == "" {
-		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-			dto.ErrCodeInvalidInput,
-			"pull_request_id is required",
-		))
+func (h *Handler) reassignReviewer(c echo.Context, req dto.ReassignReviewerRequest) error {
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
 	}
-	if req.OldReviewerID == "" {
-		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-			dto.ErrCodeInvalidInput,
-			"old_reviewer_id is required",
-		))
+
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return invalidIfMatch(c)
 	}
 
 	usecaseReq := usecase.ReassignReviewerRequest{
-		PullRequestID: req.PullRequestID,
-		OldReviewerID: req.OldReviewerID,
+		PullRequestID:   req.PullRequestID,
+		OldReviewerID:   req.OldReviewerID,
+		ExpectedVersion: expectedVersion,
 	}
 
 	result, err := h.prUC.ReassignReviewer(c.Request().Context(), usecaseReq)
@@ -113,6 +172,7 @@ func (h *Handler) ReassignReviewer(c echo.Context) error {
 		return mapDomainError(c, err)
 	}
 
+	setPRETag(c, result.PullRequest)
 	response := dto.ToReassignResponse(result.PullRequest, result.ReplacedBy)
 	return c.JSON(http.StatusOK, response)
 }
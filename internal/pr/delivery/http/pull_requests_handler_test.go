@@ -0,0 +1,124 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+// batchPRUseCase creates every requested PR except those listed in failures.
+type batchPRUseCase struct {
+	usecase.PRUseCase
+	failures map[string]error
+	received []usecase.CreatePRRequest
+}
+
+func (u *batchPRUseCase) BatchCreatePRs(_ context.Context, reqs []usecase.CreatePRRequest) ([]usecase.BatchCreatePRResult, error) {
+	u.received = reqs
+	createdAt := time.Now()
+
+	results := make([]usecase.BatchCreatePRResult, len(reqs))
+	for i, req := range reqs {
+		if err, ok := u.failures[req.PullRequestID]; ok {
+			results[i].Err = err
+			continue
+		}
+		results[i].PullRequest = &domain.PullRequest{
+			PullRequestID:     req.PullRequestID,
+			PullRequestName:   req.PullRequestName,
+			AuthorID:          req.AuthorID,
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u2"},
+			CreatedAt:         &createdAt,
+		}
+	}
+	return results, nil
+}
+
+func TestHandler_BatchCreatePRs(t *testing.T) {
+	prUC := &batchPRUseCase{failures: map[string]error{
+		"pr-3": domain.ErrUserNotFound,
+		"pr-4": errors.New("connection reset by peer"),
+	}}
+	e := echo.New()
+	e.Validator = newRequestValidator()
+	e.POST("/pullRequest/batchCreate", (&Handler{prUC: prUC}).BatchCreatePRs)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/batchCreate", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("reports every item in request order", func(t *testing.T) {
+		rec := post(`{"pull_requests": [
+			{"pull_request_id": "pr-1", "pull_request_name": "One", "author_id": "u1"},
+			{"pull_request_id": "pr 2", "pull_request_name": "Two", "author_id": "u1"},
+			{"pull_request_id": "pr-3", "pull_request_name": "Three", "author_id": "ghost"}
+		]}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resp dto.BatchCreatePRsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 1, resp.Created)
+		assert.Equal(t, 2, resp.Failed)
+		require.Len(t, resp.Results, 3)
+
+		assert.Equal(t, http.StatusCreated, resp.Results[0].Status)
+		require.NotNil(t, resp.Results[0].PR)
+		assert.Equal(t, []string{"u2"}, resp.Results[0].PR.AssignedReviewers)
+
+		assert.Equal(t, 1, resp.Results[1].Index)
+		assert.Equal(t, http.StatusBadRequest, resp.Results[1].Status)
+		require.NotNil(t, resp.Results[1].Error)
+		assert.Equal(t, dto.ErrCodeInvalidInput, resp.Results[1].Error.Code)
+		assert.Equal(t, "pull_request_id", resp.Results[1].Error.Details[0].Field)
+
+		assert.Equal(t, "pr-3", resp.Results[2].PullRequestID)
+		assert.Equal(t, http.StatusNotFound, resp.Results[2].Status)
+		assert.Equal(t, dto.ErrCodeNotFound, resp.Results[2].Error.Code)
+
+		require.Len(t, prUC.received, 2, "invalid items must not reach the use case")
+	})
+
+	t.Run("unexpected item error does not fail the batch", func(t *testing.T) {
+		rec := post(`{"pull_requests": [
+			{"pull_request_id": "pr-1", "pull_request_name": "One", "author_id": "u1"},
+			{"pull_request_id": "pr-4", "pull_request_name": "Four", "author_id": "u1"}
+		]}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resp dto.BatchCreatePRsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 1, resp.Created)
+		assert.Equal(t, 1, resp.Failed)
+		require.Len(t, resp.Results, 2)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Results[1].Status)
+		require.NotNil(t, resp.Results[1].Error)
+		assert.Equal(t, dto.ErrCodeInternal, resp.Results[1].Error.Code)
+		assert.NotContains(t, resp.Results[1].Error.Message, "connection reset")
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		rec := post(`{"pull_requests": []}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "pull_requests")
+	})
+}
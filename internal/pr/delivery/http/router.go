@@ -5,35 +5,113 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/metrics"
 )
 
-func NewRouter(handler *Handler) *echo.Echo {
+// NewRouter registers every HTTP route of the service. The GraphQL handler
+// lives in its own package and is passed in ready to serve.
+func NewRouter(handler *Handler, graphqlHandler echo.HandlerFunc, m *metrics.Metrics) *echo.Echo {
 	e := echo.New()
 
 	e.HideBanner = true
 	e.HidePort = true
+	e.Validator = newRequestValidator()
 
 	e.Use(middleware.Logger())
+	e.Use(m.Middleware())
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORS())
 	e.Use(middleware.RequestID())
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
+		// The event stream stays open for as long as the client listens.
+		Skipper: func(c echo.Context) bool { return c.Path() == "/events" },
 		Timeout: 30 * time.Second,
 	}))
+	e.Use(handler.idempotency)
+
+	e.POST("/team/add", handler.CreateTeam, deprecated("/v1/teams"))
+	e.GET("/team/get", handler.GetTeam, deprecated("/v1/teams/{name}"))
+	e.GET("/team/tree", handler.GetTeamTree, deprecated("/v1/teams/{name}/tree"))
+	e.POST("/team/setParent", handler.SetParentTeam, deprecated("/v1/teams/{name}/parent"))
+	e.POST("/team/addMember", handler.AddTeamMember, deprecated("/v1/teams/{name}/members"))
+	e.POST("/team/updateSettings", handler.UpdateTeamSettings, deprecated("/v1/teams/{name}/settings"))
+
+	e.POST("/users/setIsActive", handler.SetUserIsActive, deprecated("/v1/users/{id}"))
+	e.GET("/users/getReview", handler.GetReviewerPRs, deprecated("/v1/users/{id}/reviews"))
+	e.GET("/users/get", handler.GetUser, deprecated("/v1/users/{id}"))
+	e.POST("/users/update", handler.UpdateUser, deprecated("/v1/users/{id}"))
+
+	e.POST("/pullRequest/create", handler.CreatePR, deprecated("/v1/pull-requests"))
+	e.POST("/pullRequest/batchCreate", handler.BatchCreatePRs, deprecated("/v1/pull-requests/batch"))
+	e.POST("/pullRequest/merge", handler.MergePR, deprecated("/v1/pull-requests/{id}/merge"))
+	e.POST("/pullRequest/reassign", handler.ReassignReviewer, deprecated("/v1/pull-requests/{id}/reassign"))
+
+	v1 := e.Group("/v1")
+
+	v1.POST("/teams", handler.CreateTeam)
+	v1.GET("/teams", handler.GetTeamTree)
+	v1.GET("/teams/:name", handler.GetTeamV1)
+	v1.GET("/teams/:name/tree", handler.GetTeamTreeV1)
+	v1.PUT("/teams/:name/parent", handler.SetParentTeamV1)
+	v1.POST("/teams/:name/members", handler.AddTeamMemberV1)
+	v1.PUT("/teams/:name/settings", handler.UpdateTeamSettingsV1)
+
+	v1.GET("/users/:id", handler.GetUserV1)
+	v1.PATCH("/users/:id", handler.PatchUserV1)
+	v1.GET("/users/:id/reviews", handler.GetReviewerPRsV1)
 
-	e.POST("/team/add", handler.CreateTeam)
-	e.GET("/team/get", handler.GetTeam)
+	v1.POST("/pull-requests", handler.CreatePR)
+	v1.POST("/pull-requests/batch", handler.BatchCreatePRs)
+	v1.GET("/pull-requests/:id", handler.GetPRV1)
+	v1.POST("/pull-requests/:id/merge", handler.MergePRV1)
+	v1.POST("/pull-requests/:id/reassign", handler.ReassignReviewerV1)
 
-	e.POST("/users/setIsActive", handler.SetUserIsActive)
-	e.GET("/users/getReview", handler.GetReviewerPRs)
+	// Reports are read-only and identical under both prefixes; only the
+	// legacy copies carry deprecation headers.
+	for _, route := range statsRoutes(handler) {
+		e.GET("/stats"+route.path, route.handler, deprecated("/v1/stats"+route.path))
+		v1.GET("/stats"+route.path, route.handler)
+	}
 
-	e.POST("/pullRequest/create", handler.CreatePR)
-	e.POST("/pullRequest/merge", handler.MergePR)
-	e.POST("/pullRequest/reassign", handler.ReassignReviewer)
+	e.GET("/events", handler.StreamEvents)
+	e.POST("/graphql", graphqlHandler)
 
-	e.GET("/stats/users", handler.GetUserStats)
-	e.GET("/stats/prs", handler.GetPRStats)
-	e.GET("/stats/workload", handler.GetReviewerWorkload)
+	e.GET("/metrics", echo.WrapHandler(m.Handler()))
+	e.GET("/openapi.json", serveOpenAPI)
+	e.GET("/docs", serveSwaggerUI)
 
 	return e
 }
+
+type statsRoute struct {
+	path    string
+	handler echo.HandlerFunc
+}
+
+func statsRoutes(handler *Handler) []statsRoute {
+	return []statsRoute{
+		{"/users", handler.GetUserStats},
+		{"/prs", handler.GetPRStats},
+		{"/workload", handler.GetReviewerWorkload},
+		{"/teams", handler.GetTeamStats},
+		{"/cycleTime", handler.GetCycleTimeStats},
+		{"/timeseries", handler.GetTimeSeries},
+		{"/fairness", handler.GetFairnessReport},
+		{"/pairs", handler.GetReviewerPairs},
+		{"/stale", handler.GetStalePRs},
+	}
+}
+
+// deprecated marks a legacy route in the response headers and points clients
+// at its /v1 replacement.
+func deprecated(successor string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Response().Header()
+			header.Set("Deprecation", "true")
+			header.Set("Link", "<"+successor+`>; rel="successor-version"`)
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package http
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+)
+
+type statsFormat string
+
+const (
+	statsFormatJSON statsFormat = "json"
+	statsFormatCSV  statsFormat = "csv"
+
+	mimeTextCSV = "text/csv"
+)
+
+// parseStatsFormat picks the response format from the format query parameter
+// or, when it is absent, from the Accept header: CSV is chosen when text/csv is
+// accepted at least as strongly as application/json. JSON is the default.
+func parseStatsFormat(c echo.Context) (statsFormat, error) {
+	switch format := statsFormat(c.QueryParam("format")); format {
+	case statsFormatJSON, statsFormatCSV:
+		return format, nil
+	case "":
+	default:
+		return "", fmt.Errorf("format must be one of: %s, %s", statsFormatJSON, statsFormatCSV)
+	}
+
+	var csvQ, jsonQ float64
+	for _, accepted := range strings.Split(c.Request().Header.Get(echo.HeaderAccept), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if raw, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(raw, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case mimeTextCSV:
+			csvQ = max(csvQ, q)
+		case echo.MIMEApplicationJSON:
+			jsonQ = max(jsonQ, q)
+		}
+	}
+	if csvQ > 0 && csvQ >= jsonQ {
+		return statsFormatCSV, nil
+	}
+	return statsFormatJSON, nil
+}
+
+type csvTable struct {
+	header []string
+	rows   [][]string
+}
+
+// writeStats renders body as JSON or, for CSV, the table built by table.
+// The table is only built when it is actually needed.
+func writeStats(c echo.Context, format statsFormat, name string, body any, table func() csvTable) error {
+	// The format may come from Accept, so caches must key on it.
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+	if format != statsFormatCSV {
+		return c.JSON(http.StatusOK, body)
+	}
+
+	t := table()
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(t.header); err != nil {
+		return err
+	}
+	if err := w.WriteAll(t.rows); err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="stats_%s.csv"`, name))
+	return c.Blob(http.StatusOK, mimeTextCSV+"; charset=utf-8", buf.Bytes())
+}
+
+// csvText neutralises values that spreadsheet applications would otherwise
+// evaluate as formulas.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func csvInt(n int64) string {
+	return strconv.FormatInt(n, 10)
+}
+
+func csvFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func userStatsCSV(stats []dto.UserStats) csvTable {
+	t := csvTable{header: []string{
+		"user_id", "username", "team_name", "assignments_count", "reviews_merged", "mean_time_in_review_seconds",
+	}}
+	for _, s := range stats {
+		t.rows = append(t.rows, []string{
+			csvText(s.UserID), csvText(s.Username), csvText(s.TeamName), csvInt(s.AssignmentsCount),
+			csvInt(s.ReviewsMerged), csvFloat(s.MeanTimeInReviewSeconds),
+		})
+	}
+	return t
+}
+
+func prStatsCSV(s dto.PRStats) csvTable {
+	return csvTable{
+		header: []string{"total_prs", "open_prs", "merged_prs"},
+		rows:   [][]string{{csvInt(s.TotalPRs), csvInt(s.OpenPRs), csvInt(s.MergedPRs)}},
+	}
+}
+
+func workloadCSV(workload []dto.ReviewerWorkload) csvTable {
+	t := csvTable{header: []string{
+		"user_id", "username", "team_name", "open_prs_count", "oldest_open_age_seconds", "assignments_last_7_days",
+	}}
+	for _, w := range workload {
+		t.rows = append(t.rows, []string{
+			csvText(w.UserID), csvText(w.Username), csvText(w.TeamName), csvInt(w.OpenPRsCount),
+			csvFloat(w.OldestOpenAgeSeconds), csvInt(w.AssignmentsLast7Days),
+		})
+	}
+	return t
+}
+
+func teamStatsCSV(stats []dto.TeamRollupStats) csvTable {
+	t := csvTable{header: []string{
+		"team_name", "parent_team_name", "teams_count", "members_count", "assignments_count", "open_prs", "merged_prs",
+	}}
+	for _, s := range stats {
+		t.rows = append(t.rows, []string{
+			csvText(s.TeamName), csvText(s.ParentTeamName), csvInt(s.TeamsCount), csvInt(s.MembersCount),
+			csvInt(s.AssignmentsCount), csvInt(s.OpenPRs), csvInt(s.MergedPRs),
+		})
+	}
+	return t
+}
+
+func pairsCSV(pairs []dto.ReviewerPair) csvTable {
+	t := csvTable{header: []string{"author_id", "author_username", "reviewer_id", "reviewer_username", "reviews_count"}}
+	for _, p := range pairs {
+		t.rows = append(t.rows, []string{
+			csvText(p.AuthorID), csvText(p.AuthorUsername), csvText(p.ReviewerID), csvText(p.ReviewerUsername),
+			csvInt(p.ReviewsCount),
+		})
+	}
+	return t
+}
+
+// fairnessCSV writes one row per team; overloaded members are listed by ID
+// in a single semicolon-separated cell.
+func fairnessCSV(teams []dto.TeamFairness) csvTable {
+	t := csvTable{header: []string{
+		"team_name", "members_count", "total_assignments", "min_assignments", "max_assignments",
+		"mean_assignments", "stddev_assignments", "gini", "overloaded_members",
+	}}
+	for _, f := range teams {
+		overloaded := make([]string, len(f.Overloaded))
+		for i, m := range f.Overloaded {
+			overloaded[i] = m.UserID
+		}
+		t.rows = append(t.rows, []string{
+			csvText(f.TeamName), strconv.Itoa(f.MembersCount), csvInt(f.TotalAssignments), csvInt(f.Min), csvInt(f.Max),
+			csvFloat(f.Mean), csvFloat(f.StdDev), csvFloat(f.Gini), csvText(strings.Join(overloaded, ";")),
+		})
+	}
+	return t
+}
+
+// cycleTimeCSV flattens the team, author and histogram sections into one
+// table distinguished by the kind column; cells that do not apply to a kind
+// are left empty.
+func cycleTimeCSV(stats dto.CycleTimeStats) csvTable {
+	t := csvTable{header: []string{
+		"kind", "team_name", "user_id", "username", "merged_prs", "mean_seconds", "median_seconds",
+		"p90_seconds", "p99_seconds", "from_seconds", "to_seconds", "count",
+	}}
+	summary := func(s dto.CycleTimeSummary) []string {
+		return []string{
+			csvInt(s.MergedPRs), csvFloat(s.MeanSeconds), csvFloat(s.MedianSeconds),
+			csvFloat(s.P90Seconds), csvFloat(s.P99Seconds),
+		}
+	}
+	for _, team := range stats.Teams {
+		row := append([]string{"team", csvText(team.TeamName), "", ""}, summary(team.CycleTimeSummary)...)
+		t.rows = append(t.rows, append(row, "", "", ""))
+	}
+	for _, author := range stats.Authors {
+		row := append([]string{"author", csvText(author.TeamName), csvText(author.UserID), csvText(author.Username)}, summary(author.CycleTimeSummary)...)
+		t.rows = append(t.rows, append(row, "", "", ""))
+	}
+	for _, b := range stats.Histogram {
+		to := ""
+		if b.ToSeconds != nil {
+			to = csvFloat(*b.ToSeconds)
+		}
+		t.rows = append(t.rows, []string{
+			"histogram", "", "", "", "", "", "", "", "", csvFloat(b.FromSeconds), to, csvInt(b.Count),
+		})
+	}
+	return t
+}
+
+func timeSeriesCSV(points []dto.TimeSeriesPoint) csvTable {
+	t := csvTable{header: []string{"bucket", "prs_opened", "prs_merged", "assignments"}}
+	for _, p := range points {
+		t.rows = append(t.rows, []string{
+			p.Bucket.Format(time.RFC3339), csvInt(p.PRsOpened), csvInt(p.PRsMerged), csvInt(p.Assignments),
+		})
+	}
+	return t
+}
+
+// staleCSV lists reviewers of each PR in a single semicolon-separated cell.
+func staleCSV(prs []dto.StalePR) csvTable {
+	t := csvTable{header: []string{
+		"pull_request_id", "pull_request_name", "author_id", "team_name", "assigned_reviewers",
+		"created_at", "age_seconds", "threshold_seconds", "flagged_at",
+	}}
+	for _, pr := range prs {
+		flaggedAt := ""
+		if pr.FlaggedAt != nil {
+			flaggedAt = pr.FlaggedAt.Format(time.RFC3339)
+		}
+		t.rows = append(t.rows, []string{
+			csvText(pr.PullRequestID), csvText(pr.PullRequestName), csvText(pr.AuthorID), csvText(pr.TeamName),
+			csvText(strings.Join(pr.AssignedReviewers, ";")), pr.CreatedAt.Format(time.RFC3339),
+			csvFloat(pr.AgeSeconds), csvFloat(pr.ThresholdSeconds), flaggedAt,
+		})
+	}
+	return t
+}
@@ -0,0 +1,160 @@
+package http
+
+import (
+	"context"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+// csvStatsUseCase serves fixed user stats and no freshness information.
+type csvStatsUseCase struct {
+	usecase.StatsUseCase
+	stats []domain.UserAssignmentStats
+}
+
+func (u *csvStatsUseCase) GetFreshness(context.Context, domain.StatsFilter) (*domain.StatsFreshness, error) {
+	return nil, nil
+}
+
+func (u *csvStatsUseCase) GetUserAssignmentStats(context.Context, domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	return u.stats, nil
+}
+
+func TestParseStatsFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		accept  string
+		format  statsFormat
+		wantErr bool
+	}{
+		{name: "default", format: statsFormatJSON},
+		{name: "query csv", query: "csv", format: statsFormatCSV},
+		{name: "query wins over accept", query: "json", accept: "text/csv", format: statsFormatJSON},
+		{name: "accept csv", accept: "text/csv", format: statsFormatCSV},
+		{name: "accept csv with charset", accept: "text/csv; charset=utf-8", format: statsFormatCSV},
+		{name: "json preferred by q", accept: "text/csv;q=0.5, application/json", format: statsFormatJSON},
+		{name: "csv preferred by q", accept: "application/json;q=0.5, text/csv", format: statsFormatCSV},
+		{name: "equal q picks csv", accept: "application/json;q=0.8, text/csv;q=0.8", format: statsFormatCSV},
+		{name: "csv refused", accept: "text/csv;q=0", format: statsFormatJSON},
+		{name: "wildcard", accept: "*/*", format: statsFormatJSON},
+		{name: "malformed q is ignored", accept: "text/csv;q=high", format: statsFormatJSON},
+		{name: "unknown format", query: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := "/stats/users"
+			if tt.query != "" {
+				target += "?format=" + tt.query
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			if tt.accept != "" {
+				req.Header.Set(echo.HeaderAccept, tt.accept)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			format, err := parseStatsFormat(c)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.format, format)
+		})
+	}
+}
+
+func TestCSVText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "", want: ""},
+		{in: "alice", want: "alice"},
+		{in: "a=b", want: "a=b"},
+		{in: "=SUM(A1:A2)", want: "'=SUM(A1:A2)"},
+		{in: "+1", want: "'+1"},
+		{in: "-1", want: "'-1"},
+		{in: "@cmd", want: "'@cmd"},
+		{in: "\tx", want: "'\tx"},
+		{in: "\rx", want: "'\rx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, csvText(tt.in))
+		})
+	}
+}
+
+func TestHandler_GetUserStatsCSV(t *testing.T) {
+	statsUC := &csvStatsUseCase{stats: []domain.UserAssignmentStats{
+		{UserID: "u1", Username: `Alice "Al" Smith`, TeamName: "backend", AssignmentsCount: 3},
+		{UserID: "u2", Username: "Bob, Jr.\nSecond line", TeamName: "backend", AssignmentsCount: 1},
+		{UserID: "u3", Username: "=HYPERLINK(\"x\")", TeamName: "backend"},
+	}}
+	e := echo.New()
+	e.GET("/stats/users", (&Handler{statsUC: statsUC}).GetUserStats)
+
+	get := func(target, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if accept != "" {
+			req.Header.Set(echo.HeaderAccept, accept)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("special characters survive a round trip", func(t *testing.T) {
+		rec := get("/stats/users?format=csv", "")
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, `attachment; filename="stats_users.csv"`, rec.Header().Get(echo.HeaderContentDisposition))
+
+		records, err := csv.NewReader(strings.NewReader(rec.Body.String())).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		assert.Equal(t, "username", records[0][1])
+		assert.Equal(t, `Alice "Al" Smith`, records[1][1])
+		assert.Equal(t, "Bob, Jr.\nSecond line", records[2][1])
+		assert.Equal(t, `'=HYPERLINK("x")`, records[3][1])
+		assert.Equal(t, "3", records[1][3])
+	})
+
+	t.Run("accept header selects csv", func(t *testing.T) {
+		rec := get("/stats/users", "text/csv")
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.True(t, strings.HasPrefix(rec.Body.String(), "user_id,username,"))
+		assert.Equal(t, echo.HeaderAccept, rec.Header().Get(echo.HeaderVary))
+	})
+
+	t.Run("json response varies on accept too", func(t *testing.T) {
+		rec := get("/stats/users", "")
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+		assert.Equal(t, echo.HeaderAccept, rec.Header().Get(echo.HeaderVary))
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		rec := get("/stats/users?format=xlsx", "")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), dto.ErrCodeInvalidInput)
+	})
+}
@@ -1,48 +1,148 @@
 package http
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 )
 
+const statsDateLayout = "2006-01-02"
+
+// parseStatsFilter reads the from, to and team_name query parameters shared
+// by all /stats endpoints. Dates are RFC 3339 timestamps or YYYY-MM-DD; a bare
+// "to" date includes the whole day.
+func parseStatsFilter(c echo.Context) (domain.StatsFilter, error) {
+	filter := domain.StatsFilter{
+		TeamName: c.QueryParam("team_name"),
+	}
+
+	if raw := c.QueryParam("from"); raw != "" {
+		from, _, err := parseStatsTime(raw)
+		if err != nil {
+			return filter, errors.New("from must be an RFC 3339 timestamp or YYYY-MM-DD date")
+		}
+		filter.From = &from
+	}
+
+	if raw := c.QueryParam("to"); raw != "" {
+		to, dateOnly, err := parseStatsTime(raw)
+		if err != nil {
+			return filter, errors.New("to must be an RFC 3339 timestamp or YYYY-MM-DD date")
+		}
+		if dateOnly {
+			to = to.AddDate(0, 0, 1)
+		}
+		filter.To = &to
+	}
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, errors.New("from must be before to")
+	}
+
+	return filter, nil
+}
+
+func parseStatsTime(raw string) (time.Time, bool, error) {
+	if t, err := time.Parse(statsDateLayout, raw); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return t.UTC(), false, nil
+}
+
+func statsQueryError(c echo.Context, err error) error {
+	return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+		dto.ErrCodeInvalidInput,
+		err.Error(),
+	))
+}
+
+// setStatsFreshness sets Last-Modified to the time the aggregates were last
+// updated when the request is served from them.
+func (h *Handler) setStatsFreshness(c echo.Context, filter domain.StatsFilter) error {
+	freshness, err := h.statsUC.GetFreshness(c.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+	if freshness != nil {
+		c.Response().Header().Set(echo.HeaderLastModified, freshness.UpdatedAt.UTC().Format(http.TimeFormat))
+	}
+	return nil
+}
+
 func (h *Handler) GetUserStats(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	stats, err := h.statsUC.GetUserAssignmentStats(ctx)
+	filter, err := parseStatsFilter(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	format, err := parseStatsFormat(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	if err := h.setStatsFreshness(c, filter); err != nil {
+		return internalError(c, fmt.Errorf("get stats freshness: %w", err))
+	}
+
+	stats, err := h.statsUC.GetUserAssignmentStats(ctx, filter)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(
-			"INTERNAL_ERROR",
-			"failed to get user stats: "+err.Error(),
-		))
+		return internalError(c, fmt.Errorf("get user stats: %w", err))
 	}
 
-	out := make([]dto.UserAssignmentStats, len(stats))
+	out := make([]dto.UserStats, len(stats))
 	for i, s := range stats {
-		out[i] = dto.UserAssignmentStats{
-			UserID:           s.UserID,
-			Username:         s.Username,
-			TeamName:         s.TeamName,
-			AssignmentsCount: s.AssignmentsCount,
+		out[i] = dto.UserStats{
+			UserAssignmentStats: dto.UserAssignmentStats{
+				UserID:           s.UserID,
+				Username:         s.Username,
+				TeamName:         s.TeamName,
+				AssignmentsCount: s.AssignmentsCount,
+			},
+			ReviewsMerged:           s.ReviewsMerged,
+			MeanTimeInReviewSeconds: s.MeanTimeInReview.Seconds(),
 		}
 	}
 
-	return c.JSON(http.StatusOK, map[string]any{
+	return writeStats(c, format, "users", map[string]any{
 		"users": out,
-	})
+	}, func() csvTable { return userStatsCSV(out) })
 }
 
 func (h *Handler) GetPRStats(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	stats, err := h.statsUC.GetPRStats(ctx)
+	filter, err := parseStatsFilter(c)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(
-			"INTERNAL_ERROR",
-			"failed to get PR stats: "+err.Error(),
-		))
+		return statsQueryError(c, err)
+	}
+
+	format, err := parseStatsFormat(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	if err := h.setStatsFreshness(c, filter); err != nil {
+		return internalError(c, fmt.Errorf("get stats freshness: %w", err))
+	}
+
+	stats, err := h.statsUC.GetPRStats(ctx, filter)
+	if err != nil {
+		return internalError(c, fmt.Errorf("get PR stats: %w", err))
 	}
 
 	out := dto.PRStats{
@@ -51,31 +151,371 @@ func (h *Handler) GetPRStats(c echo.Context) error {
 		MergedPRs: stats.MergedPRs,
 	}
 
-	return c.JSON(http.StatusOK, out)
+	return writeStats(c, format, "prs", out, func() csvTable { return prStatsCSV(out) })
 }
 
 func (h *Handler) GetReviewerWorkload(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	workload, err := h.statsUC.GetReviewerWorkload(ctx)
+	filter, err := parseStatsFilter(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	format, err := parseStatsFormat(c)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(
-			"INTERNAL_ERROR",
-			"failed to get reviewer workload: "+err.Error(),
-		))
+		return statsQueryError(c, err)
+	}
+
+	sort := domain.WorkloadSort(c.QueryParam("sort"))
+	if sort != "" && !sort.IsValid() {
+		return statsQueryError(c, errors.New("sort must be one of: open_prs, oldest_open, recent_assignments, username"))
+	}
+
+	workload, err := h.statsUC.GetReviewerWorkload(ctx, filter, sort)
+	if err != nil {
+		return internalError(c, fmt.Errorf("get reviewer workload: %w", err))
 	}
 
 	out := make([]dto.ReviewerWorkload, len(workload))
 	for i, w := range workload {
 		out[i] = dto.ReviewerWorkload{
-			UserID:       w.UserID,
-			Username:     w.Username,
-			TeamName:     w.TeamName,
-			OpenPRsCount: w.OpenPRsCount,
+			UserID:               w.UserID,
+			Username:             w.Username,
+			TeamName:             w.TeamName,
+			OpenPRsCount:         w.OpenPRsCount,
+			OldestOpenAgeSeconds: w.OldestOpenAge.Seconds(),
+			AssignmentsLast7Days: w.AssignmentsLast7Days,
 		}
 	}
 
-	return c.JSON(http.StatusOK, map[string]any{
+	return writeStats(c, format, "workload", map[string]any{
 		"reviewers": out,
-	})
+	}, func() csvTable { return workloadCSV(out) })
+}
+
+func (h *Handler) GetTeamStats(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := parseStatsFilter(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	format, err := parseStatsFormat(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	stats, err := h.statsUC.GetTeamRollupStats(ctx, filter)
+	if err != nil {
+		return internalError(c, fmt.Errorf("get team stats: %w", err))
+	}
+
+	out := make([]dto.TeamRollupStats, len(stats))
+	for i, s := range stats {
+		out[i] = dto.TeamRollupStats{
+			TeamName:         s.TeamName,
+			ParentTeamName:   s.ParentTeamName,
+			TeamsCount:       s.TeamsCount,
+			MembersCount:     s.MembersCount,
+			AssignmentsCount: s.AssignmentsCount,
+			OpenPRs:          s.OpenPRs,
+			MergedPRs:        s.MergedPRs,
+		}
+	}
+
+	return writeStats(c, format, "teams", map[string]any{
+		"teams": out,
+	}, func() csvTable { return teamStatsCSV(out) })
+}
+
+func (h *Handler) GetReviewerPairs(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := parseStatsFilter(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	format, err := parseStatsFormat(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	pairs, err := h.statsUC.GetReviewerPairs(ctx, filter)
+	if err != nil {
+		return internalError(c, fmt.Errorf("get reviewer pairs: %w", err))
+	}
+
+	out := make([]dto.ReviewerPair, len(pairs))
+	for i, p := range pairs {
+		out[i] = dto.ReviewerPair{
+			AuthorID:         p.AuthorID,
+			AuthorUsername:   p.AuthorUsername,
+			ReviewerID:       p.ReviewerID,
+			ReviewerUsername: p.ReviewerUsername,
+			ReviewsCount:     p.ReviewsCount,
+		}
+	}
+
+	return writeStats(c, format, "pairs", map[string]any{
+		"pairs": out,
+	}, func() csvTable { return pairsCSV(out) })
+}
+
+func (h *Handler) GetFairnessReport(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := parseStatsFilter(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	format, err := parseStatsFormat(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	threshold := float64(domain.DefaultFairnessThresholdPct)
+	if raw := c.QueryParam("threshold_pct"); raw != "" {
+		threshold, err = strconv.ParseFloat(raw, 64)
+		if err != nil || threshold < 0 || math.IsInf(threshold, 0) || math.IsNaN(threshold) {
+			return statsQueryError(c, errors.New("threshold_pct must be a non-negative number"))
+		}
+	}
+
+	if err := h.setStatsFreshness(c, filter); err != nil {
+		return internalError(c, fmt.Errorf("get stats freshness: %w", err))
+	}
+
+	report, err := h.statsUC.GetFairnessReport(ctx, filter, threshold)
+	if err != nil {
+		return internalError(c, fmt.Errorf("get fairness report: %w", err))
+	}
+
+	out := make([]dto.TeamFairness, len(report))
+	for i, t := range report {
+		overloaded := make([]dto.UserAssignmentStats, len(t.Overloaded))
+		for j, m := range t.Overloaded {
+			overloaded[j] = dto.UserAssignmentStats{
+				UserID:           m.UserID,
+				Username:         m.Username,
+				TeamName:         m.TeamName,
+				AssignmentsCount: m.AssignmentsCount,
+			}
+		}
+		out[i] = dto.TeamFairness{
+			TeamName:         t.TeamName,
+			MembersCount:     t.MembersCount,
+			TotalAssignments: t.TotalAssignments,
+			Min:              t.Min,
+			Max:              t.Max,
+			Mean:             t.Mean,
+			StdDev:           t.StdDev,
+			Gini:             t.Gini,
+			Overloaded:       overloaded,
+		}
+	}
+
+	return writeStats(c, format, "fairness", map[string]any{
+		"threshold_pct": threshold,
+		"teams":         out,
+	}, func() csvTable { return fairnessCSV(out) })
+}
+
+func (h *Handler) GetCycleTimeStats(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := parseStatsFilter(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	format, err := parseStatsFormat(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	buckets, err := parseCycleTimeBuckets(c.QueryParam("buckets"))
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	stats, err := h.statsUC.GetCycleTimeStats(ctx, filter, buckets)
+	if err != nil {
+		return internalError(c, fmt.Errorf("get cycle time stats: %w", err))
+	}
+
+	out := dto.CycleTimeStats{
+		Teams:     make([]dto.TeamCycleTime, len(stats.Teams)),
+		Authors:   make([]dto.AuthorCycleTime, len(stats.Authors)),
+		Histogram: make([]dto.CycleTimeBucket, len(stats.Histogram)),
+	}
+	for i, t := range stats.Teams {
+		out.Teams[i] = dto.TeamCycleTime{
+			TeamName:         t.TeamName,
+			CycleTimeSummary: toCycleTimeSummaryDTO(t.CycleTimeSummary),
+		}
+	}
+	for i, a := range stats.Authors {
+		out.Authors[i] = dto.AuthorCycleTime{
+			UserID:           a.UserID,
+			Username:         a.Username,
+			TeamName:         a.TeamName,
+			CycleTimeSummary: toCycleTimeSummaryDTO(a.CycleTimeSummary),
+		}
+	}
+	for i, b := range stats.Histogram {
+		out.Histogram[i] = dto.CycleTimeBucket{
+			FromSeconds: b.From.Seconds(),
+			Count:       b.Count,
+		}
+		if b.To != nil {
+			to := b.To.Seconds()
+			out.Histogram[i].ToSeconds = &to
+		}
+	}
+
+	return writeStats(c, format, "cycle_time", out, func() csvTable { return cycleTimeCSV(out) })
+}
+
+func toCycleTimeSummaryDTO(s domain.CycleTimeSummary) dto.CycleTimeSummary {
+	return dto.CycleTimeSummary{
+		MergedPRs:     s.MergedPRs,
+		MeanSeconds:   s.Mean.Seconds(),
+		MedianSeconds: s.Median.Seconds(),
+		P90Seconds:    s.P90.Seconds(),
+		P99Seconds:    s.P99.Seconds(),
+	}
+}
+
+func (h *Handler) GetTimeSeries(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := parseStatsFilter(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	format, err := parseStatsFormat(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	interval := domain.StatsIntervalDay
+	if raw := c.QueryParam("interval"); raw != "" {
+		interval = domain.StatsInterval(raw)
+		if !interval.IsValid() {
+			return statsQueryError(c, errors.New("interval must be one of: day, week, month"))
+		}
+	}
+
+	if err := h.setStatsFreshness(c, filter); err != nil {
+		return internalError(c, fmt.Errorf("get stats freshness: %w", err))
+	}
+
+	points, err := h.statsUC.GetTimeSeries(ctx, filter, interval)
+	if err != nil {
+		return mapDomainError(c, fmt.Errorf("get time series: %w", err))
+	}
+
+	out := make([]dto.TimeSeriesPoint, len(points))
+	for i, p := range points {
+		out[i] = dto.TimeSeriesPoint{
+			Bucket:      p.Bucket,
+			PRsOpened:   p.PRsOpened,
+			PRsMerged:   p.PRsMerged,
+			Assignments: p.Assignments,
+		}
+	}
+
+	return writeStats(c, format, "timeseries", map[string]any{
+		"interval": interval,
+		"points":   out,
+	}, func() csvTable { return timeSeriesCSV(out) })
+}
+
+func (h *Handler) GetStalePRs(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := parseStatsFilter(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	format, err := parseStatsFormat(c)
+	if err != nil {
+		return statsQueryError(c, err)
+	}
+
+	var olderThan time.Duration
+	if raw := c.QueryParam("older_than"); raw != "" {
+		olderThan, err = parseStatsDuration(raw)
+		if err != nil || olderThan <= 0 {
+			return statsQueryError(c, errors.New("older_than must be a positive duration such as 48h or 3d"))
+		}
+	}
+
+	prs, err := h.statsUC.GetStalePRs(ctx, filter, olderThan)
+	if err != nil {
+		return internalError(c, fmt.Errorf("get stale PRs: %w", err))
+	}
+
+	out := make([]dto.StalePR, len(prs))
+	for i, pr := range prs {
+		out[i] = dto.StalePR{
+			PullRequestID:     pr.PullRequestID,
+			PullRequestName:   pr.PullRequestName,
+			AuthorID:          pr.AuthorID,
+			TeamName:          pr.TeamName,
+			AssignedReviewers: pr.AssignedReviewers,
+			CreatedAt:         pr.CreatedAt,
+			AgeSeconds:        pr.Age.Seconds(),
+			ThresholdSeconds:  pr.Threshold.Seconds(),
+			FlaggedAt:         pr.FlaggedAt,
+		}
+	}
+
+	return writeStats(c, format, "stale", map[string]any{
+		"pull_requests": out,
+	}, func() csvTable { return staleCSV(out) })
+}
+
+// parseCycleTimeBuckets parses a comma-separated list of ascending histogram
+// bounds such as "1h,4h,1d,7d". Besides Go durations a whole number of days
+// ("3d") is accepted. An empty value selects the default buckets.
+func parseCycleTimeBuckets(raw string) ([]time.Duration, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(raw, ",")
+	if len(parts) > domain.MaxCycleTimeBuckets {
+		return nil, fmt.Errorf("at most %d buckets are allowed", domain.MaxCycleTimeBuckets)
+	}
+
+	buckets := make([]time.Duration, len(parts))
+	for i, part := range parts {
+		d, err := parseStatsDuration(strings.TrimSpace(part))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid bucket %q", part)
+		}
+		if i > 0 && d <= buckets[i-1] {
+			return nil, errors.New("buckets must be in ascending order")
+		}
+		buckets[i] = d
+	}
+	return buckets, nil
+}
+
+func parseStatsDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
 }
@@ -2,10 +2,12 @@ package http
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
 )
 
@@ -18,43 +20,25 @@ func (h *Handler) CreateTeam(c echo.Context) error {
 		))
 	}
 
-	if req.TeamName == "" {
-		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-			dto.ErrCodeInvalidInput,
-			"team_name is required",
-		))
-	}
-	if len(req.Members) == 0 {
-		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-			dto.ErrCodeInvalidInput,
-			"members are required",
-		))
-	}
-
-	for i, member := range req.Members {
-		if member.UserID == "" {
-			return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-				dto.ErrCodeInvalidInput,
-				"member user_id is required at index "+string(rune(i)),
-			))
-		}
-		if member.Username == "" {
-			return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-				dto.ErrCodeInvalidInput,
-				"member username is required at index "+string(rune(i)),
-			))
-		}
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
 	}
 
 	usecaseReq := usecase.CreateTeamRequest{
-		TeamName: req.TeamName,
-		Members:  make([]usecase.CreateTeamMember, len(req.Members)),
+		TeamName:       req.TeamName,
+		ParentTeamName: req.ParentTeamName,
+		Settings: domain.TeamSettings{
+			RequireLeadReview: req.RequireLeadReview,
+			StaleAfter:        time.Duration(req.StaleAfterHours) * time.Hour,
+		},
+		Members: make([]usecase.CreateTeamMember, len(req.Members)),
 	}
 	for i, m := range req.Members {
 		usecaseReq.Members[i] = usecase.CreateTeamMember{
 			UserID:   m.UserID,
 			Username: m.Username,
 			IsActive: m.IsActive,
+			Role:     domain.TeamRole(m.Role),
 		}
 	}
 
@@ -68,15 +52,112 @@ func (h *Handler) CreateTeam(c echo.Context) error {
 }
 
 func (h *Handler) GetTeam(c echo.Context) error {
-	teamName := c.QueryParam("team_name")
-	if teamName == "" {
+	return h.getTeam(c, c.QueryParam("team_name"))
+}
+
+func (h *Handler) getTeam(c echo.Context, teamName string) error {
+	if err := c.Validate(&teamNameParam{TeamName: teamName}); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	team, err := h.teamUC.GetTeam(c.Request().Context(), teamName)
+	if err != nil {
+		return mapDomainError(c, err)
+	}
+
+	response := dto.ToTeamResponse(team)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) AddTeamMember(c echo.Context) error {
+	var req dto.AddTeamMemberRequest
+	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
 			dto.ErrCodeInvalidInput,
-			"team_name query parameter is required",
+			"invalid JSON: "+err.Error(),
 		))
 	}
+	return h.addTeamMember(c, req)
+}
 
-	team, err := h.teamUC.GetTeam(c.Request().Context(), teamName)
+func (h *Handler) addTeamMember(c echo.Context, req dto.AddTeamMemberRequest) error {
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	usecaseReq := usecase.AddTeamMemberRequest{
+		TeamName: req.TeamName,
+		UserID:   req.UserID,
+		Role:     domain.TeamRole(req.Role),
+		ActorID:  req.ActorID,
+	}
+
+	team, err := h.teamUC.AddMember(c.Request().Context(), usecaseReq)
+	if err != nil {
+		return mapDomainError(c, err)
+	}
+
+	response := dto.ToTeamResponse(team)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) SetParentTeam(c echo.Context) error {
+	var req dto.SetParentTeamRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+			dto.ErrCodeInvalidInput,
+			"invalid JSON: "+err.Error(),
+		))
+	}
+	return h.setParentTeam(c, req)
+}
+
+func (h *Handler) setParentTeam(c echo.Context, req dto.SetParentTeamRequest) error {
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	usecaseReq := usecase.SetParentTeamRequest{
+		TeamName:       req.TeamName,
+		ParentTeamName: req.ParentTeamName,
+		ActorID:        req.ActorID,
+	}
+
+	team, err := h.teamUC.SetParentTeam(c.Request().Context(), usecaseReq)
+	if err != nil {
+		return mapDomainError(c, err)
+	}
+
+	response := dto.ToTeamResponse(team)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) UpdateTeamSettings(c echo.Context) error {
+	var req dto.UpdateTeamSettingsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+			dto.ErrCodeInvalidInput,
+			"invalid JSON: "+err.Error(),
+		))
+	}
+	return h.updateTeamSettings(c, req)
+}
+
+func (h *Handler) updateTeamSettings(c echo.Context, req dto.UpdateTeamSettingsRequest) error {
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	usecaseReq := usecase.UpdateTeamSettingsRequest{
+		TeamName: req.TeamName,
+		Settings: domain.TeamSettings{
+			RequireLeadReview: req.RequireLeadReview,
+			StaleAfter:        time.Duration(req.StaleAfterHours) * time.Hour,
+		},
+		ActorID: req.ActorID,
+	}
+
+	team, err := h.teamUC.UpdateSettings(c.Request().Context(), usecaseReq)
 	if err != nil {
 		return mapDomainError(c, err)
 	}
@@ -84,3 +165,17 @@ func (h *Handler) GetTeam(c echo.Context) error {
 	response := dto.ToTeamResponse(team)
 	return c.JSON(http.StatusOK, response)
 }
+
+func (h *Handler) GetTeamTree(c echo.Context) error {
+	return h.getTeamTree(c, c.QueryParam("team_name"))
+}
+
+func (h *Handler) getTeamTree(c echo.Context, rootTeamName string) error {
+	tree, err := h.teamUC.GetTeamTree(c.Request().Context(), rootTeamName)
+	if err != nil {
+		return mapDomainError(c, err)
+	}
+
+	response := dto.ToTeamTreeResponse(tree)
+	return c.JSON(http.StatusOK, response)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
 )
 
@@ -18,11 +19,8 @@ func (h *Handler) SetUserIsActive(c echo.Context) error {
 		))
 	}
 
-	if req.UserID == "" {
-		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
-			dto.ErrCodeInvalidInput,
-			"user_id is required",
-		))
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
 	}
 
 	usecaseReq := usecase.SetUserIsActiveRequest{
@@ -40,19 +38,69 @@ func (h *Handler) SetUserIsActive(c echo.Context) error {
 }
 
 func (h *Handler) GetReviewerPRs(c echo.Context) error {
-	userID := c.QueryParam("user_id")
-	if userID == "" {
+	return h.getReviewerPRs(c, c.QueryParam("user_id"))
+}
+
+func (h *Handler) getReviewerPRs(c echo.Context, userID string) error {
+	if err := c.Validate(&userIDParam{UserID: userID}); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	prs, err := h.prUC.GetReviewerPRs(c.Request().Context(), userID)
+	if err != nil {
+		return mapDomainError(c, err)
+	}
+
+	response := dto.ToGetReviewerPRsResponse(userID, prs)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) GetUser(c echo.Context) error {
+	return h.getUser(c, c.QueryParam("user_id"))
+}
+
+func (h *Handler) getUser(c echo.Context, userID string) error {
+	if err := c.Validate(&userIDParam{UserID: userID}); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	profile, err := h.userUC.GetUserProfile(c.Request().Context(), userID)
+	if err != nil {
+		return mapDomainError(c, err)
+	}
+
+	response := dto.ToUserProfileResponse(profile)
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) UpdateUser(c echo.Context) error {
+	var req dto.UpdateUserRequest
+	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
 			dto.ErrCodeInvalidInput,
-			"user_id query parameter is required",
+			"invalid JSON: "+err.Error(),
 		))
 	}
 
-	prs, err := h.prUC.GetReviewerPRs(c.Request().Context(), userID)
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	username, err := domain.NormalizeUsername(req.Username)
 	if err != nil {
 		return mapDomainError(c, err)
 	}
 
-	response := dto.ToGetReviewerPRsResponse(userID, prs)
+	usecaseReq := usecase.UpdateUserRequest{
+		UserID:   req.UserID,
+		Username: username,
+	}
+
+	user, err := h.userUC.UpdateUser(c.Request().Context(), usecaseReq)
+	if err != nil {
+		return mapDomainError(c, err)
+	}
+
+	response := dto.ToUserResponse(user)
 	return c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+// The /v1 handlers take resource identifiers from the path and share the
+// validation and usecase calls with the legacy handlers. A path identifier
+// always wins over the same field in the body.
+
+func (h *Handler) GetTeamV1(c echo.Context) error {
+	return h.getTeam(c, c.Param("name"))
+}
+
+func (h *Handler) GetTeamTreeV1(c echo.Context) error {
+	return h.getTeamTree(c, c.Param("name"))
+}
+
+func (h *Handler) SetParentTeamV1(c echo.Context) error {
+	var req dto.SetParentTeamRequest
+	if err := c.Bind(&req); err != nil {
+		return invalidJSON(c, err)
+	}
+	req.TeamName = c.Param("name")
+	return h.setParentTeam(c, req)
+}
+
+func (h *Handler) AddTeamMemberV1(c echo.Context) error {
+	var req dto.AddTeamMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return invalidJSON(c, err)
+	}
+	req.TeamName = c.Param("name")
+	return h.addTeamMember(c, req)
+}
+
+func (h *Handler) UpdateTeamSettingsV1(c echo.Context) error {
+	var req dto.UpdateTeamSettingsRequest
+	if err := c.Bind(&req); err != nil {
+		return invalidJSON(c, err)
+	}
+	req.TeamName = c.Param("name")
+	return h.updateTeamSettings(c, req)
+}
+
+func (h *Handler) GetUserV1(c echo.Context) error {
+	return h.getUser(c, c.Param("id"))
+}
+
+func (h *Handler) GetReviewerPRsV1(c echo.Context) error {
+	return h.getReviewerPRs(c, c.Param("id"))
+}
+
+// PatchUserV1 changes the username and/or the active flag in one transaction.
+func (h *Handler) PatchUserV1(c echo.Context) error {
+	var req dto.PatchUserRequest
+	if err := c.Bind(&req); err != nil {
+		return invalidJSON(c, err)
+	}
+
+	userID := c.Param("id")
+	if err := c.Validate(&userIDParam{UserID: userID}); err != nil {
+		return invalidRequest(c, err)
+	}
+	if err := c.Validate(&req); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	if req.Username == nil && req.IsActive == nil {
+		return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+			dto.ErrCodeInvalidInput,
+			"username or is_active is required",
+		))
+	}
+
+	user, err := h.userUC.PatchUser(c.Request().Context(), usecase.PatchUserRequest{
+		UserID:   userID,
+		Username: req.Username,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		return mapDomainError(c, err)
+	}
+
+	response := dto.ToUserResponse(user)
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetPRV1 returns the PR with its version in the ETag header, ready to be
+// sent back in If-Match.
+func (h *Handler) GetPRV1(c echo.Context) error {
+	prID := c.Param("id")
+	if err := c.Validate(&prIDParam{PullRequestID: prID}); err != nil {
+		return invalidRequest(c, err)
+	}
+
+	pr, err := h.prUC.GetPR(c.Request().Context(), prID)
+	if err != nil {
+		return mapDomainError(c, err)
+	}
+
+	setPRETag(c, pr)
+	return c.JSON(http.StatusOK, dto.ToPRResponse(pr))
+}
+
+func (h *Handler) MergePRV1(c echo.Context) error {
+	var req dto.MergePRRequest
+	if err := c.Bind(&req); err != nil {
+		return invalidJSON(c, err)
+	}
+	req.PullRequestID = c.Param("id")
+	return h.mergePR(c, req)
+}
+
+func (h *Handler) ReassignReviewerV1(c echo.Context) error {
+	var req dto.ReassignReviewerRequest
+	if err := c.Bind(&req); err != nil {
+		return invalidJSON(c, err)
+	}
+	req.PullRequestID = c.Param("id")
+	return h.reassignReviewer(c, req)
+}
+
+func invalidJSON(c echo.Context, err error) error {
+	return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+		dto.ErrCodeInvalidInput,
+		"invalid JSON: "+err.Error(),
+	))
+}
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/metrics"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+// v1PRUseCase knows a single PR, pr-1, at version 4.
+type v1PRUseCase struct {
+	usecase.PRUseCase
+	merged []usecase.MergePRRequest
+}
+
+func (u *v1PRUseCase) GetPR(_ context.Context, prID string) (*domain.PullRequest, error) {
+	if prID != "pr-1" {
+		return nil, domain.ErrPRNotFound
+	}
+	createdAt := time.Now()
+	return &domain.PullRequest{PullRequestID: "pr-1", Status: domain.PRStatusOpen, CreatedAt: &createdAt, Version: 4}, nil
+}
+
+func (u *v1PRUseCase) MergePR(_ context.Context, req usecase.MergePRRequest) (*domain.PullRequest, error) {
+	u.merged = append(u.merged, req)
+	now := time.Now()
+	return &domain.PullRequest{
+		PullRequestID: req.PullRequestID,
+		Status:        domain.PRStatusMerged,
+		CreatedAt:     &now,
+		MergedAt:      &now,
+		Version:       5,
+	}, nil
+}
+
+type v1UserUseCase struct {
+	usecase.UserUseCase
+	patched []usecase.PatchUserRequest
+}
+
+func (u *v1UserUseCase) PatchUser(_ context.Context, req usecase.PatchUserRequest) (*domain.User, error) {
+	u.patched = append(u.patched, req)
+	user := &domain.User{UserID: req.UserID, Username: "Alice", IsActive: true}
+	if req.Username != nil {
+		user.Username = *req.Username
+	}
+	if req.IsActive != nil {
+		user.IsActive = *req.IsActive
+	}
+	return user, nil
+}
+
+func (u *v1UserUseCase) GetUserProfile(_ context.Context, userID string) (*domain.UserProfile, error) {
+	return &domain.UserProfile{User: domain.User{UserID: userID, Username: "Alice", IsActive: true}}, nil
+}
+
+func TestHandler_V1Routes(t *testing.T) {
+	prUC := &v1PRUseCase{}
+	userUC := &v1UserUseCase{}
+	graphqlHandler := func(echo.Context) error { return nil }
+	e := NewRouter(NewHandler(nil, userUC, prUC, nil, nil, nil), graphqlHandler, metrics.New(nil, nil))
+	e.Logger.SetOutput(io.Discard)
+
+	send := func(method, target, body string, header map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		for name, value := range header {
+			req.Header.Set(name, value)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("path id wins over the body", func(t *testing.T) {
+		rec := send(http.MethodPost, "/v1/pull-requests/pr-1/merge", `{"pull_request_id": "pr-2"}`,
+			map[string]string{headerIfMatch: `"4"`})
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, prUC.merged, 1)
+		assert.Equal(t, "pr-1", prUC.merged[0].PullRequestID)
+		assert.Equal(t, int64(4), prUC.merged[0].ExpectedVersion)
+		assert.Equal(t, `"5"`, rec.Header().Get(headerETag))
+	})
+
+	t.Run("patch changes only the fields sent", func(t *testing.T) {
+		rec := send(http.MethodPatch, "/v1/users/u1", `{"is_active": false}`, nil)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, userUC.patched, 1)
+		assert.Equal(t, "u1", userUC.patched[0].UserID)
+		assert.Nil(t, userUC.patched[0].Username)
+		require.NotNil(t, userUC.patched[0].IsActive)
+		assert.False(t, *userUC.patched[0].IsActive)
+	})
+
+	t.Run("patch without fields is rejected", func(t *testing.T) {
+		before := len(userUC.patched)
+
+		rec := send(http.MethodPatch, "/v1/users/u1", `{}`, nil)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), dto.ErrCodeInvalidInput)
+		assert.Len(t, userUC.patched, before)
+	})
+
+	t.Run("get PR returns its version as the ETag", func(t *testing.T) {
+		rec := send(http.MethodGet, "/v1/pull-requests/pr-1", "", nil)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"4"`, rec.Header().Get(headerETag))
+		assert.Contains(t, rec.Body.String(), `"pull_request_id":"pr-1"`)
+	})
+
+	t.Run("get unknown PR has no ETag", func(t *testing.T) {
+		rec := send(http.MethodGet, "/v1/pull-requests/pr-9", "", nil)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Empty(t, rec.Header().Get(headerETag))
+	})
+
+	t.Run("legacy routes point at their successor", func(t *testing.T) {
+		rec := send(http.MethodGet, "/users/get?user_id=u1", "", nil)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "true", rec.Header().Get("Deprecation"))
+		assert.Equal(t, `</v1/users/{id}>; rel="successor-version"`, rec.Header().Get("Link"))
+	})
+
+	t.Run("v1 routes are not deprecated", func(t *testing.T) {
+		rec := send(http.MethodGet, "/v1/users/u1", "", nil)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Deprecation"))
+		assert.Empty(t, rec.Header().Get("Link"))
+	})
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+)
+
+const maxIDLength = 64
+
+var idPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// requestValidator enforces the validate tags of the dto structs. Besides the
+// standard rules it knows "id": at most maxIDLength letters, digits, dots,
+// dashes and underscores.
+type requestValidator struct {
+	validate *validator.Validate
+}
+
+func newRequestValidator() *requestValidator {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	// The rule is registered once at startup with a static tag; it cannot fail.
+	_ = v.RegisterValidation("id", func(fl validator.FieldLevel) bool {
+		s := fl.Field().String()
+		return len(s) <= maxIDLength && idPattern.MatchString(s)
+	})
+
+	return &requestValidator{validate: v}
+}
+
+// validationError carries every failed rule of a request so that clients can
+// fix them in one go.
+type validationError struct {
+	details []dto.FieldError
+}
+
+func (e *validationError) Error() string {
+	return dto.NewValidationErrorResponse(e.details).Error.Message
+}
+
+func (rv *requestValidator) Validate(i any) error {
+	err := rv.validate.Struct(i)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+
+	details := make([]dto.FieldError, len(fieldErrs))
+	for i, fe := range fieldErrs {
+		details[i] = dto.FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Message: ruleMessage(fe),
+		}
+	}
+	return &validationError{details: details}
+}
+
+// fieldPath drops the struct name from the namespace, leaving the JSON path.
+func fieldPath(fe validator.FieldError) string {
+	_, path, found := strings.Cut(fe.Namespace(), ".")
+	if !found {
+		return fe.Field()
+	}
+	return path
+}
+
+func ruleMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "id":
+		return fmt.Sprintf("must be at most %d characters of letters, digits, '.', '-' or '_'", maxIDLength)
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case "max":
+		if fe.Kind() == reflect.String {
+			return "must be at most " + fe.Param() + " characters"
+		}
+		return "must be at most " + fe.Param()
+	case "min":
+		switch fe.Kind() {
+		case reflect.Slice, reflect.Map:
+			return "must contain at least " + fe.Param() + " item(s)"
+		case reflect.String:
+			return "must be at least " + fe.Param() + " characters"
+		default:
+			return "must be at least " + fe.Param()
+		}
+	default:
+		return "failed the " + fe.Tag() + " rule"
+	}
+}
+
+// invalidRequest writes the 400 response for an error returned by c.Validate.
+func invalidRequest(c echo.Context, err error) error {
+	var ve *validationError
+	if errors.As(err, &ve) {
+		return c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse(ve.details))
+	}
+	return c.JSON(http.StatusBadRequest, dto.NewErrorResponse(
+		dto.ErrCodeInvalidInput,
+		err.Error(),
+	))
+}
+
+// Identifiers taken from the query string or the path are checked with the
+// same rules as body fields.
+type teamNameParam struct {
+	TeamName string `json:"team_name" validate:"required,max=100"`
+}
+
+type userIDParam struct {
+	UserID string `json:"user_id" validate:"required,id"`
+}
+
+type prIDParam struct {
+	PullRequestID string `json:"pull_request_id" validate:"required,id"`
+}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/delivery/http/dto"
+)
+
+func TestRequestValidator_Validate(t *testing.T) {
+	v := newRequestValidator()
+
+	t.Run("valid request", func(t *testing.T) {
+		err := v.Validate(&dto.CreatePRRequest{
+			PullRequestID:   "pr-1001.v2",
+			PullRequestName: "Add search",
+			AuthorID:        "u_1",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("reports every field with its JSON path", func(t *testing.T) {
+		err := v.Validate(&dto.CreateTeamRequest{
+			StaleAfterHours: -1,
+			Members: []dto.TeamMember{
+				{UserID: "u1", Username: "Alice"},
+				{UserID: "u 2", Username: "Bob", Role: "boss"},
+			},
+		})
+
+		var ve *validationError
+		require.ErrorAs(t, err, &ve)
+		assert.Equal(t, []dto.FieldError{
+			{Field: "team_name", Rule: "required", Message: "is required"},
+			{Field: "stale_after_hours", Rule: "min", Message: "must be at least 0"},
+			{Field: "members[1].user_id", Rule: "id", Message: ruleMessageID},
+			{Field: "members[1].role", Rule: "oneof", Message: "must be one of lead, member, observer"},
+		}, ve.details)
+	})
+
+	t.Run("id length limit", func(t *testing.T) {
+		err := v.Validate(&userIDParam{UserID: strings.Repeat("a", maxIDLength+1)})
+
+		var ve *validationError
+		require.ErrorAs(t, err, &ve)
+		require.Len(t, ve.details, 1)
+		assert.Equal(t, "id", ve.details[0].Rule)
+
+		assert.NoError(t, v.Validate(&userIDParam{UserID: strings.Repeat("a", maxIDLength)}))
+	})
+
+	t.Run("empty members", func(t *testing.T) {
+		err := v.Validate(&dto.CreateTeamRequest{TeamName: "backend", Members: []dto.TeamMember{}})
+
+		var ve *validationError
+		require.ErrorAs(t, err, &ve)
+		assert.Equal(t, []dto.FieldError{
+			{Field: "members", Rule: "min", Message: "must contain at least 1 item(s)"},
+		}, ve.details)
+	})
+}
+
+const ruleMessageID = "must be at most 64 characters of letters, digits, '.', '-' or '_'"
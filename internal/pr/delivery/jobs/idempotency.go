@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+// IdempotencyKeyPurger periodically deletes stored responses whose
+// Idempotency-Key has expired.
+type IdempotencyKeyPurger struct {
+	idempotencyUC usecase.IdempotencyUseCase
+	interval      time.Duration
+}
+
+func NewIdempotencyKeyPurger(idempotencyUC usecase.IdempotencyUseCase, interval time.Duration) *IdempotencyKeyPurger {
+	return &IdempotencyKeyPurger{
+		idempotencyUC: idempotencyUC,
+		interval:      interval,
+	}
+}
+
+// Run purges once immediately and then every interval until ctx is done.
+func (p *IdempotencyKeyPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *IdempotencyKeyPurger) purge(ctx context.Context) {
+	deleted, err := p.idempotencyUC.PurgeExpired(ctx)
+	if err != nil {
+		log.Printf("Failed to purge expired idempotency keys: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("Purged %d expired idempotency keys", deleted)
+	}
+}
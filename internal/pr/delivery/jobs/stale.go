@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+)
+
+// StalePRFlagger periodically flags open PRs that outgrew their team's stale
+// threshold and logs each of them once, so leads can follow up.
+type StalePRFlagger struct {
+	prUC       usecase.PRUseCase
+	defaultAge time.Duration
+	interval   time.Duration
+}
+
+func NewStalePRFlagger(prUC usecase.PRUseCase, defaultAge, interval time.Duration) *StalePRFlagger {
+	return &StalePRFlagger{
+		prUC:       prUC,
+		defaultAge: defaultAge,
+		interval:   interval,
+	}
+}
+
+// Run checks once immediately and then every interval until ctx is done.
+func (f *StalePRFlagger) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		f.flag(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (f *StalePRFlagger) flag(ctx context.Context) {
+	prs, err := f.prUC.FlagStalePRs(ctx, f.defaultAge)
+	if err != nil {
+		log.Printf("Failed to flag stale PRs: %v", err)
+		return
+	}
+
+	for _, pr := range prs {
+		log.Printf("Stale PR %s %q by %s", pr.PullRequestID, pr.PullRequestName, pr.AuthorID)
+	}
+}
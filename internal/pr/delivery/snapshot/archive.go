@@ -0,0 +1,246 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+const FormatVersion = 3
+
+// Version 1 archives predate team memberships, so users.team_name is the
+// only membership restored from them. Versions 1 and 2 carry no assignment
+// times, PR versions or stale flags: reviewers count as assigned when their
+// PR was created and PRs start again at version 1.
+const legacyFormatVersion = 1
+
+var ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+
+type Archive struct {
+	Version           int                `json:"version"`
+	ExportedAt        time.Time          `json:"exported_at"`
+	Teams             []Team             `json:"teams"`
+	Users             []User             `json:"users"`
+	TeamMemberships   []TeamMembership   `json:"team_memberships"`
+	PullRequests      []PullRequest      `json:"pull_requests"`
+	AssignedReviewers []AssignedReviewer `json:"assigned_reviewers"`
+}
+
+type Team struct {
+	TeamName          string `json:"team_name"`
+	ParentTeamName    string `json:"parent_team_name,omitempty"`
+	RequireLeadReview bool   `json:"require_lead_review,omitempty"`
+	StaleAfterSeconds int64  `json:"stale_after_seconds,omitempty"`
+}
+
+type User struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	IsActive bool   `json:"is_active"`
+}
+
+type TeamMembership struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+}
+
+type PullRequest struct {
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	TeamName        string     `json:"team_name,omitempty"`
+	Status          string     `json:"status"`
+	CreatedAt       time.Time  `json:"created_at"`
+	MergedAt        *time.Time `json:"merged_at"`
+	Version         int64      `json:"version,omitempty"`
+	StaleFlaggedAt  *time.Time `json:"stale_flagged_at,omitempty"`
+}
+
+type AssignedReviewer struct {
+	PullRequestID string     `json:"pr_id"`
+	ReviewerID    string     `json:"reviewer_id"`
+	AssignedAt    *time.Time `json:"assigned_at,omitempty"`
+}
+
+func Write(w io.Writer, snapshot *domain.Snapshot, exportedAt time.Time) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(ToArchive(snapshot, exportedAt)); err != nil {
+		return fmt.Errorf("encode snapshot: %w", err)
+	}
+	return nil
+}
+
+func Read(r io.Reader) (*domain.Snapshot, error) {
+	var archive Archive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+
+	if archive.Version < legacyFormatVersion || archive.Version > FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, archive.Version)
+	}
+
+	return archive.ToDomain(), nil
+}
+
+func ToArchive(snapshot *domain.Snapshot, exportedAt time.Time) Archive {
+	archive := Archive{
+		Version:           FormatVersion,
+		ExportedAt:        exportedAt.UTC(),
+		Teams:             make([]Team, len(snapshot.Teams)),
+		Users:             make([]User, len(snapshot.Users)),
+		TeamMemberships:   make([]TeamMembership, len(snapshot.Memberships)),
+		PullRequests:      make([]PullRequest, len(snapshot.PullRequests)),
+		AssignedReviewers: []AssignedReviewer{},
+	}
+
+	for i, t := range snapshot.Teams {
+		archive.Teams[i] = Team{
+			TeamName:          t.TeamName,
+			ParentTeamName:    t.ParentTeamName,
+			RequireLeadReview: t.Settings.RequireLeadReview,
+			StaleAfterSeconds: int64(t.Settings.StaleAfter / time.Second),
+		}
+	}
+
+	for i, u := range snapshot.Users {
+		archive.Users[i] = User{
+			UserID:   u.UserID,
+			Username: u.Username,
+			TeamName: u.TeamName,
+			IsActive: u.IsActive,
+		}
+	}
+
+	for i, m := range snapshot.Memberships {
+		archive.TeamMemberships[i] = TeamMembership{
+			TeamName: m.TeamName,
+			UserID:   m.UserID,
+			Role:     string(m.Role),
+		}
+	}
+
+	for i, pr := range snapshot.PullRequests {
+		archive.PullRequests[i] = PullRequest{
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			TeamName:        pr.TeamName,
+			Status:          string(pr.Status),
+			MergedAt:        pr.MergedAt,
+			Version:         pr.Version,
+			StaleFlaggedAt:  pr.StaleFlaggedAt,
+		}
+		if pr.CreatedAt != nil {
+			archive.PullRequests[i].CreatedAt = *pr.CreatedAt
+		}
+
+		for _, reviewerID := range pr.AssignedReviewers {
+			reviewer := AssignedReviewer{
+				PullRequestID: pr.PullRequestID,
+				ReviewerID:    reviewerID,
+			}
+			if assignedAt, ok := pr.ReviewersAssignedAt[reviewerID]; ok {
+				reviewer.AssignedAt = &assignedAt
+			}
+			archive.AssignedReviewers = append(archive.AssignedReviewers, reviewer)
+		}
+	}
+
+	return archive
+}
+
+func (a Archive) ToDomain() *domain.Snapshot {
+	snapshot := &domain.Snapshot{
+		Teams:        make([]domain.Team, len(a.Teams)),
+		Users:        make([]domain.User, len(a.Users)),
+		Memberships:  make([]domain.TeamMembership, 0, len(a.TeamMemberships)),
+		PullRequests: make([]domain.PullRequest, len(a.PullRequests)),
+	}
+
+	for i, t := range a.Teams {
+		snapshot.Teams[i] = domain.Team{
+			TeamName:       t.TeamName,
+			ParentTeamName: t.ParentTeamName,
+			Settings: domain.TeamSettings{
+				RequireLeadReview: t.RequireLeadReview,
+				StaleAfter:        time.Duration(t.StaleAfterSeconds) * time.Second,
+			},
+		}
+	}
+
+	for i, u := range a.Users {
+		snapshot.Users[i] = domain.User{
+			UserID:   u.UserID,
+			Username: u.Username,
+			TeamName: u.TeamName,
+			IsActive: u.IsActive,
+		}
+	}
+
+	for _, m := range a.TeamMemberships {
+		snapshot.Memberships = append(snapshot.Memberships, domain.TeamMembership{
+			TeamName: m.TeamName,
+			UserID:   m.UserID,
+			Role:     domain.TeamRole(m.Role),
+		})
+	}
+	if a.Version == legacyFormatVersion {
+		for _, u := range a.Users {
+			snapshot.Memberships = append(snapshot.Memberships, domain.TeamMembership{
+				TeamName: u.TeamName,
+				UserID:   u.UserID,
+				Role:     domain.TeamRoleMember,
+			})
+		}
+	}
+
+	reviewersByPR := make(map[string][]string)
+	assignedAtByPR := make(map[string]map[string]time.Time)
+	for _, ar := range a.AssignedReviewers {
+		reviewersByPR[ar.PullRequestID] = append(reviewersByPR[ar.PullRequestID], ar.ReviewerID)
+		if ar.AssignedAt == nil {
+			continue
+		}
+		if assignedAtByPR[ar.PullRequestID] == nil {
+			assignedAtByPR[ar.PullRequestID] = make(map[string]time.Time)
+		}
+		assignedAtByPR[ar.PullRequestID][ar.ReviewerID] = *ar.AssignedAt
+	}
+
+	userTeams := make(map[string]string, len(a.Users))
+	for _, u := range a.Users {
+		userTeams[u.UserID] = u.TeamName
+	}
+
+	for i, pr := range a.PullRequests {
+		createdAt := pr.CreatedAt
+		teamName := pr.TeamName
+		if teamName == "" {
+			teamName = userTeams[pr.AuthorID]
+		}
+		snapshot.PullRequests[i] = domain.PullRequest{
+			PullRequestID:       pr.PullRequestID,
+			PullRequestName:     pr.PullRequestName,
+			AuthorID:            pr.AuthorID,
+			TeamName:            teamName,
+			Status:              domain.PRStatus(pr.Status),
+			AssignedReviewers:   reviewersByPR[pr.PullRequestID],
+			CreatedAt:           &createdAt,
+			MergedAt:            pr.MergedAt,
+			Version:             pr.Version,
+			StaleFlaggedAt:      pr.StaleFlaggedAt,
+			ReviewersAssignedAt: assignedAtByPR[pr.PullRequestID],
+		}
+	}
+
+	return snapshot
+}
@@ -0,0 +1,89 @@
+package snapshot
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 11, 19, 9, 0, 0, 0, time.UTC)
+	reassignedAt := time.Date(2025, 11, 19, 11, 0, 0, 0, time.UTC)
+	flaggedAt := time.Date(2025, 11, 21, 9, 0, 0, 0, time.UTC)
+
+	original := &domain.Snapshot{
+		Teams:       []domain.Team{{TeamName: "backend"}},
+		Users:       []domain.User{{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}},
+		Memberships: []domain.TeamMembership{{TeamName: "backend", UserID: "u1", Role: domain.TeamRoleMember}},
+		PullRequests: []domain.PullRequest{{
+			PullRequestID:     "pr-1001",
+			PullRequestName:   "Add search",
+			AuthorID:          "u1",
+			TeamName:          "backend",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u2", "u3"},
+			CreatedAt:         &createdAt,
+			Version:           3,
+			StaleFlaggedAt:    &flaggedAt,
+			ReviewersAssignedAt: map[string]time.Time{
+				"u2": createdAt,
+				"u3": reassignedAt,
+			},
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, original, time.Now()))
+
+	restored, err := Read(&buf)
+	require.NoError(t, err)
+	require.Len(t, restored.PullRequests, 1)
+
+	pr := restored.PullRequests[0]
+	assert.Equal(t, int64(3), pr.Version)
+	require.NotNil(t, pr.StaleFlaggedAt)
+	assert.True(t, flaggedAt.Equal(*pr.StaleFlaggedAt))
+	assert.Equal(t, []string{"u2", "u3"}, pr.AssignedReviewers)
+	assert.True(t, reassignedAt.Equal(pr.ReviewersAssignedAt["u3"]))
+	assert.True(t, createdAt.Equal(pr.ReviewersAssignedAt["u2"]))
+}
+
+func TestRead(t *testing.T) {
+	t.Run("version 2 archive has no assignment times", func(t *testing.T) {
+		restored, err := Read(strings.NewReader(`{
+			"version": 2,
+			"users": [{"user_id": "u1", "username": "Alice", "team_name": "backend", "is_active": true}],
+			"pull_requests": [{"pull_request_id": "pr-1", "pull_request_name": "One", "author_id": "u1",
+				"status": "OPEN", "created_at": "2025-11-19T09:00:00Z", "merged_at": null}],
+			"assigned_reviewers": [{"pr_id": "pr-1", "reviewer_id": "u2"}]
+		}`))
+
+		require.NoError(t, err)
+		require.Len(t, restored.PullRequests, 1)
+		assert.Equal(t, []string{"u2"}, restored.PullRequests[0].AssignedReviewers)
+		assert.Nil(t, restored.PullRequests[0].ReviewersAssignedAt)
+		assert.Zero(t, restored.PullRequests[0].Version)
+	})
+
+	t.Run("version 1 archive restores memberships from users", func(t *testing.T) {
+		restored, err := Read(strings.NewReader(`{
+			"version": 1,
+			"users": [{"user_id": "u1", "username": "Alice", "team_name": "backend", "is_active": true}]
+		}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.TeamMembership{{TeamName: "backend", UserID: "u1", Role: domain.TeamRoleMember}}, restored.Memberships)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := Read(strings.NewReader(`{"version": 4}`))
+
+		assert.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+}
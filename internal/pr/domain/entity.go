@@ -1,10 +1,57 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
 
 type Team struct {
+	TeamName       string
+	ParentTeamName string
+	Settings       TeamSettings
+	Members        []TeamMember
+}
+
+type TeamSettings struct {
+	RequireLeadReview bool
+	// StaleAfter is the age after which an open PR of the team counts as
+	// stale; zero means the service-wide default.
+	StaleAfter time.Duration
+}
+
+type TeamRole string
+
+const (
+	TeamRoleLead     TeamRole = "lead"
+	TeamRoleMember   TeamRole = "member"
+	TeamRoleObserver TeamRole = "observer"
+)
+
+func (r TeamRole) IsValid() bool {
+	switch r {
+	case TeamRoleLead, TeamRoleMember, TeamRoleObserver:
+		return true
+	default:
+		return false
+	}
+}
+
+type TeamMember struct {
+	User
+	Role TeamRole
+}
+
+type TeamMembership struct {
 	TeamName string
-	Members  []User
+	UserID   string
+	Role     TeamRole
+}
+
+type TeamTreeNode struct {
+	TeamName string
+	Children []TeamTreeNode
 }
 
 type User struct {
@@ -14,16 +61,58 @@ type User struct {
 	IsActive bool
 }
 
+const MaxUsernameLength = 100
+
+// NormalizeUsername trims surrounding whitespace and checks that the result is
+// neither empty nor longer than MaxUsernameLength.
+func NormalizeUsername(raw string) (string, error) {
+	username := strings.TrimSpace(raw)
+	if username == "" {
+		return "", RequiredError("username")
+	}
+	if utf8.RuneCountInString(username) > MaxUsernameLength {
+		return "", NewValidationError("username", "max", fmt.Sprintf("must be at most %d characters", MaxUsernameLength))
+	}
+	return username, nil
+}
+
+type UserProfile struct {
+	User
+	Teams            []string
+	OpenReviewsCount int64
+	AuthoredOpenPRs  []PullRequestShort
+}
+
 type PullRequest struct {
 	PullRequestID     string
 	PullRequestName   string
 	AuthorID          string
+	TeamName          string
 	Status            PRStatus
 	AssignedReviewers []string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	// Version grows with every change of the PR or its reviewers and is
+	// used for optimistic concurrency control.
+	Version int64
+	// StaleFlaggedAt is when the PR was last reported as stale.
+	StaleFlaggedAt *time.Time
+	// ReviewersAssignedAt holds when each reviewer was assigned. Only
+	// snapshots fill it in.
+	ReviewersAssignedAt map[string]time.Time
+}
+
+// ReviewerCandidate is an active, non-observer team member together with the
+// number of open PRs they already review.
+type ReviewerCandidate struct {
+	UserID      string
+	Role        TeamRole
+	OpenReviews int64
 }
 
+// MaxPRBatchSize limits the number of PRs created by one batch request.
+const MaxPRBatchSize = 1000
+
 type PRStatus string
 
 const (
@@ -38,11 +127,44 @@ type PullRequestShort struct {
 	Status          PRStatus
 }
 
+// StatsFilter narrows statistics to a half-open [From, To) time window and
+// a single team. Zero values mean no restriction.
+type StatsFilter struct {
+	From     *time.Time
+	To       *time.Time
+	TeamName string
+}
+
+// DayAligned reports whether both bounds fall on UTC midnight, so the filter
+// can be answered from the daily aggregate tables.
+func (f StatsFilter) DayAligned() bool {
+	return isMidnight(f.From) && isMidnight(f.To)
+}
+
+func isMidnight(t *time.Time) bool {
+	if t == nil {
+		return true
+	}
+	u := t.UTC()
+	return u.Equal(u.Truncate(24 * time.Hour))
+}
+
+// StatsFreshness tells when the aggregate tables were last changed
+// incrementally and when they were last rebuilt from scratch.
+type StatsFreshness struct {
+	UpdatedAt time.Time
+	RebuiltAt time.Time
+}
+
 type UserAssignmentStats struct {
 	UserID           string
 	Username         string
 	TeamName         string
 	AssignmentsCount int64
+	// ReviewsMerged counts the user's reviews of PRs merged in the range;
+	// MeanTimeInReview averages their time from assignment to merge.
+	ReviewsMerged    int64
+	MeanTimeInReview time.Duration
 }
 
 type PRStats struct {
@@ -52,8 +174,243 @@ type PRStats struct {
 }
 
 type ReviewerWorkload struct {
-	UserID       string
-	Username     string
-	TeamName     string
-	OpenPRsCount int64
+	UserID               string
+	Username             string
+	TeamName             string
+	OpenPRsCount         int64
+	OldestOpenAge        time.Duration
+	AssignmentsLast7Days int64
+}
+
+type WorkloadSort string
+
+const (
+	WorkloadSortOpenPRs           WorkloadSort = "open_prs"
+	WorkloadSortOldestOpen        WorkloadSort = "oldest_open"
+	WorkloadSortRecentAssignments WorkloadSort = "recent_assignments"
+	WorkloadSortUsername          WorkloadSort = "username"
+)
+
+func (s WorkloadSort) IsValid() bool {
+	switch s {
+	case WorkloadSortOpenPRs, WorkloadSortOldestOpen, WorkloadSortRecentAssignments, WorkloadSortUsername:
+		return true
+	default:
+		return false
+	}
+}
+
+type TeamOpenWorkload struct {
+	TeamName        string
+	OpenPRs         int64
+	OpenAssignments int64
+}
+
+type ReviewerPair struct {
+	AuthorID         string
+	AuthorUsername   string
+	ReviewerID       string
+	ReviewerUsername string
+	ReviewsCount     int64
+}
+
+type TeamRollupStats struct {
+	TeamName         string
+	ParentTeamName   string
+	TeamsCount       int64
+	MembersCount     int64
+	AssignmentsCount int64
+	OpenPRs          int64
+	MergedPRs        int64
+}
+
+// DefaultFairnessThresholdPct is used when a fairness report request does not
+// set its own threshold.
+const DefaultFairnessThresholdPct = 50
+
+// TeamFairness describes how evenly assignments are spread among the active
+// reviewers of a team. Overloaded lists members whose assignment count exceeds
+// the team mean by more than the requested threshold.
+type TeamFairness struct {
+	TeamName         string
+	MembersCount     int
+	TotalAssignments int64
+	Min              int64
+	Max              int64
+	Mean             float64
+	StdDev           float64
+	Gini             float64
+	Overloaded       []UserAssignmentStats
+}
+
+type CycleTimeSummary struct {
+	MergedPRs int64
+	Mean      time.Duration
+	Median    time.Duration
+	P90       time.Duration
+	P99       time.Duration
+}
+
+type TeamCycleTime struct {
+	TeamName string
+	CycleTimeSummary
+}
+
+type AuthorCycleTime struct {
+	UserID   string
+	Username string
+	TeamName string
+	CycleTimeSummary
 }
+
+// MaxCycleTimeBuckets limits the number of histogram bounds a client may
+// request.
+const MaxCycleTimeBuckets = 50
+
+// CycleTimeBucket counts merged PRs whose time to merge falls into
+// [From, To). The last bucket of a histogram has no upper bound.
+type CycleTimeBucket struct {
+	From  time.Duration
+	To    *time.Duration
+	Count int64
+}
+
+type CycleTimeStats struct {
+	Teams     []TeamCycleTime
+	Authors   []AuthorCycleTime
+	Histogram []CycleTimeBucket
+}
+
+type StatsInterval string
+
+const (
+	StatsIntervalDay   StatsInterval = "day"
+	StatsIntervalWeek  StatsInterval = "week"
+	StatsIntervalMonth StatsInterval = "month"
+)
+
+func (i StatsInterval) IsValid() bool {
+	switch i {
+	case StatsIntervalDay, StatsIntervalWeek, StatsIntervalMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+// Truncate rounds t down to the start of its interval the same way
+// PostgreSQL date_trunc does; weeks start on Monday.
+func (i StatsInterval) Truncate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	switch i {
+	case StatsIntervalWeek:
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, t.Location())
+	case StatsIntervalMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
+
+func (i StatsInterval) Next(t time.Time) time.Time {
+	switch i {
+	case StatsIntervalWeek:
+		return t.AddDate(0, 0, 7)
+	case StatsIntervalMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// StalePR is an open PR older than its team's stale threshold. FlaggedAt is
+// set once the background job has reported it.
+type StalePR struct {
+	PullRequestID     string
+	PullRequestName   string
+	AuthorID          string
+	TeamName          string
+	AssignedReviewers []string
+	CreatedAt         time.Time
+	Age               time.Duration
+	Threshold         time.Duration
+	FlaggedAt         *time.Time
+}
+
+type TimeSeriesPoint struct {
+	Bucket      time.Time
+	PRsOpened   int64
+	PRsMerged   int64
+	Assignments int64
+}
+
+type Snapshot struct {
+	Teams        []Team
+	Users        []User
+	Memberships  []TeamMembership
+	PullRequests []PullRequest
+}
+
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyRecord is the response stored for an Idempotency-Key.
+// StatusCode is zero while the first request with the key is still running.
+// Headers holds the response headers set by the handler, such as ETag or
+// Location, besides Content-Type.
+type IdempotencyRecord struct {
+	Key         string
+	RequestHash string
+	StatusCode  int
+	ContentType string
+	Headers     map[string][]string
+	Body        []byte
+}
+
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+type EventType string
+
+const (
+	EventPRCreated          EventType = "pr.created"
+	EventPRMerged           EventType = "pr.merged"
+	EventReviewerAssigned   EventType = "reviewer.assigned"
+	EventReviewerReassigned EventType = "reviewer.reassigned"
+	EventUserDeactivated    EventType = "user.deactivated"
+)
+
+// Event is an entry of the event log. UserID is the user the event is
+// about: the author for pr.*, the reviewer for reviewer.assigned, the new
+// reviewer for reviewer.reassigned and the user for user.deactivated.
+// PreviousUserID is the replaced reviewer of reviewer.reassigned.
+type Event struct {
+	ID             int64
+	Type           EventType
+	TeamName       string
+	PullRequestID  string
+	UserID         string
+	PreviousUserID string
+	CreatedAt      time.Time
+}
+
+// EventFilter narrows the event log to a team and to the events in which a
+// user is either UserID or PreviousUserID. Zero values mean no restriction.
+type EventFilter struct {
+	TeamName string
+	UserID   string
+}
+
+func (f EventFilter) Matches(e Event) bool {
+	if f.TeamName != "" && e.TeamName != f.TeamName {
+		return false
+	}
+	if f.UserID != "" && e.UserID != f.UserID && e.PreviousUserID != f.UserID {
+		return false
+	}
+	return true
+}
+
+// MaxEventPage limits the number of events read from the log at once.
+const MaxEventPage = 500
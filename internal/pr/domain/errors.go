@@ -6,11 +6,44 @@ var (
 	ErrTeamAlreadyExists = errors.New("team already exists")
 	ErrTeamNotFound      = errors.New("team not found")
 
-	ErrUserNotFound = errors.New("user not found")
+	ErrParentTeamNotFound = errors.New("parent team not found")
+	ErrTeamHierarchyCycle = errors.New("team hierarchy cycle")
+	ErrNotTeamLead        = errors.New("only team leads can change team settings")
+
+	ErrUserNotFound  = errors.New("user not found")
+	ErrNotTeamMember = errors.New("user is not a member of the team")
 
 	ErrPRAlreadyExists     = errors.New("pull request already exists")
 	ErrPRNotFound          = errors.New("pull request not found")
 	ErrPRMerged            = errors.New("cannot modify merged pull request")
 	ErrReviewerNotAssigned = errors.New("reviewer is not assigned to this PR")
 	ErrNoCandidates        = errors.New("no active replacement candidate in team")
+	ErrPRVersionMismatch   = errors.New("pull request was modified by another request")
+
+	ErrDatabaseNotEmpty = errors.New("database is not empty")
+
+	ErrIdempotencyKeyNotFound   = errors.New("idempotency key not found")
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was used for a different request")
+	ErrIdempotencyKeyInProgress = errors.New("request with this idempotency key is in progress")
 )
+
+// ValidationError reports input that breaks a rule checked by the service
+// layer. Field is the name of the offending request field and Rule a short
+// machine-readable name of the rule, e.g. "required".
+type ValidationError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+func NewValidationError(field, rule, message string) *ValidationError {
+	return &ValidationError{Field: field, Rule: rule, Message: message}
+}
+
+func RequiredError(field string) *ValidationError {
+	return NewValidationError(field, "required", "is required")
+}
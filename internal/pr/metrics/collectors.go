@@ -0,0 +1,173 @@
+package metrics
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository"
+)
+
+const refreshTimeout = 5 * time.Second
+
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns   *prometheus.Desc
+	idleConns       *prometheus.Desc
+	totalConns      *prometheus.Desc
+	maxConns        *prometheus.Desc
+	acquireCount    *prometheus.Desc
+	acquireDuration *prometheus.Desc
+	emptyAcquire    *prometheus.Desc
+	canceledAcquire *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return &poolCollector{
+		pool:            pool,
+		acquiredConns:   desc("acquired_conns", "Connections currently in use."),
+		idleConns:       desc("idle_conns", "Idle connections in the pool."),
+		totalConns:      desc("total_conns", "Total connections in the pool."),
+		maxConns:        desc("max_conns", "Maximum size of the pool."),
+		acquireCount:    desc("acquire_total", "Number of successful connection acquires."),
+		acquireDuration: desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		emptyAcquire:    desc("empty_acquire_total", "Acquires that had to wait for a connection."),
+		canceledAcquire: desc("canceled_acquire_total", "Acquires canceled by their context."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquire
+	ch <- c.canceledAcquire
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquire, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquire, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+}
+
+// businessCollector serves PR totals read from the database by refresh, so
+// scrapes never query the tables themselves. Created and merged counts come
+// from the table rather than from in-process counters, so they survive
+// restarts and are not inflated by repeated idempotent merges.
+type businessCollector struct {
+	statsRepo repository.StatsRepository
+
+	openPRs             *prometheus.Desc
+	createdPRs          *prometheus.Desc
+	mergedPRs           *prometheus.Desc
+	teamOpenPRs         *prometheus.Desc
+	teamOpenAssignments *prometheus.Desc
+
+	mu       sync.Mutex
+	stats    *domain.PRStats
+	workload []domain.TeamOpenWorkload
+}
+
+func newBusinessCollector(statsRepo repository.StatsRepository) *businessCollector {
+	return &businessCollector{
+		statsRepo: statsRepo,
+		openPRs: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "open_pull_requests"),
+			"Number of open pull requests.", nil, nil),
+		createdPRs: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "pull_requests_created_total"),
+			"Number of pull requests created.", nil, nil),
+		mergedPRs: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "pull_requests_merged_total"),
+			"Number of pull requests merged.", nil, nil),
+		teamOpenPRs: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "team", "open_pull_requests"),
+			"Number of open pull requests per team.", []string{"team"}, nil),
+		teamOpenAssignments: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "team", "open_review_assignments"),
+			"Number of reviewer assignments on open pull requests per team.", []string{"team"}, nil),
+	}
+}
+
+// run refreshes once immediately and then every interval until ctx is done.
+func (c *businessCollector) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh reads the totals from the database. On error the previous values
+// are kept, so a slow or failing query never reaches a scrape.
+func (c *businessCollector) refresh(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+
+	stats, err := c.statsRepo.GetPRStats(ctx, domain.StatsFilter{})
+	if err != nil {
+		log.Printf("metrics: %v", err)
+	} else {
+		c.mu.Lock()
+		c.stats = stats
+		c.mu.Unlock()
+	}
+
+	workload, err := c.statsRepo.GetTeamOpenWorkload(ctx)
+	if err != nil {
+		log.Printf("metrics: %v", err)
+		return
+	}
+	c.mu.Lock()
+	c.workload = workload
+	c.mu.Unlock()
+}
+
+func (c *businessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.openPRs
+	ch <- c.createdPRs
+	ch <- c.mergedPRs
+	ch <- c.teamOpenPRs
+	ch <- c.teamOpenAssignments
+}
+
+func (c *businessCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	stats, workload := c.stats, c.workload
+	c.mu.Unlock()
+
+	if stats != nil {
+		ch <- prometheus.MustNewConstMetric(c.openPRs, prometheus.GaugeValue, float64(stats.OpenPRs))
+		ch <- prometheus.MustNewConstMetric(c.createdPRs, prometheus.CounterValue, float64(stats.TotalPRs))
+		ch <- prometheus.MustNewConstMetric(c.mergedPRs, prometheus.CounterValue, float64(stats.MergedPRs))
+	}
+	for _, w := range workload {
+		ch <- prometheus.MustNewConstMetric(c.teamOpenPRs, prometheus.GaugeValue, float64(w.OpenPRs), w.TeamName)
+		ch <- prometheus.MustNewConstMetric(c.teamOpenAssignments, prometheus.GaugeValue, float64(w.OpenAssignments), w.TeamName)
+	}
+}
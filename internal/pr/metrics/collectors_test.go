@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/mocks"
+)
+
+func TestBusinessCollector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	collector := newBusinessCollector(mockStatsRepo)
+	ctx := context.Background()
+
+	t.Run("success - scrapes do not query before the first refresh", func(t *testing.T) {
+		require.Equal(t, 0, testutil.CollectAndCount(collector))
+	})
+
+	t.Run("success - scrapes serve the last refresh", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetPRStats(gomock.Any(), domain.StatsFilter{}).
+			Return(&domain.PRStats{TotalPRs: 5, OpenPRs: 2, MergedPRs: 3}, nil).
+			Times(1)
+		mockStatsRepo.EXPECT().
+			GetTeamOpenWorkload(gomock.Any()).
+			Return([]domain.TeamOpenWorkload{{TeamName: "backend", OpenPRs: 2, OpenAssignments: 3}}, nil).
+			Times(1)
+
+		collector.refresh(ctx)
+
+		expected := `
+# HELP pr_open_pull_requests Number of open pull requests.
+# TYPE pr_open_pull_requests gauge
+pr_open_pull_requests 2
+# HELP pr_team_open_review_assignments Number of reviewer assignments on open pull requests per team.
+# TYPE pr_team_open_review_assignments gauge
+pr_team_open_review_assignments{team="backend"} 3
+`
+		for range 2 {
+			require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+				"pr_open_pull_requests", "pr_team_open_review_assignments"))
+		}
+	})
+
+	t.Run("error - failed refresh keeps the previous values", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetPRStats(gomock.Any(), domain.StatsFilter{}).
+			Return(nil, errors.New("database error")).
+			Times(1)
+		mockStatsRepo.EXPECT().
+			GetTeamOpenWorkload(gomock.Any()).
+			Return(nil, errors.New("database error")).
+			Times(1)
+
+		collector.refresh(ctx)
+
+		require.Equal(t, 5, testutil.CollectAndCount(collector))
+	})
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres/sqlc"
+)
+
+type EventRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewEventRepository(queries *sqlc.Queries) *EventRepository {
+	return &EventRepository{queries: queries}
+}
+
+// RecordEvents serializes writers on an advisory lock taken right before the
+// insert. The lock is released on commit, so the next writer gets larger ids
+// only after the previous ones are visible.
+func (r *EventRepository) RecordEvents(ctx context.Context, events []domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err := r.queries.LockEventLog(ctx); err != nil {
+		return fmt.Errorf("lock event log: %w", err)
+	}
+
+	now := time.Now().UTC()
+	rows := make([]sqlc.CopyEventsParams, len(events))
+	for i, event := range events {
+		createdAt := event.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = now
+		}
+		rows[i] = sqlc.CopyEventsParams{
+			Type:           string(event.Type),
+			TeamName:       nullableString(event.TeamName),
+			PullRequestID:  nullableString(event.PullRequestID),
+			UserID:         nullableString(event.UserID),
+			PreviousUserID: nullableString(event.PreviousUserID),
+			CreatedAt:      createdAt,
+		}
+	}
+
+	if _, err := r.queries.CopyEvents(ctx, rows); err != nil {
+		return fmt.Errorf("insert events: %w", err)
+	}
+	return nil
+}
+
+func (r *EventRepository) ListEvents(ctx context.Context, filter domain.EventFilter, afterID int64, limit int) ([]domain.Event, error) {
+	rows, err := r.queries.ListEvents(ctx, sqlc.ListEventsParams{
+		AfterID:   afterID,
+		TeamName:  nullableString(filter.TeamName),
+		UserID:    nullableString(filter.UserID),
+		MaxEvents: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list events: %w", err)
+	}
+
+	events := make([]domain.Event, len(rows))
+	for i, row := range rows {
+		events[i] = domain.Event{
+			ID:             row.ID,
+			Type:           domain.EventType(row.Type),
+			TeamName:       derefString(row.TeamName),
+			PullRequestID:  derefString(row.PullRequestID),
+			UserID:         derefString(row.UserID),
+			PreviousUserID: derefString(row.PreviousUserID),
+			CreatedAt:      row.CreatedAt,
+		}
+	}
+	return events, nil
+}
+
+func (r *EventRepository) LastEventID(ctx context.Context) (int64, error) {
+	id, err := r.queries.GetLastEventID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get last event id: %w", err)
+	}
+	return id, nil
+}
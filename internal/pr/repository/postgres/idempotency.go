@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres/sqlc"
+	"github.com/jackc/pgx/v5"
+)
+
+type IdempotencyRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewIdempotencyRepository(queries *sqlc.Queries) *IdempotencyRepository {
+	return &IdempotencyRepository{queries: queries}
+}
+
+func (r *IdempotencyRepository) Reserve(ctx context.Context, key, requestHash string, lease time.Duration) (bool, error) {
+	rows, err := r.queries.ReserveIdempotencyKey(ctx, sqlc.ReserveIdempotencyKeyParams{
+		Key:         key,
+		RequestHash: requestHash,
+		TtlSeconds:  int64(lease.Seconds()),
+	})
+	if err != nil {
+		return false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, key string) (*domain.IdempotencyRecord, error) {
+	row, err := r.queries.GetIdempotencyKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrIdempotencyKeyNotFound
+		}
+		return nil, fmt.Errorf("get idempotency key: %w", err)
+	}
+
+	record := &domain.IdempotencyRecord{
+		Key:         row.Key,
+		RequestHash: row.RequestHash,
+		ContentType: derefString(row.ContentType),
+		Body:        row.ResponseBody,
+	}
+	if row.StatusCode != nil {
+		record.StatusCode = int(*row.StatusCode)
+	}
+	if row.ResponseHeaders != nil {
+		if err := json.Unmarshal(row.ResponseHeaders, &record.Headers); err != nil {
+			return nil, fmt.Errorf("decode idempotency response headers: %w", err)
+		}
+	}
+	return record, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, record *domain.IdempotencyRecord, ttl time.Duration) error {
+	var headers []byte
+	if len(record.Headers) > 0 {
+		var err error
+		if headers, err = json.Marshal(record.Headers); err != nil {
+			return fmt.Errorf("encode idempotency response headers: %w", err)
+		}
+	}
+
+	statusCode := int32(record.StatusCode)
+	err := r.queries.CompleteIdempotencyKey(ctx, sqlc.CompleteIdempotencyKeyParams{
+		StatusCode:      &statusCode,
+		ContentType:     nullableString(record.ContentType),
+		ResponseBody:    record.Body,
+		ResponseHeaders: headers,
+		TtlSeconds:      int64(ttl.Seconds()),
+		Key:             record.Key,
+	})
+	if err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) Release(ctx context.Context, key string) error {
+	if err := r.queries.ReleaseIdempotencyKey(ctx, key); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	rows, err := r.queries.DeleteExpiredIdempotencyKeys(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	return rows, nil
+}
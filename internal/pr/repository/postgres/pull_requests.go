@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres/sqlc"
@@ -23,6 +24,7 @@ func (r *PRRepository) CreatePR(ctx context.Context, pr *domain.PullRequest) err
 		PullRequestID:   pr.PullRequestID,
 		PullRequestName: pr.PullRequestName,
 		AuthorID:        pr.AuthorID,
+		TeamName:        pr.TeamName,
 	})
 	if err != nil {
 		if isPgUniqueViolation(err) {
@@ -33,6 +35,42 @@ func (r *PRRepository) CreatePR(ctx context.Context, pr *domain.PullRequest) err
 	return nil
 }
 
+// CreatePRs bulk-inserts PRs together with their assigned reviewers using
+// COPY. Every PR must have CreatedAt set; it is also used as the assignment
+// time of its reviewers.
+func (r *PRRepository) CreatePRs(ctx context.Context, prs []*domain.PullRequest) error {
+	prRows := make([]sqlc.CopyPullRequestsParams, len(prs))
+	var reviewerRows []sqlc.CopyReviewersParams
+	for i, pr := range prs {
+		prRows[i] = sqlc.CopyPullRequestsParams{
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			TeamName:        pr.TeamName,
+			Status:          string(pr.Status),
+			CreatedAt:       *pr.CreatedAt,
+		}
+		for _, reviewerID := range pr.AssignedReviewers {
+			reviewerRows = append(reviewerRows, sqlc.CopyReviewersParams{
+				PrID:       pr.PullRequestID,
+				ReviewerID: reviewerID,
+				AssignedAt: *pr.CreatedAt,
+			})
+		}
+	}
+
+	if _, err := r.queries.CopyPullRequests(ctx, prRows); err != nil {
+		if isPgUniqueViolation(err) {
+			return domain.ErrPRAlreadyExists
+		}
+		return fmt.Errorf("copy PRs: %w", err)
+	}
+	if _, err := r.queries.CopyReviewers(ctx, reviewerRows); err != nil {
+		return fmt.Errorf("copy reviewers: %w", err)
+	}
+	return nil
+}
+
 func (r *PRRepository) GetPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
 	pr, err := r.queries.GetPullRequest(ctx, prID)
 	if err != nil {
@@ -41,15 +79,44 @@ func (r *PRRepository) GetPR(ctx context.Context, prID string) (*domain.PullRequ
 		}
 		return nil, fmt.Errorf("get PR: %w", err)
 	}
+	return toDomainPR(pr), nil
+}
+
+// GetPRForUpdate locks the PR row until the surrounding transaction ends, so
+// concurrent changes of the same PR run one after another.
+func (r *PRRepository) GetPRForUpdate(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	pr, err := r.queries.GetPullRequestForUpdate(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrPRNotFound
+		}
+		return nil, fmt.Errorf("get PR for update: %w", err)
+	}
+	return toDomainPR(pr), nil
+}
+
+func (r *PRRepository) IncrementVersion(ctx context.Context, prID string) (int64, error) {
+	version, err := r.queries.IncrementPullRequestVersion(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrPRNotFound
+		}
+		return 0, fmt.Errorf("increment PR version: %w", err)
+	}
+	return version, nil
+}
 
+func toDomainPR(pr sqlc.PullRequest) *domain.PullRequest {
 	return &domain.PullRequest{
 		PullRequestID:   pr.PullRequestID,
 		PullRequestName: pr.PullRequestName,
 		AuthorID:        pr.AuthorID,
+		TeamName:        pr.TeamName,
 		Status:          domain.PRStatus(pr.Status),
 		CreatedAt:       &pr.CreatedAt,
 		MergedAt:        pr.MergedAt,
-	}, nil
+		Version:         pr.Version,
+	}
 }
 
 func (r *PRRepository) GetPRWithReviewers(ctx context.Context, prID string) (*domain.PullRequest, error) {
@@ -75,6 +142,14 @@ func (r *PRRepository) PRExists(ctx context.Context, prID string) (bool, error)
 	return exists, nil
 }
 
+func (r *PRRepository) ListExistingPRIDs(ctx context.Context, prIDs []string) ([]string, error) {
+	existing, err := r.queries.ListExistingPullRequestIDs(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list existing PR ids: %w", err)
+	}
+	return existing, nil
+}
+
 func (r *PRRepository) MergePR(ctx context.Context, prID string) (*domain.PullRequest, error) {
 	pr, err := r.queries.MergePullRequest(ctx, prID)
 	if err != nil {
@@ -89,15 +164,9 @@ func (r *PRRepository) MergePR(ctx context.Context, prID string) (*domain.PullRe
 		return nil, err
 	}
 
-	return &domain.PullRequest{
-		PullRequestID:     pr.PullRequestID,
-		PullRequestName:   pr.PullRequestName,
-		AuthorID:          pr.AuthorID,
-		Status:            domain.PRStatus(pr.Status),
-		AssignedReviewers: reviewers,
-		CreatedAt:         &pr.CreatedAt,
-		MergedAt:          pr.MergedAt,
-	}, nil
+	merged := toDomainPR(pr)
+	merged.AssignedReviewers = reviewers
+	return merged, nil
 }
 
 func (r *PRRepository) GetPRAuthorID(ctx context.Context, prID string) (string, error) {
@@ -108,3 +177,49 @@ func (r *PRRepository) GetPRAuthorID(ctx context.Context, prID string) (string,
 
 	return authorID, nil
 }
+
+func (r *PRRepository) ListOpenPRsByAuthor(ctx context.Context, authorID string) ([]domain.PullRequestShort, error) {
+	prs, err := r.queries.ListOpenPullRequestsByAuthor(ctx, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("list open PRs by author: %w", err)
+	}
+
+	result := make([]domain.PullRequestShort, len(prs))
+	for i, pr := range prs {
+		result[i] = domain.PullRequestShort{
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			Status:          domain.PRStatus(pr.Status),
+		}
+	}
+	return result, nil
+}
+
+// FlagStalePRs marks open PRs that have outgrown their team's threshold, or
+// defaultAge for teams without one, and returns the newly flagged ones.
+func (r *PRRepository) FlagStalePRs(ctx context.Context, defaultAge time.Duration) ([]domain.PullRequestShort, error) {
+	prs, err := r.queries.FlagStalePRs(ctx, int64(defaultAge/time.Second))
+	if err != nil {
+		return nil, fmt.Errorf("flag stale PRs: %w", err)
+	}
+
+	result := make([]domain.PullRequestShort, len(prs))
+	for i, pr := range prs {
+		result[i] = domain.PullRequestShort{
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			Status:          domain.PRStatus(pr.Status),
+		}
+	}
+	return result, nil
+}
+
+func (r *PRRepository) TransactionTime(ctx context.Context) (time.Time, error) {
+	now, err := r.queries.GetTransactionTime(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get transaction time: %w", err)
+	}
+	return now, nil
+}
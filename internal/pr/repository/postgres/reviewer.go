@@ -58,6 +58,21 @@ func (r *ReviewerRepository) GetAssignedReviewers(ctx context.Context, prID stri
 	return reviewers, nil
 }
 
+// GetAssignedReviewersByPRs returns the reviewers of several PRs at once,
+// keyed by PR id. PRs without reviewers are absent from the map.
+func (r *ReviewerRepository) GetAssignedReviewersByPRs(ctx context.Context, prIDs []string) (map[string][]string, error) {
+	rows, err := r.queries.ListAssignedReviewersByPRs(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get assigned reviewers by PRs: %w", err)
+	}
+
+	result := make(map[string][]string)
+	for _, row := range rows {
+		result[row.PrID] = append(result[row.PrID], row.ReviewerID)
+	}
+	return result, nil
+}
+
 func (r *ReviewerRepository) FindCandidatesForNewPR(ctx context.Context, teamName, authorID string) ([]string, error) {
 	users, err := r.queries.GetActiveCandidatesForPR(ctx, sqlc.GetActiveCandidatesForPRParams{
 		TeamName: teamName,
@@ -74,6 +89,34 @@ func (r *ReviewerRepository) FindCandidatesForNewPR(ctx context.Context, teamNam
 	return result, nil
 }
 
+func (r *ReviewerRepository) FindLeadCandidatesForNewPR(ctx context.Context, teamName, authorID string) ([]string, error) {
+	leads, err := r.queries.GetActiveLeadCandidatesForPR(ctx, sqlc.GetActiveLeadCandidatesForPRParams{
+		TeamName: teamName,
+		UserID:   authorID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find lead candidates for new PR: %w", err)
+	}
+	return leads, nil
+}
+
+func (r *ReviewerRepository) ListReviewerCandidates(ctx context.Context, teamName string) ([]domain.ReviewerCandidate, error) {
+	rows, err := r.queries.ListReviewerCandidates(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("list reviewer candidates: %w", err)
+	}
+
+	result := make([]domain.ReviewerCandidate, len(rows))
+	for i, row := range rows {
+		result[i] = domain.ReviewerCandidate{
+			UserID:      row.UserID,
+			Role:        domain.TeamRole(row.Role),
+			OpenReviews: row.OpenReviews,
+		}
+	}
+	return result, nil
+}
+
 func (r *ReviewerRepository) FindCandidatesForReassignment(ctx context.Context, teamName, authorID, prID string) ([]string, error) {
 	candidates, err := r.queries.GetActiveCandidatesForReassignment(ctx, sqlc.GetActiveCandidatesForReassignmentParams{
 		TeamName: teamName,
@@ -103,3 +146,35 @@ func (r *ReviewerRepository) ListPRsByReviewer(ctx context.Context, reviewerID s
 	}
 	return result, nil
 }
+
+// ListPRsByReviewers returns the PRs assigned to several reviewers at once,
+// newest first and keyed by reviewer id.
+func (r *ReviewerRepository) ListPRsByReviewers(ctx context.Context, reviewerIDs []string) (map[string][]domain.PullRequest, error) {
+	rows, err := r.queries.ListPullRequestsByReviewers(ctx, reviewerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list PRs by reviewers: %w", err)
+	}
+
+	result := make(map[string][]domain.PullRequest)
+	for _, row := range rows {
+		result[row.ReviewerID] = append(result[row.ReviewerID], domain.PullRequest{
+			PullRequestID:   row.PullRequestID,
+			PullRequestName: row.PullRequestName,
+			AuthorID:        row.AuthorID,
+			TeamName:        row.TeamName,
+			Status:          domain.PRStatus(row.Status),
+			CreatedAt:       &row.CreatedAt,
+			MergedAt:        row.MergedAt,
+			Version:         row.Version,
+		})
+	}
+	return result, nil
+}
+
+func (r *ReviewerRepository) CountOpenReviews(ctx context.Context, reviewerID string) (int64, error) {
+	count, err := r.queries.CountOpenReviews(ctx, reviewerID)
+	if err != nil {
+		return 0, fmt.Errorf("count open reviews: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres/sqlc"
+)
+
+type SnapshotRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewSnapshotRepository(queries *sqlc.Queries) *SnapshotRepository {
+	return &SnapshotRepository{queries: queries}
+}
+
+func (r *SnapshotRepository) HasData(ctx context.Context) (bool, error) {
+	hasData, err := r.queries.HasData(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check database has data: %w", err)
+	}
+	return hasData, nil
+}
+
+func (r *SnapshotRepository) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	teams, err := r.queries.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list teams: %w", err)
+	}
+
+	result := make([]domain.Team, len(teams))
+	for i, t := range teams {
+		result[i] = domain.Team{
+			TeamName:       t.TeamName,
+			ParentTeamName: derefString(t.ParentTeamName),
+			Settings:       teamSettings(t),
+		}
+	}
+	return result, nil
+}
+
+func (r *SnapshotRepository) ListUsers(ctx context.Context) ([]domain.User, error) {
+	users, err := r.queries.ListUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+
+	result := make([]domain.User, len(users))
+	for i, u := range users {
+		result[i] = domain.User{
+			UserID:   u.UserID,
+			Username: u.Username,
+			TeamName: u.TeamName,
+			IsActive: u.IsActive,
+		}
+	}
+	return result, nil
+}
+
+func (r *SnapshotRepository) ListMemberships(ctx context.Context) ([]domain.TeamMembership, error) {
+	memberships, err := r.queries.ListTeamMemberships(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list team memberships: %w", err)
+	}
+
+	result := make([]domain.TeamMembership, len(memberships))
+	for i, m := range memberships {
+		result[i] = domain.TeamMembership{
+			TeamName: m.TeamName,
+			UserID:   m.UserID,
+			Role:     domain.TeamRole(m.Role),
+		}
+	}
+	return result, nil
+}
+
+func (r *SnapshotRepository) ListPullRequests(ctx context.Context) ([]domain.PullRequest, error) {
+	prs, err := r.queries.ListPullRequests(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list pull requests: %w", err)
+	}
+
+	assignments, err := r.queries.ListAssignedReviewers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list assigned reviewers: %w", err)
+	}
+
+	reviewersByPR := make(map[string][]string)
+	assignedAtByPR := make(map[string]map[string]time.Time)
+	for _, a := range assignments {
+		reviewersByPR[a.PrID] = append(reviewersByPR[a.PrID], a.ReviewerID)
+		if assignedAtByPR[a.PrID] == nil {
+			assignedAtByPR[a.PrID] = make(map[string]time.Time)
+		}
+		assignedAtByPR[a.PrID][a.ReviewerID] = a.AssignedAt
+	}
+
+	result := make([]domain.PullRequest, len(prs))
+	for i, pr := range prs {
+		reviewers := reviewersByPR[pr.PullRequestID]
+		if reviewers == nil {
+			reviewers = []string{}
+		}
+
+		result[i] = domain.PullRequest{
+			PullRequestID:       pr.PullRequestID,
+			PullRequestName:     pr.PullRequestName,
+			AuthorID:            pr.AuthorID,
+			TeamName:            pr.TeamName,
+			Status:              domain.PRStatus(pr.Status),
+			AssignedReviewers:   reviewers,
+			CreatedAt:           &pr.CreatedAt,
+			MergedAt:            pr.MergedAt,
+			Version:             pr.Version,
+			StaleFlaggedAt:      pr.StaleFlaggedAt,
+			ReviewersAssignedAt: assignedAtByPR[pr.PullRequestID],
+		}
+	}
+	return result, nil
+}
+
+func (r *SnapshotRepository) RestorePullRequest(ctx context.Context, pr *domain.PullRequest) error {
+	params := sqlc.RestorePullRequestParams{
+		PullRequestID:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorID:        pr.AuthorID,
+		TeamName:        pr.TeamName,
+		Status:          string(pr.Status),
+		MergedAt:        pr.MergedAt,
+		StaleFlaggedAt:  pr.StaleFlaggedAt,
+		Version:         max(pr.Version, 1),
+	}
+	if pr.CreatedAt != nil {
+		params.CreatedAt = *pr.CreatedAt
+	}
+
+	if err := r.queries.RestorePullRequest(ctx, params); err != nil {
+		if isPgUniqueViolation(err) {
+			return domain.ErrPRAlreadyExists
+		}
+		return fmt.Errorf("restore PR: %w", err)
+	}
+
+	for _, reviewerID := range pr.AssignedReviewers {
+		// Archives that predate assigned_at count reviewers as assigned
+		// when the PR was created.
+		assignedAt, ok := pr.ReviewersAssignedAt[reviewerID]
+		if !ok {
+			assignedAt = params.CreatedAt
+		}
+		err := r.queries.RestoreReviewer(ctx, sqlc.RestoreReviewerParams{
+			PrID:       pr.PullRequestID,
+			ReviewerID: reviewerID,
+			AssignedAt: assignedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("restore reviewer %s: %w", reviewerID, err)
+		}
+	}
+	return nil
+}
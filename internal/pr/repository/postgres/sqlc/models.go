@@ -9,8 +9,30 @@ import (
 )
 
 type AssignedReviewer struct {
-	PrID       string `json:"pr_id"`
-	ReviewerID string `json:"reviewer_id"`
+	PrID       string    `json:"pr_id"`
+	ReviewerID string    `json:"reviewer_id"`
+	AssignedAt time.Time `json:"assigned_at"`
+}
+
+type Event struct {
+	ID             int64     `json:"id"`
+	Type           string    `json:"type"`
+	TeamName       *string   `json:"team_name"`
+	PullRequestID  *string   `json:"pull_request_id"`
+	UserID         *string   `json:"user_id"`
+	PreviousUserID *string   `json:"previous_user_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	Key             string    `json:"key"`
+	RequestHash     string    `json:"request_hash"`
+	StatusCode      *int32    `json:"status_code"`
+	ContentType     *string   `json:"content_type"`
+	ResponseBody    []byte    `json:"response_body"`
+	CreatedAt       time.Time `json:"created_at"`
+	ExpiresAt       time.Time `json:"expires_at"`
+	ResponseHeaders []byte    `json:"response_headers"`
 }
 
 type PullRequest struct {
@@ -20,10 +42,22 @@ type PullRequest struct {
 	Status          string     `json:"status"`
 	CreatedAt       time.Time  `json:"created_at"`
 	MergedAt        *time.Time `json:"merged_at"`
+	TeamName        string     `json:"team_name"`
+	StaleFlaggedAt  *time.Time `json:"stale_flagged_at"`
+	Version         int64      `json:"version"`
 }
 
 type Team struct {
+	TeamName          string  `json:"team_name"`
+	ParentTeamName    *string `json:"parent_team_name"`
+	RequireLeadReview bool    `json:"require_lead_review"`
+	StaleAfterSeconds int64   `json:"stale_after_seconds"`
+}
+
+type TeamMembership struct {
 	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
 }
 
 type User struct {
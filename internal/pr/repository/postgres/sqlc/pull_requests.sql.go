@@ -7,22 +7,38 @@ package sqlc
 
 import (
 	"context"
+	"time"
 )
 
+type CopyPullRequestsParams struct {
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	TeamName        string    `json:"team_name"`
+	Status          string    `json:"status"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
 const createPullRequest = `-- name: CreatePullRequest :one
-INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status)
-VALUES ($1, $2, $3, 'OPEN')
-RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, team_name, status)
+VALUES ($1, $2, $3, $4, 'OPEN')
+RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at, team_name, stale_flagged_at, version
 `
 
 type CreatePullRequestParams struct {
 	PullRequestID   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
 	AuthorID        string `json:"author_id"`
+	TeamName        string `json:"team_name"`
 }
 
 func (q *Queries) CreatePullRequest(ctx context.Context, arg CreatePullRequestParams) (PullRequest, error) {
-	row := q.db.QueryRow(ctx, createPullRequest, arg.PullRequestID, arg.PullRequestName, arg.AuthorID)
+	row := q.db.QueryRow(ctx, createPullRequest,
+		arg.PullRequestID,
+		arg.PullRequestName,
+		arg.AuthorID,
+		arg.TeamName,
+	)
 	var i PullRequest
 	err := row.Scan(
 		&i.PullRequestID,
@@ -31,10 +47,56 @@ func (q *Queries) CreatePullRequest(ctx context.Context, arg CreatePullRequestPa
 		&i.Status,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.TeamName,
+		&i.StaleFlaggedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
+const flagStalePRs = `-- name: FlagStalePRs :many
+UPDATE pull_requests pr
+SET stale_flagged_at = NOW()
+FROM teams t
+WHERE t.team_name = pr.team_name
+  AND pr.status = 'OPEN'
+  AND pr.stale_flagged_at IS NULL
+  AND pr.created_at <= NOW()::timestamp - make_interval(secs => COALESCE(NULLIF(t.stale_after_seconds, 0), $1::bigint))
+RETURNING pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
+`
+
+type FlagStalePRsRow struct {
+	PullRequestID   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+	AuthorID        string `json:"author_id"`
+	Status          string `json:"status"`
+}
+
+func (q *Queries) FlagStalePRs(ctx context.Context, defaultSeconds int64) ([]FlagStalePRsRow, error) {
+	rows, err := q.db.Query(ctx, flagStalePRs, defaultSeconds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FlagStalePRsRow{}
+	for rows.Next() {
+		var i FlagStalePRsRow
+		if err := rows.Scan(
+			&i.PullRequestID,
+			&i.PullRequestName,
+			&i.AuthorID,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPRAuthorId = `-- name: GetPRAuthorId :one
 SELECT author_id
 FROM pull_requests
@@ -49,7 +111,7 @@ func (q *Queries) GetPRAuthorId(ctx context.Context, pullRequestID string) (stri
 }
 
 const getPullRequest = `-- name: GetPullRequest :one
-SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, team_name, stale_flagged_at, version
 FROM pull_requests
 WHERE pull_request_id = $1
 `
@@ -64,10 +126,127 @@ func (q *Queries) GetPullRequest(ctx context.Context, pullRequestID string) (Pul
 		&i.Status,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.TeamName,
+		&i.StaleFlaggedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
+const getPullRequestForUpdate = `-- name: GetPullRequestForUpdate :one
+SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at, team_name, stale_flagged_at, version
+FROM pull_requests
+WHERE pull_request_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetPullRequestForUpdate(ctx context.Context, pullRequestID string) (PullRequest, error) {
+	row := q.db.QueryRow(ctx, getPullRequestForUpdate, pullRequestID)
+	var i PullRequest
+	err := row.Scan(
+		&i.PullRequestID,
+		&i.PullRequestName,
+		&i.AuthorID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MergedAt,
+		&i.TeamName,
+		&i.StaleFlaggedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getTransactionTime = `-- name: GetTransactionTime :one
+SELECT NOW()::timestamp AS now
+`
+
+func (q *Queries) GetTransactionTime(ctx context.Context) (time.Time, error) {
+	row := q.db.QueryRow(ctx, getTransactionTime)
+	var now time.Time
+	err := row.Scan(&now)
+	return now, err
+}
+
+const incrementPullRequestVersion = `-- name: IncrementPullRequestVersion :one
+UPDATE pull_requests
+SET version = version + 1
+WHERE pull_request_id = $1
+RETURNING version
+`
+
+func (q *Queries) IncrementPullRequestVersion(ctx context.Context, pullRequestID string) (int64, error) {
+	row := q.db.QueryRow(ctx, incrementPullRequestVersion, pullRequestID)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
+
+const listExistingPullRequestIDs = `-- name: ListExistingPullRequestIDs :many
+SELECT pull_request_id
+FROM pull_requests
+WHERE pull_request_id = ANY($1::text[])
+`
+
+func (q *Queries) ListExistingPullRequestIDs(ctx context.Context, ids []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listExistingPullRequestIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var pull_request_id string
+		if err := rows.Scan(&pull_request_id); err != nil {
+			return nil, err
+		}
+		items = append(items, pull_request_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenPullRequestsByAuthor = `-- name: ListOpenPullRequestsByAuthor :many
+SELECT pull_request_id, pull_request_name, author_id, status
+FROM pull_requests
+WHERE author_id = $1 AND status = 'OPEN'
+ORDER BY created_at DESC
+`
+
+type ListOpenPullRequestsByAuthorRow struct {
+	PullRequestID   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+	AuthorID        string `json:"author_id"`
+	Status          string `json:"status"`
+}
+
+func (q *Queries) ListOpenPullRequestsByAuthor(ctx context.Context, authorID string) ([]ListOpenPullRequestsByAuthorRow, error) {
+	rows, err := q.db.Query(ctx, listOpenPullRequestsByAuthor, authorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOpenPullRequestsByAuthorRow{}
+	for rows.Next() {
+		var i ListOpenPullRequestsByAuthorRow
+		if err := rows.Scan(
+			&i.PullRequestID,
+			&i.PullRequestName,
+			&i.AuthorID,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPullRequestsByReviewer = `-- name: ListPullRequestsByReviewer :many
 SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
 FROM pull_requests pr
@@ -108,12 +287,63 @@ func (q *Queries) ListPullRequestsByReviewer(ctx context.Context, reviewerID str
 	return items, nil
 }
 
+const listPullRequestsByReviewers = `-- name: ListPullRequestsByReviewers :many
+SELECT ar.reviewer_id, pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.team_name, pr.version
+FROM assigned_reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+WHERE ar.reviewer_id = ANY($1::text[])
+ORDER BY ar.reviewer_id, pr.created_at DESC
+`
+
+type ListPullRequestsByReviewersRow struct {
+	ReviewerID      string     `json:"reviewer_id"`
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	Status          string     `json:"status"`
+	CreatedAt       time.Time  `json:"created_at"`
+	MergedAt        *time.Time `json:"merged_at"`
+	TeamName        string     `json:"team_name"`
+	Version         int64      `json:"version"`
+}
+
+func (q *Queries) ListPullRequestsByReviewers(ctx context.Context, reviewerIds []string) ([]ListPullRequestsByReviewersRow, error) {
+	rows, err := q.db.Query(ctx, listPullRequestsByReviewers, reviewerIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPullRequestsByReviewersRow{}
+	for rows.Next() {
+		var i ListPullRequestsByReviewersRow
+		if err := rows.Scan(
+			&i.ReviewerID,
+			&i.PullRequestID,
+			&i.PullRequestName,
+			&i.AuthorID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.MergedAt,
+			&i.TeamName,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const mergePullRequest = `-- name: MergePullRequest :one
 UPDATE pull_requests
 SET status = 'MERGED', 
-    merged_at = COALESCE(merged_at, NOW())
+    merged_at = COALESCE(merged_at, NOW()),
+    version = CASE WHEN status = 'MERGED' THEN version ELSE version + 1 END
 WHERE pull_request_id = $1
-RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at, team_name, stale_flagged_at, version
 `
 
 func (q *Queries) MergePullRequest(ctx context.Context, pullRequestID string) (PullRequest, error) {
@@ -126,6 +356,9 @@ func (q *Queries) MergePullRequest(ctx context.Context, pullRequestID string) (P
 		&i.Status,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.TeamName,
+		&i.StaleFlaggedAt,
+		&i.Version,
 	)
 	return i, err
 }
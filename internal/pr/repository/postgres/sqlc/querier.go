@@ -6,33 +6,101 @@ package sqlc
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
 	AddReviewer(ctx context.Context, arg AddReviewerParams) error
+	AddTeamMember(ctx context.Context, arg AddTeamMemberParams) error
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error
+	CopyEvents(ctx context.Context, arg []CopyEventsParams) (int64, error)
+	CopyPullRequests(ctx context.Context, arg []CopyPullRequestsParams) (int64, error)
+	CopyReviewers(ctx context.Context, arg []CopyReviewersParams) (int64, error)
+	CountOpenReviews(ctx context.Context, reviewerID string) (int64, error)
+	CountTeamLeads(ctx context.Context, teamName string) (int64, error)
 	CreatePullRequest(ctx context.Context, arg CreatePullRequestParams) (PullRequest, error)
 	CreateTeam(ctx context.Context, teamName string) (string, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	DeleteTeamDailyStats(ctx context.Context) error
+	DeleteUserDailyStats(ctx context.Context) error
+	FlagStalePRs(ctx context.Context, defaultSeconds int64) ([]FlagStalePRsRow, error)
 	GetActiveCandidatesForPR(ctx context.Context, arg GetActiveCandidatesForPRParams) ([]GetActiveCandidatesForPRRow, error)
 	GetActiveCandidatesForReassignment(ctx context.Context, arg GetActiveCandidatesForReassignmentParams) ([]string, error)
+	GetActiveLeadCandidatesForPR(ctx context.Context, arg GetActiveLeadCandidatesForPRParams) ([]string, error)
+	GetAggregatedPRStats(ctx context.Context, arg GetAggregatedPRStatsParams) (GetAggregatedPRStatsRow, error)
+	GetAggregatedTeamMemberAssignmentCounts(ctx context.Context, arg GetAggregatedTeamMemberAssignmentCountsParams) ([]GetAggregatedTeamMemberAssignmentCountsRow, error)
+	GetAggregatedTimeSeries(ctx context.Context, arg GetAggregatedTimeSeriesParams) ([]GetAggregatedTimeSeriesRow, error)
+	GetAggregatedUserAssignmentStats(ctx context.Context, arg GetAggregatedUserAssignmentStatsParams) ([]GetAggregatedUserAssignmentStatsRow, error)
+	GetAssignedAt(ctx context.Context, arg GetAssignedAtParams) (time.Time, error)
 	GetAssignedReviewers(ctx context.Context, prID string) ([]string, error)
+	GetAuthorCycleTimeStats(ctx context.Context, arg GetAuthorCycleTimeStatsParams) ([]GetAuthorCycleTimeStatsRow, error)
+	GetCycleTimeHistogram(ctx context.Context, arg GetCycleTimeHistogramParams) ([]GetCycleTimeHistogramRow, error)
+	GetIdempotencyKey(ctx context.Context, key string) (IdempotencyKey, error)
+	GetLastEventID(ctx context.Context) (int64, error)
 	GetPRAuthorId(ctx context.Context, pullRequestID string) (string, error)
-	GetPRStats(ctx context.Context) (GetPRStatsRow, error)
+	GetPRStats(ctx context.Context, arg GetPRStatsParams) (GetPRStatsRow, error)
 	GetPullRequest(ctx context.Context, pullRequestID string) (PullRequest, error)
-	GetReviewerWorkload(ctx context.Context) ([]GetReviewerWorkloadRow, error)
-	GetTeam(ctx context.Context, teamName string) (string, error)
+	GetPullRequestForUpdate(ctx context.Context, pullRequestID string) (PullRequest, error)
+	GetReviewerPairs(ctx context.Context, arg GetReviewerPairsParams) ([]GetReviewerPairsRow, error)
+	GetReviewerWorkload(ctx context.Context, arg GetReviewerWorkloadParams) ([]GetReviewerWorkloadRow, error)
+	GetStalePRs(ctx context.Context, arg GetStalePRsParams) ([]GetStalePRsRow, error)
+	GetStatsFreshness(ctx context.Context) (GetStatsFreshnessRow, error)
+	GetStatsTimeSeries(ctx context.Context, arg GetStatsTimeSeriesParams) ([]GetStatsTimeSeriesRow, error)
+	GetTeam(ctx context.Context, teamName string) (Team, error)
+	GetTeamAncestors(ctx context.Context, teamName string) ([]string, error)
+	GetTeamCycleTimeStats(ctx context.Context, arg GetTeamCycleTimeStatsParams) ([]GetTeamCycleTimeStatsRow, error)
+	GetTeamMemberAssignmentCounts(ctx context.Context, arg GetTeamMemberAssignmentCountsParams) ([]GetTeamMemberAssignmentCountsRow, error)
+	GetTeamMemberRole(ctx context.Context, arg GetTeamMemberRoleParams) (string, error)
+	GetTeamMembers(ctx context.Context, teamName string) ([]GetTeamMembersRow, error)
+	GetTeamOpenWorkload(ctx context.Context) ([]GetTeamOpenWorkloadRow, error)
+	GetTeamRollupStats(ctx context.Context, arg GetTeamRollupStatsParams) ([]GetTeamRollupStatsRow, error)
+	GetTransactionTime(ctx context.Context) (time.Time, error)
 	GetUser(ctx context.Context, userID string) (User, error)
-	GetUserAssignmentStats(ctx context.Context) ([]GetUserAssignmentStatsRow, error)
+	GetUserAssignmentStats(ctx context.Context, arg GetUserAssignmentStatsParams) ([]GetUserAssignmentStatsRow, error)
+	GetUserForUpdate(ctx context.Context, userID string) (User, error)
+	GetUsersByIDs(ctx context.Context, userIds []string) ([]User, error)
 	GetUsersByTeam(ctx context.Context, teamName string) ([]User, error)
+	HasData(ctx context.Context) (bool, error)
+	IncrementPullRequestVersion(ctx context.Context, pullRequestID string) (int64, error)
 	InsertUser(ctx context.Context, arg InsertUserParams) (User, error)
 	IsReviewerAssigned(ctx context.Context, arg IsReviewerAssignedParams) (bool, error)
+	IsTeamMember(ctx context.Context, arg IsTeamMemberParams) (bool, error)
+	ListAssignedReviewers(ctx context.Context) ([]ListAssignedReviewersRow, error)
+	ListAssignedReviewersByPRs(ctx context.Context, prIds []string) ([]ListAssignedReviewersByPRsRow, error)
+	ListEvents(ctx context.Context, arg ListEventsParams) ([]Event, error)
+	ListExistingPullRequestIDs(ctx context.Context, ids []string) ([]string, error)
+	ListOpenPullRequestsByAuthor(ctx context.Context, authorID string) ([]ListOpenPullRequestsByAuthorRow, error)
+	ListPullRequests(ctx context.Context) ([]PullRequest, error)
 	ListPullRequestsByReviewer(ctx context.Context, reviewerID string) ([]ListPullRequestsByReviewerRow, error)
+	ListPullRequestsByReviewers(ctx context.Context, reviewerIds []string) ([]ListPullRequestsByReviewersRow, error)
+	ListReviewerCandidates(ctx context.Context, teamName string) ([]ListReviewerCandidatesRow, error)
+	ListTeamMembersByTeams(ctx context.Context, teamNames []string) ([]ListTeamMembersByTeamsRow, error)
+	ListTeamMemberships(ctx context.Context) ([]TeamMembership, error)
+	ListTeams(ctx context.Context) ([]Team, error)
+	ListUserTeams(ctx context.Context, userID string) ([]string, error)
+	ListUsers(ctx context.Context) ([]User, error)
+	LockEventLog(ctx context.Context) error
+	LockTeamHierarchy(ctx context.Context) error
+	MarkStatsRebuilt(ctx context.Context) error
 	MergePullRequest(ctx context.Context, pullRequestID string) (PullRequest, error)
 	PRExists(ctx context.Context, pullRequestID string) (bool, error)
+	RebuildTeamDailyStats(ctx context.Context) error
+	RebuildUserDailyStats(ctx context.Context) error
+	RecordReviewTimes(ctx context.Context, pullRequestID string) error
+	ReleaseIdempotencyKey(ctx context.Context, key string) error
 	RemoveReviewer(ctx context.Context, arg RemoveReviewerParams) error
 	ReplaceReviewer(ctx context.Context, arg ReplaceReviewerParams) error
+	ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (int64, error)
+	RestorePullRequest(ctx context.Context, arg RestorePullRequestParams) error
+	RestoreReviewer(ctx context.Context, arg RestoreReviewerParams) error
+	SetParentTeam(ctx context.Context, arg SetParentTeamParams) (int64, error)
 	SetUserActivity(ctx context.Context, arg SetUserActivityParams) (User, error)
 	TeamExists(ctx context.Context, teamName string) (bool, error)
+	UpdateTeamSettings(ctx context.Context, arg UpdateTeamSettingsParams) (int64, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
+	UpdateUsername(ctx context.Context, arg UpdateUsernameParams) (User, error)
+	UpsertTeamDailyStats(ctx context.Context, arg UpsertTeamDailyStatsParams) error
+	UpsertUserDailyStats(ctx context.Context, arg UpsertUserDailyStatsParams) error
 	UserExists(ctx context.Context, userID string) (bool, error)
 }
 
@@ -7,6 +7,7 @@ package sqlc
 
 import (
 	"context"
+	"time"
 )
 
 const addReviewer = `-- name: AddReviewer :exec
@@ -24,6 +25,44 @@ func (q *Queries) AddReviewer(ctx context.Context, arg AddReviewerParams) error
 	return err
 }
 
+type CopyReviewersParams struct {
+	PrID       string    `json:"pr_id"`
+	ReviewerID string    `json:"reviewer_id"`
+	AssignedAt time.Time `json:"assigned_at"`
+}
+
+const countOpenReviews = `-- name: CountOpenReviews :one
+SELECT COUNT(*)
+FROM assigned_reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+WHERE ar.reviewer_id = $1 AND pr.status = 'OPEN'
+`
+
+func (q *Queries) CountOpenReviews(ctx context.Context, reviewerID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countOpenReviews, reviewerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getAssignedAt = `-- name: GetAssignedAt :one
+SELECT assigned_at
+FROM assigned_reviewers
+WHERE pr_id = $1 AND reviewer_id = $2
+`
+
+type GetAssignedAtParams struct {
+	PrID       string `json:"pr_id"`
+	ReviewerID string `json:"reviewer_id"`
+}
+
+func (q *Queries) GetAssignedAt(ctx context.Context, arg GetAssignedAtParams) (time.Time, error) {
+	row := q.db.QueryRow(ctx, getAssignedAt, arg.PrID, arg.ReviewerID)
+	var assigned_at time.Time
+	err := row.Scan(&assigned_at)
+	return assigned_at, err
+}
+
 const getAssignedReviewers = `-- name: GetAssignedReviewers :many
 SELECT reviewer_id
 FROM assigned_reviewers
@@ -71,6 +110,38 @@ func (q *Queries) IsReviewerAssigned(ctx context.Context, arg IsReviewerAssigned
 	return is_assigned, err
 }
 
+const listAssignedReviewersByPRs = `-- name: ListAssignedReviewersByPRs :many
+SELECT pr_id, reviewer_id
+FROM assigned_reviewers
+WHERE pr_id = ANY($1::text[])
+ORDER BY pr_id, reviewer_id
+`
+
+type ListAssignedReviewersByPRsRow struct {
+	PrID       string `json:"pr_id"`
+	ReviewerID string `json:"reviewer_id"`
+}
+
+func (q *Queries) ListAssignedReviewersByPRs(ctx context.Context, prIds []string) ([]ListAssignedReviewersByPRsRow, error) {
+	rows, err := q.db.Query(ctx, listAssignedReviewersByPRs, prIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAssignedReviewersByPRsRow{}
+	for rows.Next() {
+		var i ListAssignedReviewersByPRsRow
+		if err := rows.Scan(&i.PrID, &i.ReviewerID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const removeReviewer = `-- name: RemoveReviewer :exec
 DELETE FROM assigned_reviewers
 WHERE pr_id = $1 AND reviewer_id = $2
@@ -88,7 +159,8 @@ func (q *Queries) RemoveReviewer(ctx context.Context, arg RemoveReviewerParams)
 
 const replaceReviewer = `-- name: ReplaceReviewer :exec
 UPDATE assigned_reviewers
-SET reviewer_id = $3
+SET reviewer_id = $3,
+    assigned_at = NOW()
 WHERE pr_id = $1 AND reviewer_id = $2
 `
 
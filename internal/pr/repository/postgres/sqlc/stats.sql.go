@@ -7,52 +7,273 @@ package sqlc
 
 import (
 	"context"
+	"time"
 )
 
+const getAuthorCycleTimeStats = `-- name: GetAuthorCycleTimeStats :many
+WITH merged AS (
+    SELECT author_id, EXTRACT(EPOCH FROM merged_at - created_at)::float8 AS seconds
+    FROM pull_requests
+    WHERE status = 'MERGED'
+      AND merged_at IS NOT NULL
+      AND ($1::timestamp IS NULL OR merged_at >= $1::timestamp)
+      AND ($2::timestamp IS NULL OR merged_at < $2::timestamp)
+      AND ($3::text IS NULL OR team_name = $3::text)
+)
+SELECT
+    u.user_id,
+    u.username,
+    u.team_name,
+    COUNT(*) AS merged_prs,
+    AVG(m.seconds)::float8 AS mean_seconds,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY m.seconds)::float8 AS median_seconds,
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY m.seconds)::float8 AS p90_seconds,
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY m.seconds)::float8 AS p99_seconds
+FROM merged m
+JOIN users u ON u.user_id = m.author_id
+GROUP BY u.user_id, u.username, u.team_name
+ORDER BY u.user_id
+`
+
+type GetAuthorCycleTimeStatsParams struct {
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	TeamName *string    `json:"team_name"`
+}
+
+type GetAuthorCycleTimeStatsRow struct {
+	UserID        string  `json:"user_id"`
+	Username      string  `json:"username"`
+	TeamName      string  `json:"team_name"`
+	MergedPrs     int64   `json:"merged_prs"`
+	MeanSeconds   float64 `json:"mean_seconds"`
+	MedianSeconds float64 `json:"median_seconds"`
+	P90Seconds    float64 `json:"p90_seconds"`
+	P99Seconds    float64 `json:"p99_seconds"`
+}
+
+func (q *Queries) GetAuthorCycleTimeStats(ctx context.Context, arg GetAuthorCycleTimeStatsParams) ([]GetAuthorCycleTimeStatsRow, error) {
+	rows, err := q.db.Query(ctx, getAuthorCycleTimeStats, arg.From, arg.To, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetAuthorCycleTimeStatsRow{}
+	for rows.Next() {
+		var i GetAuthorCycleTimeStatsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TeamName,
+			&i.MergedPrs,
+			&i.MeanSeconds,
+			&i.MedianSeconds,
+			&i.P90Seconds,
+			&i.P99Seconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCycleTimeHistogram = `-- name: GetCycleTimeHistogram :many
+SELECT
+    width_bucket(EXTRACT(EPOCH FROM merged_at - created_at)::float8, $1::float8[]) AS bucket,
+    COUNT(*) AS prs_count
+FROM pull_requests
+WHERE status = 'MERGED'
+  AND merged_at IS NOT NULL
+  AND ($2::timestamp IS NULL OR merged_at >= $2::timestamp)
+  AND ($3::timestamp IS NULL OR merged_at < $3::timestamp)
+  AND ($4::text IS NULL OR team_name = $4::text)
+GROUP BY bucket
+ORDER BY bucket
+`
+
+type GetCycleTimeHistogramParams struct {
+	Bounds   []float64  `json:"bounds"`
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	TeamName *string    `json:"team_name"`
+}
+
+type GetCycleTimeHistogramRow struct {
+	Bucket   int32 `json:"bucket"`
+	PrsCount int64 `json:"prs_count"`
+}
+
+func (q *Queries) GetCycleTimeHistogram(ctx context.Context, arg GetCycleTimeHistogramParams) ([]GetCycleTimeHistogramRow, error) {
+	rows, err := q.db.Query(ctx, getCycleTimeHistogram,
+		arg.Bounds,
+		arg.From,
+		arg.To,
+		arg.TeamName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetCycleTimeHistogramRow{}
+	for rows.Next() {
+		var i GetCycleTimeHistogramRow
+		if err := rows.Scan(&i.Bucket, &i.PrsCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPRStats = `-- name: GetPRStats :one
 SELECT 
     COUNT(*) as total_prs,
     COUNT(*) FILTER (WHERE status = 'OPEN') as open_prs,
     COUNT(*) FILTER (WHERE status = 'MERGED') as merged_prs
 FROM pull_requests
+WHERE ($1::timestamp IS NULL OR created_at >= $1::timestamp)
+  AND ($2::timestamp IS NULL OR created_at < $2::timestamp)
+  AND ($3::text IS NULL OR team_name = $3::text)
 `
 
+type GetPRStatsParams struct {
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	TeamName *string    `json:"team_name"`
+}
+
 type GetPRStatsRow struct {
 	TotalPrs  int64 `json:"total_prs"`
 	OpenPrs   int64 `json:"open_prs"`
 	MergedPrs int64 `json:"merged_prs"`
 }
 
-func (q *Queries) GetPRStats(ctx context.Context) (GetPRStatsRow, error) {
-	row := q.db.QueryRow(ctx, getPRStats)
+func (q *Queries) GetPRStats(ctx context.Context, arg GetPRStatsParams) (GetPRStatsRow, error) {
+	row := q.db.QueryRow(ctx, getPRStats, arg.From, arg.To, arg.TeamName)
 	var i GetPRStatsRow
 	err := row.Scan(&i.TotalPrs, &i.OpenPrs, &i.MergedPrs)
 	return i, err
 }
 
+const getReviewerPairs = `-- name: GetReviewerPairs :many
+SELECT
+    pr.author_id,
+    a.username AS author_username,
+    ar.reviewer_id,
+    r.username AS reviewer_username,
+    COUNT(*) AS reviews_count
+FROM assigned_reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+JOIN users a ON a.user_id = pr.author_id
+JOIN users r ON r.user_id = ar.reviewer_id
+WHERE ($1::timestamp IS NULL OR ar.assigned_at >= $1::timestamp)
+  AND ($2::timestamp IS NULL OR ar.assigned_at < $2::timestamp)
+  AND ($3::text IS NULL OR pr.team_name = $3::text)
+GROUP BY pr.author_id, a.username, ar.reviewer_id, r.username
+ORDER BY reviews_count DESC, pr.author_id, ar.reviewer_id
+`
+
+type GetReviewerPairsParams struct {
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	TeamName *string    `json:"team_name"`
+}
+
+type GetReviewerPairsRow struct {
+	AuthorID         string `json:"author_id"`
+	AuthorUsername   string `json:"author_username"`
+	ReviewerID       string `json:"reviewer_id"`
+	ReviewerUsername string `json:"reviewer_username"`
+	ReviewsCount     int64  `json:"reviews_count"`
+}
+
+func (q *Queries) GetReviewerPairs(ctx context.Context, arg GetReviewerPairsParams) ([]GetReviewerPairsRow, error) {
+	rows, err := q.db.Query(ctx, getReviewerPairs, arg.From, arg.To, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReviewerPairsRow{}
+	for rows.Next() {
+		var i GetReviewerPairsRow
+		if err := rows.Scan(
+			&i.AuthorID,
+			&i.AuthorUsername,
+			&i.ReviewerID,
+			&i.ReviewerUsername,
+			&i.ReviewsCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getReviewerWorkload = `-- name: GetReviewerWorkload :many
 SELECT 
     u.user_id,
     u.username,
     u.team_name,
-    COUNT(ar.pr_id) as open_prs_count
+    COUNT(pr.pull_request_id) as open_prs_count,
+    COALESCE(EXTRACT(EPOCH FROM NOW()::timestamp - MIN(ar.assigned_at) FILTER (WHERE pr.pull_request_id IS NOT NULL)), 0)::float8 AS oldest_open_age_seconds,
+    COUNT(ar.pr_id) FILTER (WHERE ar.assigned_at >= NOW()::timestamp - INTERVAL '7 days') AS assignments_last_7_days
 FROM users u
 LEFT JOIN assigned_reviewers ar ON u.user_id = ar.reviewer_id
+    AND ($1::timestamp IS NULL OR ar.assigned_at >= $1::timestamp)
+    AND ($2::timestamp IS NULL OR ar.assigned_at < $2::timestamp)
 LEFT JOIN pull_requests pr ON ar.pr_id = pr.pull_request_id AND pr.status = 'OPEN'
 WHERE u.is_active = true
+  AND (
+      $3::text IS NULL
+      OR EXISTS (
+          SELECT 1
+          FROM team_memberships tm
+          WHERE tm.user_id = u.user_id AND tm.team_name = $3::text
+      )
+  )
 GROUP BY u.user_id, u.username, u.team_name
-ORDER BY open_prs_count DESC
+ORDER BY
+    CASE WHEN $4::text = 'username' THEN u.username END,
+    CASE WHEN $4::text = 'oldest_open' THEN MIN(ar.assigned_at) FILTER (WHERE pr.pull_request_id IS NOT NULL) END NULLS LAST,
+    CASE WHEN $4::text = 'recent_assignments' THEN COUNT(ar.pr_id) FILTER (WHERE ar.assigned_at >= NOW()::timestamp - INTERVAL '7 days') END DESC,
+    open_prs_count DESC,
+    u.user_id
 `
 
+type GetReviewerWorkloadParams struct {
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	TeamName *string    `json:"team_name"`
+	SortBy   string     `json:"sort_by"`
+}
+
 type GetReviewerWorkloadRow struct {
-	UserID       string `json:"user_id"`
-	Username     string `json:"username"`
-	TeamName     string `json:"team_name"`
-	OpenPrsCount int64  `json:"open_prs_count"`
+	UserID               string  `json:"user_id"`
+	Username             string  `json:"username"`
+	TeamName             string  `json:"team_name"`
+	OpenPrsCount         int64   `json:"open_prs_count"`
+	OldestOpenAgeSeconds float64 `json:"oldest_open_age_seconds"`
+	AssignmentsLast7Days int64   `json:"assignments_last_7_days"`
 }
 
-func (q *Queries) GetReviewerWorkload(ctx context.Context) ([]GetReviewerWorkloadRow, error) {
-	rows, err := q.db.Query(ctx, getReviewerWorkload)
+func (q *Queries) GetReviewerWorkload(ctx context.Context, arg GetReviewerWorkloadParams) ([]GetReviewerWorkloadRow, error) {
+	rows, err := q.db.Query(ctx, getReviewerWorkload,
+		arg.From,
+		arg.To,
+		arg.TeamName,
+		arg.SortBy,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +286,444 @@ func (q *Queries) GetReviewerWorkload(ctx context.Context) ([]GetReviewerWorkloa
 			&i.Username,
 			&i.TeamName,
 			&i.OpenPrsCount,
+			&i.OldestOpenAgeSeconds,
+			&i.AssignmentsLast7Days,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStalePRs = `-- name: GetStalePRs :many
+WITH thresholds AS (
+    SELECT
+        team_name,
+        COALESCE(
+            $1::bigint,
+            NULLIF(stale_after_seconds, 0),
+            $2::bigint
+        )::bigint AS threshold_seconds
+    FROM teams
+)
+SELECT
+    pr.pull_request_id,
+    pr.pull_request_name,
+    pr.author_id,
+    pr.team_name,
+    pr.created_at,
+    pr.stale_flagged_at,
+    EXTRACT(EPOCH FROM NOW()::timestamp - pr.created_at)::float8 AS age_seconds,
+    t.threshold_seconds,
+    COALESCE(array_agg(ar.reviewer_id ORDER BY ar.reviewer_id) FILTER (WHERE ar.reviewer_id IS NOT NULL), '{}')::text[] AS reviewers
+FROM pull_requests pr
+JOIN thresholds t ON t.team_name = pr.team_name
+LEFT JOIN assigned_reviewers ar ON ar.pr_id = pr.pull_request_id
+WHERE pr.status = 'OPEN'
+  AND pr.created_at <= NOW()::timestamp - make_interval(secs => t.threshold_seconds)
+  AND ($3::timestamp IS NULL OR pr.created_at >= $3::timestamp)
+  AND ($4::timestamp IS NULL OR pr.created_at < $4::timestamp)
+  AND ($5::text IS NULL OR pr.team_name = $5::text)
+GROUP BY pr.pull_request_id, t.threshold_seconds
+ORDER BY pr.created_at, pr.pull_request_id
+`
+
+type GetStalePRsParams struct {
+	OlderThanSeconds *int64     `json:"older_than_seconds"`
+	DefaultSeconds   int64      `json:"default_seconds"`
+	From             *time.Time `json:"from"`
+	To               *time.Time `json:"to"`
+	TeamName         *string    `json:"team_name"`
+}
+
+type GetStalePRsRow struct {
+	PullRequestID    string     `json:"pull_request_id"`
+	PullRequestName  string     `json:"pull_request_name"`
+	AuthorID         string     `json:"author_id"`
+	TeamName         string     `json:"team_name"`
+	CreatedAt        time.Time  `json:"created_at"`
+	StaleFlaggedAt   *time.Time `json:"stale_flagged_at"`
+	AgeSeconds       float64    `json:"age_seconds"`
+	ThresholdSeconds int64      `json:"threshold_seconds"`
+	Reviewers        []string   `json:"reviewers"`
+}
+
+func (q *Queries) GetStalePRs(ctx context.Context, arg GetStalePRsParams) ([]GetStalePRsRow, error) {
+	rows, err := q.db.Query(ctx, getStalePRs,
+		arg.OlderThanSeconds,
+		arg.DefaultSeconds,
+		arg.From,
+		arg.To,
+		arg.TeamName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetStalePRsRow{}
+	for rows.Next() {
+		var i GetStalePRsRow
+		if err := rows.Scan(
+			&i.PullRequestID,
+			&i.PullRequestName,
+			&i.AuthorID,
+			&i.TeamName,
+			&i.CreatedAt,
+			&i.StaleFlaggedAt,
+			&i.AgeSeconds,
+			&i.ThresholdSeconds,
+			&i.Reviewers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStatsTimeSeries = `-- name: GetStatsTimeSeries :many
+WITH opened AS (
+    SELECT date_trunc($1::text, created_at)::timestamp AS bucket, COUNT(*) AS prs_opened
+    FROM pull_requests
+    WHERE ($2::timestamp IS NULL OR created_at >= $2::timestamp)
+      AND ($3::timestamp IS NULL OR created_at < $3::timestamp)
+      AND ($4::text IS NULL OR team_name = $4::text)
+    GROUP BY 1
+),
+merged AS (
+    SELECT date_trunc($1::text, merged_at)::timestamp AS bucket, COUNT(*) AS prs_merged
+    FROM pull_requests
+    WHERE status = 'MERGED'
+      AND merged_at IS NOT NULL
+      AND ($2::timestamp IS NULL OR merged_at >= $2::timestamp)
+      AND ($3::timestamp IS NULL OR merged_at < $3::timestamp)
+      AND ($4::text IS NULL OR team_name = $4::text)
+    GROUP BY 1
+),
+assignments AS (
+    SELECT date_trunc($1::text, ar.assigned_at)::timestamp AS bucket, COUNT(*) AS assignments
+    FROM assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+    WHERE ($2::timestamp IS NULL OR ar.assigned_at >= $2::timestamp)
+      AND ($3::timestamp IS NULL OR ar.assigned_at < $3::timestamp)
+      AND ($4::text IS NULL OR pr.team_name = $4::text)
+    GROUP BY 1
+),
+buckets AS (
+    SELECT bucket FROM opened
+    UNION
+    SELECT bucket FROM merged
+    UNION
+    SELECT bucket FROM assignments
+)
+SELECT
+    b.bucket::timestamp AS bucket,
+    COALESCE(o.prs_opened, 0)::bigint AS prs_opened,
+    COALESCE(m.prs_merged, 0)::bigint AS prs_merged,
+    COALESCE(a.assignments, 0)::bigint AS assignments
+FROM buckets b
+LEFT JOIN opened o ON o.bucket = b.bucket
+LEFT JOIN merged m ON m.bucket = b.bucket
+LEFT JOIN assignments a ON a.bucket = b.bucket
+ORDER BY b.bucket
+`
+
+type GetStatsTimeSeriesParams struct {
+	Interval string     `json:"interval"`
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	TeamName *string    `json:"team_name"`
+}
+
+type GetStatsTimeSeriesRow struct {
+	Bucket      time.Time `json:"bucket"`
+	PrsOpened   int64     `json:"prs_opened"`
+	PrsMerged   int64     `json:"prs_merged"`
+	Assignments int64     `json:"assignments"`
+}
+
+func (q *Queries) GetStatsTimeSeries(ctx context.Context, arg GetStatsTimeSeriesParams) ([]GetStatsTimeSeriesRow, error) {
+	rows, err := q.db.Query(ctx, getStatsTimeSeries,
+		arg.Interval,
+		arg.From,
+		arg.To,
+		arg.TeamName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetStatsTimeSeriesRow{}
+	for rows.Next() {
+		var i GetStatsTimeSeriesRow
+		if err := rows.Scan(
+			&i.Bucket,
+			&i.PrsOpened,
+			&i.PrsMerged,
+			&i.Assignments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamCycleTimeStats = `-- name: GetTeamCycleTimeStats :many
+WITH merged AS (
+    SELECT team_name, EXTRACT(EPOCH FROM merged_at - created_at)::float8 AS seconds
+    FROM pull_requests
+    WHERE status = 'MERGED'
+      AND merged_at IS NOT NULL
+      AND ($1::timestamp IS NULL OR merged_at >= $1::timestamp)
+      AND ($2::timestamp IS NULL OR merged_at < $2::timestamp)
+      AND ($3::text IS NULL OR team_name = $3::text)
+)
+SELECT
+    team_name,
+    COUNT(*) AS merged_prs,
+    AVG(seconds)::float8 AS mean_seconds,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds)::float8 AS median_seconds,
+    percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds)::float8 AS p90_seconds,
+    percentile_cont(0.99) WITHIN GROUP (ORDER BY seconds)::float8 AS p99_seconds
+FROM merged
+GROUP BY team_name
+ORDER BY team_name
+`
+
+type GetTeamCycleTimeStatsParams struct {
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	TeamName *string    `json:"team_name"`
+}
+
+type GetTeamCycleTimeStatsRow struct {
+	TeamName      string  `json:"team_name"`
+	MergedPrs     int64   `json:"merged_prs"`
+	MeanSeconds   float64 `json:"mean_seconds"`
+	MedianSeconds float64 `json:"median_seconds"`
+	P90Seconds    float64 `json:"p90_seconds"`
+	P99Seconds    float64 `json:"p99_seconds"`
+}
+
+func (q *Queries) GetTeamCycleTimeStats(ctx context.Context, arg GetTeamCycleTimeStatsParams) ([]GetTeamCycleTimeStatsRow, error) {
+	rows, err := q.db.Query(ctx, getTeamCycleTimeStats, arg.From, arg.To, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamCycleTimeStatsRow{}
+	for rows.Next() {
+		var i GetTeamCycleTimeStatsRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.MergedPrs,
+			&i.MeanSeconds,
+			&i.MedianSeconds,
+			&i.P90Seconds,
+			&i.P99Seconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamMemberAssignmentCounts = `-- name: GetTeamMemberAssignmentCounts :many
+SELECT
+    tm.team_name,
+    u.user_id,
+    u.username,
+    COUNT(ar.pr_id) AS assignments_count
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+LEFT JOIN (
+    assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+) ON ar.reviewer_id = tm.user_id
+    AND pr.team_name = tm.team_name
+    AND ($1::timestamp IS NULL OR ar.assigned_at >= $1::timestamp)
+    AND ($2::timestamp IS NULL OR ar.assigned_at < $2::timestamp)
+WHERE u.is_active = true
+  AND tm.role != 'observer'
+  AND ($3::text IS NULL OR tm.team_name = $3::text)
+GROUP BY tm.team_name, u.user_id, u.username
+ORDER BY tm.team_name, u.user_id
+`
+
+type GetTeamMemberAssignmentCountsParams struct {
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	TeamName *string    `json:"team_name"`
+}
+
+type GetTeamMemberAssignmentCountsRow struct {
+	TeamName         string `json:"team_name"`
+	UserID           string `json:"user_id"`
+	Username         string `json:"username"`
+	AssignmentsCount int64  `json:"assignments_count"`
+}
+
+func (q *Queries) GetTeamMemberAssignmentCounts(ctx context.Context, arg GetTeamMemberAssignmentCountsParams) ([]GetTeamMemberAssignmentCountsRow, error) {
+	rows, err := q.db.Query(ctx, getTeamMemberAssignmentCounts, arg.From, arg.To, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamMemberAssignmentCountsRow{}
+	for rows.Next() {
+		var i GetTeamMemberAssignmentCountsRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.UserID,
+			&i.Username,
+			&i.AssignmentsCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamOpenWorkload = `-- name: GetTeamOpenWorkload :many
+SELECT
+    t.team_name,
+    COUNT(DISTINCT pr.pull_request_id) AS open_prs,
+    COUNT(ar.reviewer_id) AS open_assignments
+FROM teams t
+LEFT JOIN pull_requests pr ON pr.team_name = t.team_name AND pr.status = 'OPEN'
+LEFT JOIN assigned_reviewers ar ON ar.pr_id = pr.pull_request_id
+GROUP BY t.team_name
+ORDER BY t.team_name
+`
+
+type GetTeamOpenWorkloadRow struct {
+	TeamName        string `json:"team_name"`
+	OpenPrs         int64  `json:"open_prs"`
+	OpenAssignments int64  `json:"open_assignments"`
+}
+
+func (q *Queries) GetTeamOpenWorkload(ctx context.Context) ([]GetTeamOpenWorkloadRow, error) {
+	rows, err := q.db.Query(ctx, getTeamOpenWorkload)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamOpenWorkloadRow{}
+	for rows.Next() {
+		var i GetTeamOpenWorkloadRow
+		if err := rows.Scan(&i.TeamName, &i.OpenPrs, &i.OpenAssignments); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamRollupStats = `-- name: GetTeamRollupStats :many
+WITH RECURSIVE team_tree AS (
+    SELECT team_name AS root_team, team_name
+    FROM teams
+    WHERE $1::text IS NULL OR team_name = $1::text
+    UNION ALL
+    SELECT tt.root_team, t.team_name
+    FROM teams t
+    JOIN team_tree tt ON t.parent_team_name = tt.team_name
+) CYCLE team_name SET is_cycle USING path,
+team_members AS (
+    SELECT team_name, COUNT(*) AS members_count
+    FROM team_memberships
+    GROUP BY team_name
+),
+team_assignments AS (
+    SELECT pr.team_name, COUNT(*) AS assignments_count
+    FROM assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+    WHERE ($2::timestamp IS NULL OR ar.assigned_at >= $2::timestamp)
+      AND ($3::timestamp IS NULL OR ar.assigned_at < $3::timestamp)
+    GROUP BY pr.team_name
+),
+team_prs AS (
+    SELECT
+        team_name,
+        COUNT(*) FILTER (WHERE status = 'OPEN') AS open_prs,
+        COUNT(*) FILTER (WHERE status = 'MERGED') AS merged_prs
+    FROM pull_requests
+    WHERE ($2::timestamp IS NULL OR created_at >= $2::timestamp)
+      AND ($3::timestamp IS NULL OR created_at < $3::timestamp)
+    GROUP BY team_name
+)
+SELECT
+    t.team_name,
+    t.parent_team_name,
+    COUNT(tt.team_name) AS teams_count,
+    COALESCE(SUM(m.members_count), 0)::bigint AS members_count,
+    COALESCE(SUM(a.assignments_count), 0)::bigint AS assignments_count,
+    COALESCE(SUM(p.open_prs), 0)::bigint AS open_prs,
+    COALESCE(SUM(p.merged_prs), 0)::bigint AS merged_prs
+FROM teams t
+JOIN team_tree tt ON tt.root_team = t.team_name AND NOT tt.is_cycle
+LEFT JOIN team_members m ON m.team_name = tt.team_name
+LEFT JOIN team_assignments a ON a.team_name = tt.team_name
+LEFT JOIN team_prs p ON p.team_name = tt.team_name
+GROUP BY t.team_name, t.parent_team_name
+ORDER BY t.team_name
+`
+
+type GetTeamRollupStatsParams struct {
+	TeamName *string    `json:"team_name"`
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+}
+
+type GetTeamRollupStatsRow struct {
+	TeamName         string  `json:"team_name"`
+	ParentTeamName   *string `json:"parent_team_name"`
+	TeamsCount       int64   `json:"teams_count"`
+	MembersCount     int64   `json:"members_count"`
+	AssignmentsCount int64   `json:"assignments_count"`
+	OpenPrs          int64   `json:"open_prs"`
+	MergedPrs        int64   `json:"merged_prs"`
+}
+
+func (q *Queries) GetTeamRollupStats(ctx context.Context, arg GetTeamRollupStatsParams) ([]GetTeamRollupStatsRow, error) {
+	rows, err := q.db.Query(ctx, getTeamRollupStats, arg.TeamName, arg.From, arg.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamRollupStatsRow{}
+	for rows.Next() {
+		var i GetTeamRollupStatsRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.ParentTeamName,
+			&i.TeamsCount,
+			&i.MembersCount,
+			&i.AssignmentsCount,
+			&i.OpenPrs,
+			&i.MergedPrs,
 		); err != nil {
 			return nil, err
 		}
@@ -77,26 +736,58 @@ func (q *Queries) GetReviewerWorkload(ctx context.Context) ([]GetReviewerWorkloa
 }
 
 const getUserAssignmentStats = `-- name: GetUserAssignmentStats :many
+WITH reviews AS (
+    SELECT
+        ar.reviewer_id,
+        COUNT(*) AS reviews_merged,
+        AVG(EXTRACT(EPOCH FROM pr.merged_at - ar.assigned_at))::float8 AS mean_review_seconds
+    FROM assigned_reviewers ar
+    JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id
+    WHERE pr.status = 'MERGED'
+      AND pr.merged_at IS NOT NULL
+      AND ($1::timestamp IS NULL OR pr.merged_at >= $1::timestamp)
+      AND ($2::timestamp IS NULL OR pr.merged_at < $2::timestamp)
+    GROUP BY ar.reviewer_id
+)
 SELECT 
     u.user_id,
     u.username,
     u.team_name,
-    COUNT(ar.pr_id) as assignments_count
+    COUNT(ar.pr_id) as assignments_count,
+    COALESCE(r.reviews_merged, 0)::bigint AS reviews_merged,
+    COALESCE(r.mean_review_seconds, 0)::float8 AS mean_review_seconds
 FROM users u
 LEFT JOIN assigned_reviewers ar ON u.user_id = ar.reviewer_id
-GROUP BY u.user_id, u.username, u.team_name
+    AND ($1::timestamp IS NULL OR ar.assigned_at >= $1::timestamp)
+    AND ($2::timestamp IS NULL OR ar.assigned_at < $2::timestamp)
+LEFT JOIN reviews r ON r.reviewer_id = u.user_id
+WHERE $3::text IS NULL
+   OR EXISTS (
+       SELECT 1
+       FROM team_memberships tm
+       WHERE tm.user_id = u.user_id AND tm.team_name = $3::text
+   )
+GROUP BY u.user_id, u.username, u.team_name, r.reviews_merged, r.mean_review_seconds
 ORDER BY assignments_count DESC, u.user_id
 `
 
+type GetUserAssignmentStatsParams struct {
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	TeamName *string    `json:"team_name"`
+}
+
 type GetUserAssignmentStatsRow struct {
-	UserID           string `json:"user_id"`
-	Username         string `json:"username"`
-	TeamName         string `json:"team_name"`
-	AssignmentsCount int64  `json:"assignments_count"`
+	UserID            string  `json:"user_id"`
+	Username          string  `json:"username"`
+	TeamName          string  `json:"team_name"`
+	AssignmentsCount  int64   `json:"assignments_count"`
+	ReviewsMerged     int64   `json:"reviews_merged"`
+	MeanReviewSeconds float64 `json:"mean_review_seconds"`
 }
 
-func (q *Queries) GetUserAssignmentStats(ctx context.Context) ([]GetUserAssignmentStatsRow, error) {
-	rows, err := q.db.Query(ctx, getUserAssignmentStats)
+func (q *Queries) GetUserAssignmentStats(ctx context.Context, arg GetUserAssignmentStatsParams) ([]GetUserAssignmentStatsRow, error) {
+	rows, err := q.db.Query(ctx, getUserAssignmentStats, arg.From, arg.To, arg.TeamName)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +800,8 @@ func (q *Queries) GetUserAssignmentStats(ctx context.Context) ([]GetUserAssignme
 			&i.Username,
 			&i.TeamName,
 			&i.AssignmentsCount,
+			&i.ReviewsMerged,
+			&i.MeanReviewSeconds,
 		); err != nil {
 			return nil, err
 		}
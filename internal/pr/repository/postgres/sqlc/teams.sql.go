@@ -9,6 +9,36 @@ import (
 	"context"
 )
 
+const addTeamMember = `-- name: AddTeamMember :exec
+INSERT INTO team_memberships (team_name, user_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_name, user_id) DO UPDATE SET role = EXCLUDED.role
+`
+
+type AddTeamMemberParams struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+}
+
+func (q *Queries) AddTeamMember(ctx context.Context, arg AddTeamMemberParams) error {
+	_, err := q.db.Exec(ctx, addTeamMember, arg.TeamName, arg.UserID, arg.Role)
+	return err
+}
+
+const countTeamLeads = `-- name: CountTeamLeads :one
+SELECT COUNT(*)
+FROM team_memberships
+WHERE team_name = $1 AND role = 'lead'
+`
+
+func (q *Queries) CountTeamLeads(ctx context.Context, teamName string) (int64, error) {
+	row := q.db.QueryRow(ctx, countTeamLeads, teamName)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createTeam = `-- name: CreateTeam :one
 INSERT INTO teams (team_name)
 VALUES ($1)
@@ -23,16 +53,205 @@ func (q *Queries) CreateTeam(ctx context.Context, teamName string) (string, erro
 }
 
 const getTeam = `-- name: GetTeam :one
-SELECT team_name
+SELECT team_name, parent_team_name, require_lead_review, stale_after_seconds
 FROM teams
 WHERE team_name = $1
 `
 
-func (q *Queries) GetTeam(ctx context.Context, teamName string) (string, error) {
+func (q *Queries) GetTeam(ctx context.Context, teamName string) (Team, error) {
 	row := q.db.QueryRow(ctx, getTeam, teamName)
-	var team_name string
-	err := row.Scan(&team_name)
-	return team_name, err
+	var i Team
+	err := row.Scan(
+		&i.TeamName,
+		&i.ParentTeamName,
+		&i.RequireLeadReview,
+		&i.StaleAfterSeconds,
+	)
+	return i, err
+}
+
+const getTeamAncestors = `-- name: GetTeamAncestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT t.parent_team_name, 1 AS depth
+    FROM teams t
+    WHERE t.team_name = $1
+    UNION ALL
+    SELECT t.parent_team_name, a.depth + 1
+    FROM teams t
+    JOIN ancestors a ON t.team_name = a.parent_team_name
+) CYCLE parent_team_name SET is_cycle USING path
+SELECT parent_team_name::text AS team_name
+FROM ancestors
+WHERE parent_team_name IS NOT NULL AND NOT is_cycle
+ORDER BY depth
+`
+
+func (q *Queries) GetTeamAncestors(ctx context.Context, teamName string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getTeamAncestors, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var team_name string
+		if err := rows.Scan(&team_name); err != nil {
+			return nil, err
+		}
+		items = append(items, team_name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamMemberRole = `-- name: GetTeamMemberRole :one
+SELECT role
+FROM team_memberships
+WHERE team_name = $1 AND user_id = $2
+`
+
+type GetTeamMemberRoleParams struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+}
+
+func (q *Queries) GetTeamMemberRole(ctx context.Context, arg GetTeamMemberRoleParams) (string, error) {
+	row := q.db.QueryRow(ctx, getTeamMemberRole, arg.TeamName, arg.UserID)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
+const getTeamMembers = `-- name: GetTeamMembers :many
+SELECT u.user_id, u.username, u.team_name, u.is_active, tm.role
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1
+ORDER BY u.user_id
+`
+
+type GetTeamMembersRow struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	IsActive bool   `json:"is_active"`
+	Role     string `json:"role"`
+}
+
+func (q *Queries) GetTeamMembers(ctx context.Context, teamName string) ([]GetTeamMembersRow, error) {
+	rows, err := q.db.Query(ctx, getTeamMembers, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamMembersRow{}
+	for rows.Next() {
+		var i GetTeamMembersRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TeamName,
+			&i.IsActive,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isTeamMember = `-- name: IsTeamMember :one
+SELECT EXISTS(SELECT 1 FROM team_memberships WHERE team_name = $1 AND user_id = $2)
+`
+
+type IsTeamMemberParams struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+}
+
+func (q *Queries) IsTeamMember(ctx context.Context, arg IsTeamMemberParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isTeamMember, arg.TeamName, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listTeamMembersByTeams = `-- name: ListTeamMembersByTeams :many
+SELECT tm.team_name AS member_of, u.user_id, u.username, u.team_name, u.is_active, tm.role
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = ANY($1::text[])
+ORDER BY tm.team_name, u.user_id
+`
+
+type ListTeamMembersByTeamsRow struct {
+	MemberOf string `json:"member_of"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	IsActive bool   `json:"is_active"`
+	Role     string `json:"role"`
+}
+
+func (q *Queries) ListTeamMembersByTeams(ctx context.Context, teamNames []string) ([]ListTeamMembersByTeamsRow, error) {
+	rows, err := q.db.Query(ctx, listTeamMembersByTeams, teamNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTeamMembersByTeamsRow{}
+	for rows.Next() {
+		var i ListTeamMembersByTeamsRow
+		if err := rows.Scan(
+			&i.MemberOf,
+			&i.UserID,
+			&i.Username,
+			&i.TeamName,
+			&i.IsActive,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockTeamHierarchy = `-- name: LockTeamHierarchy :exec
+SELECT pg_advisory_xact_lock(hashtext('team_hierarchy'))
+`
+
+func (q *Queries) LockTeamHierarchy(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockTeamHierarchy)
+	return err
+}
+
+const setParentTeam = `-- name: SetParentTeam :execrows
+UPDATE teams
+SET parent_team_name = $2
+WHERE team_name = $1
+`
+
+type SetParentTeamParams struct {
+	TeamName       string  `json:"team_name"`
+	ParentTeamName *string `json:"parent_team_name"`
+}
+
+func (q *Queries) SetParentTeam(ctx context.Context, arg SetParentTeamParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setParentTeam, arg.TeamName, arg.ParentTeamName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const teamExists = `-- name: TeamExists :one
@@ -45,3 +264,24 @@ func (q *Queries) TeamExists(ctx context.Context, teamName string) (bool, error)
 	err := row.Scan(&exists)
 	return exists, err
 }
+
+const updateTeamSettings = `-- name: UpdateTeamSettings :execrows
+UPDATE teams
+SET require_lead_review = $2,
+    stale_after_seconds = $3
+WHERE team_name = $1
+`
+
+type UpdateTeamSettingsParams struct {
+	TeamName          string `json:"team_name"`
+	RequireLeadReview bool   `json:"require_lead_review"`
+	StaleAfterSeconds int64  `json:"stale_after_seconds"`
+}
+
+func (q *Queries) UpdateTeamSettings(ctx context.Context, arg UpdateTeamSettingsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTeamSettings, arg.TeamName, arg.RequireLeadReview, arg.StaleAfterSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
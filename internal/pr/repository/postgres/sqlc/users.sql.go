@@ -10,11 +10,13 @@ import (
 )
 
 const getActiveCandidatesForPR = `-- name: GetActiveCandidatesForPR :many
-SELECT user_id, username
-FROM users
-WHERE team_name = $1 
-  AND is_active = true 
-  AND user_id != $2
+SELECT u.user_id, u.username
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1 
+  AND tm.role != 'observer'
+  AND u.is_active = true 
+  AND u.user_id != $2
 ORDER BY RANDOM()
 LIMIT 2
 `
@@ -50,12 +52,14 @@ func (q *Queries) GetActiveCandidatesForPR(ctx context.Context, arg GetActiveCan
 }
 
 const getActiveCandidatesForReassignment = `-- name: GetActiveCandidatesForReassignment :many
-SELECT user_id
-FROM users
-WHERE team_name = $1 
-  AND is_active = true 
-  AND user_id != $2
-  AND user_id NOT IN (
+SELECT u.user_id
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1 
+  AND tm.role != 'observer'
+  AND u.is_active = true 
+  AND u.user_id != $2
+  AND u.user_id NOT IN (
     SELECT reviewer_id 
     FROM assigned_reviewers 
     WHERE pr_id = $3
@@ -90,6 +94,43 @@ func (q *Queries) GetActiveCandidatesForReassignment(ctx context.Context, arg Ge
 	return items, nil
 }
 
+const getActiveLeadCandidatesForPR = `-- name: GetActiveLeadCandidatesForPR :many
+SELECT u.user_id
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1
+  AND tm.role = 'lead'
+  AND u.is_active = true
+  AND u.user_id != $2
+ORDER BY RANDOM()
+LIMIT 1
+`
+
+type GetActiveLeadCandidatesForPRParams struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+}
+
+func (q *Queries) GetActiveLeadCandidatesForPR(ctx context.Context, arg GetActiveLeadCandidatesForPRParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, getActiveLeadCandidatesForPR, arg.TeamName, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUser = `-- name: GetUser :one
 SELECT user_id, username, team_name, is_active
 FROM users
@@ -108,13 +149,65 @@ func (q *Queries) GetUser(ctx context.Context, userID string) (User, error) {
 	return i, err
 }
 
-const getUsersByTeam = `-- name: GetUsersByTeam :many
+const getUserForUpdate = `-- name: GetUserForUpdate :one
+SELECT user_id, username, team_name, is_active
+FROM users
+WHERE user_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetUserForUpdate(ctx context.Context, userID string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserForUpdate, userID)
+	var i User
+	err := row.Scan(
+		&i.UserID,
+		&i.Username,
+		&i.TeamName,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getUsersByIDs = `-- name: GetUsersByIDs :many
 SELECT user_id, username, team_name, is_active
 FROM users
-WHERE team_name = $1
+WHERE user_id = ANY($1::text[])
 ORDER BY user_id
 `
 
+func (q *Queries) GetUsersByIDs(ctx context.Context, userIds []string) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsersByIDs, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TeamName,
+			&i.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUsersByTeam = `-- name: GetUsersByTeam :many
+SELECT u.user_id, u.username, u.team_name, u.is_active
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+WHERE tm.team_name = $1
+ORDER BY u.user_id
+`
+
 func (q *Queries) GetUsersByTeam(ctx context.Context, teamName string) ([]User, error) {
 	rows, err := q.db.Query(ctx, getUsersByTeam, teamName)
 	if err != nil {
@@ -170,6 +263,72 @@ func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) (User, e
 	return i, err
 }
 
+const listReviewerCandidates = `-- name: ListReviewerCandidates :many
+SELECT u.user_id, tm.role, COUNT(pr.pull_request_id) AS open_reviews
+FROM team_memberships tm
+JOIN users u ON u.user_id = tm.user_id
+LEFT JOIN assigned_reviewers ar ON ar.reviewer_id = u.user_id
+LEFT JOIN pull_requests pr ON pr.pull_request_id = ar.pr_id AND pr.status = 'OPEN'
+WHERE tm.team_name = $1
+  AND tm.role != 'observer'
+  AND u.is_active = true
+GROUP BY u.user_id, tm.role
+ORDER BY u.user_id
+`
+
+type ListReviewerCandidatesRow struct {
+	UserID      string `json:"user_id"`
+	Role        string `json:"role"`
+	OpenReviews int64  `json:"open_reviews"`
+}
+
+func (q *Queries) ListReviewerCandidates(ctx context.Context, teamName string) ([]ListReviewerCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listReviewerCandidates, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListReviewerCandidatesRow{}
+	for rows.Next() {
+		var i ListReviewerCandidatesRow
+		if err := rows.Scan(&i.UserID, &i.Role, &i.OpenReviews); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserTeams = `-- name: ListUserTeams :many
+SELECT team_name
+FROM team_memberships
+WHERE user_id = $1
+ORDER BY team_name
+`
+
+func (q *Queries) ListUserTeams(ctx context.Context, userID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listUserTeams, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var team_name string
+		if err := rows.Scan(&team_name); err != nil {
+			return nil, err
+		}
+		items = append(items, team_name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setUserActivity = `-- name: SetUserActivity :one
 UPDATE users
 SET is_active = $2
@@ -198,28 +357,45 @@ const updateUser = `-- name: UpdateUser :exec
 UPDATE users
 SET
     username = $2,
-    team_name = $3,
-    is_active = $4
+    is_active = $3
 WHERE user_id = $1
 `
 
 type UpdateUserParams struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
-	TeamName string `json:"team_name"`
 	IsActive bool   `json:"is_active"`
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
-	_, err := q.db.Exec(ctx, updateUser,
-		arg.UserID,
-		arg.Username,
-		arg.TeamName,
-		arg.IsActive,
-	)
+	_, err := q.db.Exec(ctx, updateUser, arg.UserID, arg.Username, arg.IsActive)
 	return err
 }
 
+const updateUsername = `-- name: UpdateUsername :one
+UPDATE users
+SET username = $2
+WHERE user_id = $1
+RETURNING user_id, username, team_name, is_active
+`
+
+type UpdateUsernameParams struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+func (q *Queries) UpdateUsername(ctx context.Context, arg UpdateUsernameParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUsername, arg.UserID, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.UserID,
+		&i.Username,
+		&i.TeamName,
+		&i.IsActive,
+	)
+	return i, err
+}
+
 const userExists = `-- name: UserExists :one
 SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1)
 `
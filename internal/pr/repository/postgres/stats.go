@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres/sqlc"
@@ -16,8 +17,12 @@ func NewStatsRepository(queries *sqlc.Queries) *StatsRepository {
 	return &StatsRepository{queries: queries}
 }
 
-func (r *StatsRepository) GetUserAssignmentStats(ctx context.Context) ([]domain.UserAssignmentStats, error) {
-	rows, err := r.queries.GetUserAssignmentStats(ctx)
+func (r *StatsRepository) GetUserAssignmentStats(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	rows, err := r.queries.GetUserAssignmentStats(ctx, sqlc.GetUserAssignmentStatsParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("get user assignment stats: %w", err)
 	}
@@ -29,13 +34,19 @@ func (r *StatsRepository) GetUserAssignmentStats(ctx context.Context) ([]domain.
 			Username:         row.Username,
 			TeamName:         row.TeamName,
 			AssignmentsCount: row.AssignmentsCount,
+			ReviewsMerged:    row.ReviewsMerged,
+			MeanTimeInReview: secondsToDuration(row.MeanReviewSeconds),
 		}
 	}
 	return result, nil
 }
 
-func (r *StatsRepository) GetPRStats(ctx context.Context) (*domain.PRStats, error) {
-	stats, err := r.queries.GetPRStats(ctx)
+func (r *StatsRepository) GetPRStats(ctx context.Context, filter domain.StatsFilter) (*domain.PRStats, error) {
+	stats, err := r.queries.GetPRStats(ctx, sqlc.GetPRStatsParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("get PR stats: %w", err)
 	}
@@ -47,8 +58,13 @@ func (r *StatsRepository) GetPRStats(ctx context.Context) (*domain.PRStats, erro
 	}, nil
 }
 
-func (r *StatsRepository) GetReviewerWorkload(ctx context.Context) ([]domain.ReviewerWorkload, error) {
-	rows, err := r.queries.GetReviewerWorkload(ctx)
+func (r *StatsRepository) GetReviewerWorkload(ctx context.Context, filter domain.StatsFilter, sort domain.WorkloadSort) ([]domain.ReviewerWorkload, error) {
+	rows, err := r.queries.GetReviewerWorkload(ctx, sqlc.GetReviewerWorkloadParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+		SortBy:   string(sort),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("get reviewer workload: %w", err)
 	}
@@ -56,11 +72,255 @@ func (r *StatsRepository) GetReviewerWorkload(ctx context.Context) ([]domain.Rev
 	result := make([]domain.ReviewerWorkload, len(rows))
 	for i, row := range rows {
 		result[i] = domain.ReviewerWorkload{
-			UserID:       row.UserID,
-			Username:     row.Username,
-			TeamName:     row.TeamName,
-			OpenPRsCount: row.OpenPrsCount,
+			UserID:               row.UserID,
+			Username:             row.Username,
+			TeamName:             row.TeamName,
+			OpenPRsCount:         row.OpenPrsCount,
+			OldestOpenAge:        secondsToDuration(row.OldestOpenAgeSeconds),
+			AssignmentsLast7Days: row.AssignmentsLast7Days,
 		}
 	}
 	return result, nil
 }
+
+func (r *StatsRepository) GetTeamRollupStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamRollupStats, error) {
+	rows, err := r.queries.GetTeamRollupStats(ctx, sqlc.GetTeamRollupStatsParams{
+		TeamName: nullableString(filter.TeamName),
+		From:     filter.From,
+		To:       filter.To,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get team rollup stats: %w", err)
+	}
+
+	result := make([]domain.TeamRollupStats, len(rows))
+	for i, row := range rows {
+		result[i] = domain.TeamRollupStats{
+			TeamName:         row.TeamName,
+			ParentTeamName:   derefString(row.ParentTeamName),
+			TeamsCount:       row.TeamsCount,
+			MembersCount:     row.MembersCount,
+			AssignmentsCount: row.AssignmentsCount,
+			OpenPRs:          row.OpenPrs,
+			MergedPRs:        row.MergedPrs,
+		}
+	}
+	return result, nil
+}
+
+func (r *StatsRepository) GetReviewerPairs(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerPair, error) {
+	rows, err := r.queries.GetReviewerPairs(ctx, sqlc.GetReviewerPairsParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get reviewer pairs: %w", err)
+	}
+
+	result := make([]domain.ReviewerPair, len(rows))
+	for i, row := range rows {
+		result[i] = domain.ReviewerPair{
+			AuthorID:         row.AuthorID,
+			AuthorUsername:   row.AuthorUsername,
+			ReviewerID:       row.ReviewerID,
+			ReviewerUsername: row.ReviewerUsername,
+			ReviewsCount:     row.ReviewsCount,
+		}
+	}
+	return result, nil
+}
+
+func (r *StatsRepository) GetTeamOpenWorkload(ctx context.Context) ([]domain.TeamOpenWorkload, error) {
+	rows, err := r.queries.GetTeamOpenWorkload(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get team open workload: %w", err)
+	}
+
+	result := make([]domain.TeamOpenWorkload, len(rows))
+	for i, row := range rows {
+		result[i] = domain.TeamOpenWorkload{
+			TeamName:        row.TeamName,
+			OpenPRs:         row.OpenPrs,
+			OpenAssignments: row.OpenAssignments,
+		}
+	}
+	return result, nil
+}
+
+// GetTeamMemberAssignmentCounts returns one row per active non-observer team
+// member with the number of assignments on that team's PRs. TeamName holds the
+// membership team rather than the user's primary team.
+func (r *StatsRepository) GetTeamMemberAssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	rows, err := r.queries.GetTeamMemberAssignmentCounts(ctx, sqlc.GetTeamMemberAssignmentCountsParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get team member assignment counts: %w", err)
+	}
+
+	result := make([]domain.UserAssignmentStats, len(rows))
+	for i, row := range rows {
+		result[i] = domain.UserAssignmentStats{
+			UserID:           row.UserID,
+			Username:         row.Username,
+			TeamName:         row.TeamName,
+			AssignmentsCount: row.AssignmentsCount,
+		}
+	}
+	return result, nil
+}
+
+func (r *StatsRepository) GetTeamCycleTimes(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamCycleTime, error) {
+	rows, err := r.queries.GetTeamCycleTimeStats(ctx, sqlc.GetTeamCycleTimeStatsParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get team cycle time stats: %w", err)
+	}
+
+	result := make([]domain.TeamCycleTime, len(rows))
+	for i, row := range rows {
+		result[i] = domain.TeamCycleTime{
+			TeamName: row.TeamName,
+			CycleTimeSummary: domain.CycleTimeSummary{
+				MergedPRs: row.MergedPrs,
+				Mean:      secondsToDuration(row.MeanSeconds),
+				Median:    secondsToDuration(row.MedianSeconds),
+				P90:       secondsToDuration(row.P90Seconds),
+				P99:       secondsToDuration(row.P99Seconds),
+			},
+		}
+	}
+	return result, nil
+}
+
+func (r *StatsRepository) GetAuthorCycleTimes(ctx context.Context, filter domain.StatsFilter) ([]domain.AuthorCycleTime, error) {
+	rows, err := r.queries.GetAuthorCycleTimeStats(ctx, sqlc.GetAuthorCycleTimeStatsParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get author cycle time stats: %w", err)
+	}
+
+	result := make([]domain.AuthorCycleTime, len(rows))
+	for i, row := range rows {
+		result[i] = domain.AuthorCycleTime{
+			UserID:   row.UserID,
+			Username: row.Username,
+			TeamName: row.TeamName,
+			CycleTimeSummary: domain.CycleTimeSummary{
+				MergedPRs: row.MergedPrs,
+				Mean:      secondsToDuration(row.MeanSeconds),
+				Median:    secondsToDuration(row.MedianSeconds),
+				P90:       secondsToDuration(row.P90Seconds),
+				P99:       secondsToDuration(row.P99Seconds),
+			},
+		}
+	}
+	return result, nil
+}
+
+// GetCycleTimeHistogram returns len(bounds)+1 buckets: below the first bound,
+// between each pair of bounds and at or above the last one. Bounds must be
+// sorted in ascending order.
+func (r *StatsRepository) GetCycleTimeHistogram(ctx context.Context, filter domain.StatsFilter, bounds []time.Duration) ([]domain.CycleTimeBucket, error) {
+	seconds := make([]float64, len(bounds))
+	for i, b := range bounds {
+		seconds[i] = b.Seconds()
+	}
+
+	rows, err := r.queries.GetCycleTimeHistogram(ctx, sqlc.GetCycleTimeHistogramParams{
+		Bounds:   seconds,
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get cycle time histogram: %w", err)
+	}
+
+	buckets := make([]domain.CycleTimeBucket, len(bounds)+1)
+	for i := range buckets {
+		if i > 0 {
+			buckets[i].From = bounds[i-1]
+		}
+		if i < len(bounds) {
+			buckets[i].To = &bounds[i]
+		}
+	}
+	for _, row := range rows {
+		buckets[row.Bucket].Count = row.PrsCount
+	}
+	return buckets, nil
+}
+
+func (r *StatsRepository) GetTimeSeries(ctx context.Context, filter domain.StatsFilter, interval domain.StatsInterval) ([]domain.TimeSeriesPoint, error) {
+	rows, err := r.queries.GetStatsTimeSeries(ctx, sqlc.GetStatsTimeSeriesParams{
+		Interval: string(interval),
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get stats time series: %w", err)
+	}
+
+	result := make([]domain.TimeSeriesPoint, len(rows))
+	for i, row := range rows {
+		result[i] = domain.TimeSeriesPoint{
+			Bucket:      row.Bucket,
+			PRsOpened:   row.PrsOpened,
+			PRsMerged:   row.PrsMerged,
+			Assignments: row.Assignments,
+		}
+	}
+	return result, nil
+}
+
+// GetStalePRs lists open PRs older than olderThan or, when it is zero, than
+// their team's threshold falling back to defaultAge.
+func (r *StatsRepository) GetStalePRs(ctx context.Context, filter domain.StatsFilter, olderThan, defaultAge time.Duration) ([]domain.StalePR, error) {
+	var olderThanSeconds *int64
+	if olderThan > 0 {
+		seconds := int64(olderThan / time.Second)
+		olderThanSeconds = &seconds
+	}
+
+	rows, err := r.queries.GetStalePRs(ctx, sqlc.GetStalePRsParams{
+		OlderThanSeconds: olderThanSeconds,
+		DefaultSeconds:   int64(defaultAge / time.Second),
+		From:             filter.From,
+		To:               filter.To,
+		TeamName:         nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get stale PRs: %w", err)
+	}
+
+	result := make([]domain.StalePR, len(rows))
+	for i, row := range rows {
+		result[i] = domain.StalePR{
+			PullRequestID:     row.PullRequestID,
+			PullRequestName:   row.PullRequestName,
+			AuthorID:          row.AuthorID,
+			TeamName:          row.TeamName,
+			AssignedReviewers: row.Reviewers,
+			CreatedAt:         row.CreatedAt,
+			Age:               secondsToDuration(row.AgeSeconds),
+			Threshold:         time.Duration(row.ThresholdSeconds) * time.Second,
+			FlaggedAt:         row.StaleFlaggedAt,
+		}
+	}
+	return result, nil
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,300 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres/sqlc"
+)
+
+// RecordPRCreated counts a new PR and its initial reviewer assignments in the
+// daily aggregates.
+func (r *StatsRepository) RecordPRCreated(ctx context.Context, pr *domain.PullRequest) error {
+	day := statsDay(pr.CreatedAt)
+
+	err := r.queries.UpsertTeamDailyStats(ctx, sqlc.UpsertTeamDailyStatsParams{
+		Day:       day,
+		TeamName:  pr.TeamName,
+		PrsOpened: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("record opened PR: %w", err)
+	}
+
+	for _, reviewerID := range pr.AssignedReviewers {
+		err := r.queries.UpsertUserDailyStats(ctx, sqlc.UpsertUserDailyStatsParams{
+			Day:         day,
+			ReviewerID:  reviewerID,
+			TeamName:    pr.TeamName,
+			Assignments: 1,
+		})
+		if err != nil {
+			return fmt.Errorf("record assignment of %s: %w", reviewerID, err)
+		}
+	}
+
+	return nil
+}
+
+// RecordPRsCreated does what RecordPRCreated does for a whole batch, with one
+// upsert per team and day and per reviewer and day. Keys are written in a
+// fixed order so that concurrent batches lock the rows the same way.
+func (r *StatsRepository) RecordPRsCreated(ctx context.Context, prs []*domain.PullRequest) error {
+	type teamDay struct {
+		day      time.Time
+		teamName string
+	}
+	type reviewerDay struct {
+		teamDay
+		reviewerID string
+	}
+
+	opened := make(map[teamDay]int64)
+	assignments := make(map[reviewerDay]int64)
+	for _, pr := range prs {
+		key := teamDay{day: statsDay(pr.CreatedAt), teamName: pr.TeamName}
+		opened[key]++
+		for _, reviewerID := range pr.AssignedReviewers {
+			assignments[reviewerDay{teamDay: key, reviewerID: reviewerID}]++
+		}
+	}
+
+	teamKeys := slices.SortedFunc(maps.Keys(opened), func(a, b teamDay) int {
+		return cmp.Or(a.day.Compare(b.day), cmp.Compare(a.teamName, b.teamName))
+	})
+	for _, key := range teamKeys {
+		err := r.queries.UpsertTeamDailyStats(ctx, sqlc.UpsertTeamDailyStatsParams{
+			Day:       key.day,
+			TeamName:  key.teamName,
+			PrsOpened: opened[key],
+		})
+		if err != nil {
+			return fmt.Errorf("record opened PRs of %s: %w", key.teamName, err)
+		}
+	}
+
+	reviewerKeys := slices.SortedFunc(maps.Keys(assignments), func(a, b reviewerDay) int {
+		return cmp.Or(
+			a.day.Compare(b.day),
+			cmp.Compare(a.reviewerID, b.reviewerID),
+			cmp.Compare(a.teamName, b.teamName),
+		)
+	})
+	for _, key := range reviewerKeys {
+		err := r.queries.UpsertUserDailyStats(ctx, sqlc.UpsertUserDailyStatsParams{
+			Day:         key.day,
+			ReviewerID:  key.reviewerID,
+			TeamName:    key.teamName,
+			Assignments: assignments[key],
+		})
+		if err != nil {
+			return fmt.Errorf("record assignments of %s: %w", key.reviewerID, err)
+		}
+	}
+
+	return nil
+}
+
+// RecordPRMerged moves the PR from open to merged on the day it was created
+// and counts the merge, and the time each reviewer spent on it, on the day it
+// happened.
+func (r *StatsRepository) RecordPRMerged(ctx context.Context, pr *domain.PullRequest) error {
+	err := r.queries.UpsertTeamDailyStats(ctx, sqlc.UpsertTeamDailyStatsParams{
+		Day:             statsDay(pr.CreatedAt),
+		TeamName:        pr.TeamName,
+		PrsOpenedMerged: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("record merged PR: %w", err)
+	}
+
+	err = r.queries.UpsertTeamDailyStats(ctx, sqlc.UpsertTeamDailyStatsParams{
+		Day:       statsDay(pr.MergedAt),
+		TeamName:  pr.TeamName,
+		PrsMerged: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("record merge: %w", err)
+	}
+
+	if err := r.queries.RecordReviewTimes(ctx, pr.PullRequestID); err != nil {
+		return fmt.Errorf("record review times: %w", err)
+	}
+
+	return nil
+}
+
+// RecordReviewerReplaced must be called before the reviewer is replaced: it
+// reads the original assignment time to take the assignment back from the
+// right day.
+func (r *StatsRepository) RecordReviewerReplaced(ctx context.Context, prID, teamName, oldReviewerID, newReviewerID string) error {
+	assignedAt, err := r.queries.GetAssignedAt(ctx, sqlc.GetAssignedAtParams{
+		PrID:       prID,
+		ReviewerID: oldReviewerID,
+	})
+	if err != nil {
+		return fmt.Errorf("get assignment time: %w", err)
+	}
+
+	err = r.queries.UpsertUserDailyStats(ctx, sqlc.UpsertUserDailyStatsParams{
+		Day:         statsDay(&assignedAt),
+		ReviewerID:  oldReviewerID,
+		TeamName:    teamName,
+		Assignments: -1,
+	})
+	if err != nil {
+		return fmt.Errorf("record unassignment of %s: %w", oldReviewerID, err)
+	}
+
+	// ReplaceReviewer stamps the new assignment with the transaction time, so
+	// it is counted on that day, as a rebuild would count it.
+	assignedAt, err = r.queries.GetTransactionTime(ctx)
+	if err != nil {
+		return fmt.Errorf("get transaction time: %w", err)
+	}
+
+	err = r.queries.UpsertUserDailyStats(ctx, sqlc.UpsertUserDailyStatsParams{
+		Day:         statsDay(&assignedAt),
+		ReviewerID:  newReviewerID,
+		TeamName:    teamName,
+		Assignments: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("record assignment of %s: %w", newReviewerID, err)
+	}
+
+	return nil
+}
+
+// RebuildAggregates recomputes the daily aggregates from pull_requests and
+// assigned_reviewers. It should run inside a transaction.
+func (r *StatsRepository) RebuildAggregates(ctx context.Context) error {
+	if err := r.queries.DeleteUserDailyStats(ctx); err != nil {
+		return fmt.Errorf("clear user daily stats: %w", err)
+	}
+	if err := r.queries.DeleteTeamDailyStats(ctx); err != nil {
+		return fmt.Errorf("clear team daily stats: %w", err)
+	}
+	if err := r.queries.RebuildUserDailyStats(ctx); err != nil {
+		return fmt.Errorf("rebuild user daily stats: %w", err)
+	}
+	if err := r.queries.RebuildTeamDailyStats(ctx); err != nil {
+		return fmt.Errorf("rebuild team daily stats: %w", err)
+	}
+	if err := r.queries.MarkStatsRebuilt(ctx); err != nil {
+		return fmt.Errorf("mark stats rebuilt: %w", err)
+	}
+	return nil
+}
+
+func (r *StatsRepository) GetFreshness(ctx context.Context) (*domain.StatsFreshness, error) {
+	row, err := r.queries.GetStatsFreshness(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get stats freshness: %w", err)
+	}
+
+	return &domain.StatsFreshness{
+		UpdatedAt: row.UpdatedAt,
+		RebuiltAt: row.RebuiltAt,
+	}, nil
+}
+
+func (r *StatsRepository) GetAggregatedUserAssignmentStats(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	rows, err := r.queries.GetAggregatedUserAssignmentStats(ctx, sqlc.GetAggregatedUserAssignmentStatsParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get aggregated user assignment stats: %w", err)
+	}
+
+	result := make([]domain.UserAssignmentStats, len(rows))
+	for i, row := range rows {
+		result[i] = domain.UserAssignmentStats{
+			UserID:           row.UserID,
+			Username:         row.Username,
+			TeamName:         row.TeamName,
+			AssignmentsCount: row.AssignmentsCount,
+			ReviewsMerged:    row.ReviewsMerged,
+			MeanTimeInReview: secondsToDuration(row.MeanReviewSeconds),
+		}
+	}
+	return result, nil
+}
+
+func (r *StatsRepository) GetAggregatedPRStats(ctx context.Context, filter domain.StatsFilter) (*domain.PRStats, error) {
+	stats, err := r.queries.GetAggregatedPRStats(ctx, sqlc.GetAggregatedPRStatsParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get aggregated PR stats: %w", err)
+	}
+
+	return &domain.PRStats{
+		TotalPRs:  stats.TotalPrs,
+		OpenPRs:   stats.OpenPrs,
+		MergedPRs: stats.MergedPrs,
+	}, nil
+}
+
+func (r *StatsRepository) GetAggregatedTimeSeries(ctx context.Context, filter domain.StatsFilter, interval domain.StatsInterval) ([]domain.TimeSeriesPoint, error) {
+	rows, err := r.queries.GetAggregatedTimeSeries(ctx, sqlc.GetAggregatedTimeSeriesParams{
+		Interval: string(interval),
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get aggregated time series: %w", err)
+	}
+
+	result := make([]domain.TimeSeriesPoint, len(rows))
+	for i, row := range rows {
+		result[i] = domain.TimeSeriesPoint{
+			Bucket:      row.Bucket,
+			PRsOpened:   row.PrsOpened,
+			PRsMerged:   row.PrsMerged,
+			Assignments: row.Assignments,
+		}
+	}
+	return result, nil
+}
+
+func (r *StatsRepository) GetAggregatedTeamMemberAssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	rows, err := r.queries.GetAggregatedTeamMemberAssignmentCounts(ctx, sqlc.GetAggregatedTeamMemberAssignmentCountsParams{
+		From:     filter.From,
+		To:       filter.To,
+		TeamName: nullableString(filter.TeamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get aggregated team member assignment counts: %w", err)
+	}
+
+	result := make([]domain.UserAssignmentStats, len(rows))
+	for i, row := range rows {
+		result[i] = domain.UserAssignmentStats{
+			UserID:           row.UserID,
+			Username:         row.Username,
+			TeamName:         row.TeamName,
+			AssignmentsCount: row.AssignmentsCount,
+		}
+	}
+	return result, nil
+}
+
+// statsDay returns the UTC day of t, or of now when t is nil.
+func statsDay(t *time.Time) time.Time {
+	day := time.Now()
+	if t != nil {
+		day = *t
+	}
+	return day.UTC().Truncate(24 * time.Hour)
+}
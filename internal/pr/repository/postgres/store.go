@@ -7,8 +7,10 @@ import (
 	"log"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres/sqlc"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -19,10 +21,14 @@ type Store struct {
 	prRepo       *PRRepository
 	reviewerRepo *ReviewerRepository
 	statsRepo    *StatsRepository
+	snapshotRepo *SnapshotRepository
+	eventRepo    *EventRepository
+
+	idempotencyRepo *IdempotencyRepository
 }
 
 func NewStore(pool *pgxpool.Pool) *Store {
-	queries := sqlc.New(pool)
+	queries := sqlc.New(txAwareDB{pool: pool})
 
 	return &Store{
 		pool:         pool,
@@ -31,6 +37,10 @@ func NewStore(pool *pgxpool.Pool) *Store {
 		prRepo:       NewPRRepository(queries),
 		reviewerRepo: NewReviewerRepository(queries),
 		statsRepo:    NewStatsRepository(queries),
+		snapshotRepo: NewSnapshotRepository(queries),
+		eventRepo:    NewEventRepository(queries),
+
+		idempotencyRepo: NewIdempotencyRepository(queries),
 	}
 }
 
@@ -54,8 +64,41 @@ func (s *Store) Stats() repository.StatsRepository {
 	return s.statsRepo
 }
 
+func (s *Store) Snapshots() repository.SnapshotRepository {
+	return s.snapshotRepo
+}
+
+func (s *Store) Events() repository.EventRepository {
+	return s.eventRepo
+}
+
+// Idempotency is not part of the unit of work: stored responses are written
+// around a request, never inside its transaction.
+func (s *Store) Idempotency() repository.IdempotencyRepository {
+	return s.idempotencyRepo
+}
+
+// WithinTransaction runs fn in a transaction. A call made inside fn joins the
+// outer transaction instead of starting a new one.
 func (s *Store) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	tx, err := s.pool.Begin(ctx)
+	return s.withinTransaction(ctx, pgx.TxOptions{}, fn)
+}
+
+// WithinSnapshot runs fn in a read-only REPEATABLE READ transaction, so every
+// query in fn sees the database as of the same moment.
+func (s *Store) WithinSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.withinTransaction(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	}, fn)
+}
+
+func (s *Store) withinTransaction(ctx context.Context, opts pgx.TxOptions, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := s.pool.BeginTx(ctx, opts)
 	if err != nil {
 		return fmt.Errorf("begin transaction: %w", err)
 	}
@@ -73,6 +116,9 @@ func (s *Store) WithinTransaction(ctx context.Context, fn func(ctx context.Conte
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("commit transaction: %w", err)
 	}
+	if opts.AccessMode != pgx.ReadOnly {
+		usecase.RecordCommit(ctx)
+	}
 
 	return nil
 }
@@ -82,3 +128,33 @@ type txKey struct{}
 func injectTx(ctx context.Context, tx pgx.Tx) context.Context {
 	return context.WithValue(ctx, txKey{}, tx)
 }
+
+// txAwareDB runs queries in the transaction started by WithinTransaction when
+// the context carries one and on the pool otherwise, so repositories take
+// part in the transaction without knowing about it.
+type txAwareDB struct {
+	pool *pgxpool.Pool
+}
+
+func (db txAwareDB) conn(ctx context.Context) sqlc.DBTX {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return db.pool
+}
+
+func (db txAwareDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return db.conn(ctx).Exec(ctx, sql, args...)
+}
+
+func (db txAwareDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return db.conn(ctx).Query(ctx, sql, args...)
+}
+
+func (db txAwareDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return db.conn(ctx).QueryRow(ctx, sql, args...)
+}
+
+func (db txAwareDB) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return db.conn(ctx).CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
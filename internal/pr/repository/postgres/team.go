@@ -2,10 +2,13 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/repository/postgres/sqlc"
+	"github.com/jackc/pgx/v5"
 )
 
 type TeamRepository struct {
@@ -36,31 +39,187 @@ func (r *TeamRepository) TeamExists(ctx context.Context, teamName string) (bool,
 }
 
 func (r *TeamRepository) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
-	exists, err := r.TeamExists(ctx, teamName)
+	team, err := r.queries.GetTeam(ctx, teamName)
 	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return nil, domain.ErrTeamNotFound
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("get team: %w", err)
 	}
 
-	users, err := r.queries.GetUsersByTeam(ctx, teamName)
+	rows, err := r.queries.GetTeamMembers(ctx, teamName)
 	if err != nil {
 		return nil, fmt.Errorf("get team members: %w", err)
 	}
 
-	members := make([]domain.User, len(users))
-	for i, u := range users {
-		members[i] = domain.User{
-			UserID:   u.UserID,
-			Username: u.Username,
-			TeamName: u.TeamName,
-			IsActive: u.IsActive,
+	members := make([]domain.TeamMember, len(rows))
+	for i, m := range rows {
+		members[i] = domain.TeamMember{
+			User: domain.User{
+				UserID:   m.UserID,
+				Username: m.Username,
+				TeamName: m.TeamName,
+				IsActive: m.IsActive,
+			},
+			Role: domain.TeamRole(m.Role),
 		}
 	}
 
 	return &domain.Team{
-		TeamName: teamName,
-		Members:  members,
+		TeamName:       team.TeamName,
+		ParentTeamName: derefString(team.ParentTeamName),
+		Settings:       teamSettings(team),
+		Members:        members,
 	}, nil
 }
+
+// GetMembersByTeams returns the members of several teams at once, keyed by
+// team name. Teams without members are absent from the map.
+func (r *TeamRepository) GetMembersByTeams(ctx context.Context, teamNames []string) (map[string][]domain.TeamMember, error) {
+	rows, err := r.queries.ListTeamMembersByTeams(ctx, teamNames)
+	if err != nil {
+		return nil, fmt.Errorf("get members by teams: %w", err)
+	}
+
+	result := make(map[string][]domain.TeamMember)
+	for _, m := range rows {
+		result[m.MemberOf] = append(result[m.MemberOf], domain.TeamMember{
+			User: domain.User{
+				UserID:   m.UserID,
+				Username: m.Username,
+				TeamName: m.TeamName,
+				IsActive: m.IsActive,
+			},
+			Role: domain.TeamRole(m.Role),
+		})
+	}
+	return result, nil
+}
+
+func (r *TeamRepository) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	teams, err := r.queries.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list teams: %w", err)
+	}
+
+	result := make([]domain.Team, len(teams))
+	for i, t := range teams {
+		result[i] = domain.Team{
+			TeamName:       t.TeamName,
+			ParentTeamName: derefString(t.ParentTeamName),
+			Settings:       teamSettings(t),
+		}
+	}
+	return result, nil
+}
+
+func (r *TeamRepository) LockHierarchy(ctx context.Context) error {
+	if err := r.queries.LockTeamHierarchy(ctx); err != nil {
+		return fmt.Errorf("lock team hierarchy: %w", err)
+	}
+	return nil
+}
+
+func (r *TeamRepository) SetParentTeam(ctx context.Context, teamName, parentTeamName string) error {
+	updated, err := r.queries.SetParentTeam(ctx, sqlc.SetParentTeamParams{
+		TeamName:       teamName,
+		ParentTeamName: nullableString(parentTeamName),
+	})
+	if err != nil {
+		if isPgForeignKeyViolation(err) {
+			return domain.ErrParentTeamNotFound
+		}
+		return fmt.Errorf("set parent team: %w", err)
+	}
+	if updated == 0 {
+		return domain.ErrTeamNotFound
+	}
+	return nil
+}
+
+func (r *TeamRepository) GetTeamAncestors(ctx context.Context, teamName string) ([]string, error) {
+	ancestors, err := r.queries.GetTeamAncestors(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get team ancestors: %w", err)
+	}
+	return ancestors, nil
+}
+
+func (r *TeamRepository) AddMember(ctx context.Context, teamName, userID string, role domain.TeamRole) error {
+	err := r.queries.AddTeamMember(ctx, sqlc.AddTeamMemberParams{
+		TeamName: teamName,
+		UserID:   userID,
+		Role:     string(role),
+	})
+	if err != nil {
+		return fmt.Errorf("add team member: %w", err)
+	}
+	return nil
+}
+
+func (r *TeamRepository) IsMember(ctx context.Context, teamName, userID string) (bool, error) {
+	isMember, err := r.queries.IsTeamMember(ctx, sqlc.IsTeamMemberParams{
+		TeamName: teamName,
+		UserID:   userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("check team membership: %w", err)
+	}
+	return isMember, nil
+}
+
+func (r *TeamRepository) GetMemberRole(ctx context.Context, teamName, userID string) (domain.TeamRole, error) {
+	role, err := r.queries.GetTeamMemberRole(ctx, sqlc.GetTeamMemberRoleParams{
+		TeamName: teamName,
+		UserID:   userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrNotTeamMember
+		}
+		return "", fmt.Errorf("get team member role: %w", err)
+	}
+	return domain.TeamRole(role), nil
+}
+
+func (r *TeamRepository) CountLeads(ctx context.Context, teamName string) (int64, error) {
+	count, err := r.queries.CountTeamLeads(ctx, teamName)
+	if err != nil {
+		return 0, fmt.Errorf("count team leads: %w", err)
+	}
+	return count, nil
+}
+
+func (r *TeamRepository) GetTeamSettings(ctx context.Context, teamName string) (*domain.TeamSettings, error) {
+	team, err := r.queries.GetTeam(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("get team settings: %w", err)
+	}
+	settings := teamSettings(team)
+	return &settings, nil
+}
+
+func (r *TeamRepository) UpdateTeamSettings(ctx context.Context, teamName string, settings domain.TeamSettings) error {
+	updated, err := r.queries.UpdateTeamSettings(ctx, sqlc.UpdateTeamSettingsParams{
+		TeamName:          teamName,
+		RequireLeadReview: settings.RequireLeadReview,
+		StaleAfterSeconds: int64(settings.StaleAfter / time.Second),
+	})
+	if err != nil {
+		return fmt.Errorf("update team settings: %w", err)
+	}
+	if updated == 0 {
+		return domain.ErrTeamNotFound
+	}
+	return nil
+}
+
+func teamSettings(t sqlc.Team) domain.TeamSettings {
+	return domain.TeamSettings{
+		RequireLeadReview: t.RequireLeadReview,
+		StaleAfter:        time.Duration(t.StaleAfterSeconds) * time.Second,
+	}
+}
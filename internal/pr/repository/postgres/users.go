@@ -28,7 +28,6 @@ func (r *UserRepository) UpsertUser(ctx context.Context, user *domain.User) erro
 		err = r.queries.UpdateUser(ctx, sqlc.UpdateUserParams{
 			UserID:   user.UserID,
 			Username: user.Username,
-			TeamName: user.TeamName,
 			IsActive: user.IsActive,
 		})
 	} else {
@@ -63,6 +62,41 @@ func (r *UserRepository) GetUser(ctx context.Context, userID string) (*domain.Us
 	}, nil
 }
 
+func (r *UserRepository) GetUserForUpdate(ctx context.Context, userID string) (*domain.User, error) {
+	user, err := r.queries.GetUserForUpdate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("get user for update: %w", err)
+	}
+
+	return &domain.User{
+		UserID:   user.UserID,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	}, nil
+}
+
+func (r *UserRepository) GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	users, err := r.queries.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get users by ids: %w", err)
+	}
+
+	result := make([]domain.User, len(users))
+	for i, u := range users {
+		result[i] = domain.User{
+			UserID:   u.UserID,
+			Username: u.Username,
+			TeamName: u.TeamName,
+			IsActive: u.IsActive,
+		}
+	}
+	return result, nil
+}
+
 func (r *UserRepository) GetUsersByTeam(ctx context.Context, teamName string) ([]domain.User, error) {
 	users, err := r.queries.GetUsersByTeam(ctx, teamName)
 	if err != nil {
@@ -101,6 +135,34 @@ func (r *UserRepository) SetUserIsActive(ctx context.Context, userID string, isA
 	}, nil
 }
 
+func (r *UserRepository) UpdateUsername(ctx context.Context, userID, username string) (*domain.User, error) {
+	user, err := r.queries.UpdateUsername(ctx, sqlc.UpdateUsernameParams{
+		UserID:   userID,
+		Username: username,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("update username: %w", err)
+	}
+
+	return &domain.User{
+		UserID:   user.UserID,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	}, nil
+}
+
+func (r *UserRepository) ListUserTeams(ctx context.Context, userID string) ([]string, error) {
+	teams, err := r.queries.ListUserTeams(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list user teams: %w", err)
+	}
+	return teams, nil
+}
+
 func (r *UserRepository) UserExists(ctx context.Context, userID string) (bool, error) {
 	exists, err := r.queries.UserExists(ctx, userID)
 	if err != nil {
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type commitsKey struct{}
+
+// TrackCommits returns a context that remembers whether a transaction run
+// under it committed, and a function that reports it.
+func TrackCommits(ctx context.Context) (context.Context, func() bool) {
+	committed := &atomic.Bool{}
+	return context.WithValue(ctx, commitsKey{}, committed), committed.Load
+}
+
+// RecordCommit marks that a transaction run under ctx committed. Transactor
+// implementations call it after every commit that may have written data.
+func RecordCommit(ctx context.Context) {
+	if committed, ok := ctx.Value(commitsKey{}).(*atomic.Bool); ok {
+		committed.Store(true)
+	}
+}
@@ -2,28 +2,93 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 )
 
+// PRMetrics receives business events that cannot be derived from the
+// database afterwards.
+type PRMetrics interface {
+	ReviewerReassigned()
+	NoCandidate()
+}
+
 type PRUseCase interface {
 	CreatePR(ctx context.Context, req CreatePRRequest) (*domain.PullRequest, error)
+	BatchCreatePRs(ctx context.Context, reqs []CreatePRRequest) ([]BatchCreatePRResult, error)
 	MergePR(ctx context.Context, req MergePRRequest) (*domain.PullRequest, error)
+	GetPR(ctx context.Context, prID string) (*domain.PullRequest, error)
 	ReassignReviewer(ctx context.Context, req ReassignReviewerRequest) (*ReassignReviewerResponse, error)
 	GetReviewerPRs(ctx context.Context, reviewerID string) ([]domain.PullRequestShort, error)
+	FlagStalePRs(ctx context.Context, defaultAge time.Duration) ([]domain.PullRequestShort, error)
 }
 
 type TeamUseCase interface {
 	CreateTeam(ctx context.Context, req CreateTeamRequest) (*domain.Team, error)
 	GetTeam(ctx context.Context, teamName string) (*domain.Team, error)
+	AddMember(ctx context.Context, req AddTeamMemberRequest) (*domain.Team, error)
+	SetParentTeam(ctx context.Context, req SetParentTeamRequest) (*domain.Team, error)
+	UpdateSettings(ctx context.Context, req UpdateTeamSettingsRequest) (*domain.Team, error)
+	GetTeamTree(ctx context.Context, rootTeamName string) ([]domain.TeamTreeNode, error)
 }
 
 type UserUseCase interface {
 	SetIsActive(ctx context.Context, req SetUserIsActiveRequest) (*domain.User, error)
+	GetUserProfile(ctx context.Context, userID string) (*domain.UserProfile, error)
+	UpdateUser(ctx context.Context, req UpdateUserRequest) (*domain.User, error)
+	// PatchUser applies the username and active flag changes together, so
+	// either both are saved or neither is.
+	PatchUser(ctx context.Context, req PatchUserRequest) (*domain.User, error)
 }
 
 type StatsUseCase interface {
-	GetUserAssignmentStats(ctx context.Context) ([]domain.UserAssignmentStats, error)
-	GetPRStats(ctx context.Context) (*domain.PRStats, error)
-	GetReviewerWorkload(ctx context.Context) ([]domain.ReviewerWorkload, error)
+	GetUserAssignmentStats(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error)
+	GetPRStats(ctx context.Context, filter domain.StatsFilter) (*domain.PRStats, error)
+	GetReviewerWorkload(ctx context.Context, filter domain.StatsFilter, sort domain.WorkloadSort) ([]domain.ReviewerWorkload, error)
+	GetTeamRollupStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamRollupStats, error)
+	GetReviewerPairs(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerPair, error)
+	GetFairnessReport(ctx context.Context, filter domain.StatsFilter, thresholdPct float64) ([]domain.TeamFairness, error)
+	GetCycleTimeStats(ctx context.Context, filter domain.StatsFilter, buckets []time.Duration) (*domain.CycleTimeStats, error)
+	GetTimeSeries(ctx context.Context, filter domain.StatsFilter, interval domain.StatsInterval) ([]domain.TimeSeriesPoint, error)
+	GetStalePRs(ctx context.Context, filter domain.StatsFilter, olderThan time.Duration) ([]domain.StalePR, error)
+	GetFreshness(ctx context.Context, filter domain.StatsFilter) (*domain.StatsFreshness, error)
+	RebuildAggregates(ctx context.Context) error
+}
+
+type SnapshotUseCase interface {
+	Export(ctx context.Context) (*domain.Snapshot, error)
+	Import(ctx context.Context, snapshot *domain.Snapshot) error
+}
+
+// ReadUseCase serves read-only views such as the GraphQL API. The plural
+// methods look up many parents in one call so that nested data is fetched
+// level by level instead of once per parent; their maps are keyed by the
+// requested ids and miss the ids that have nothing to return.
+type ReadUseCase interface {
+	ListTeams(ctx context.Context) ([]domain.Team, error)
+	GetTeam(ctx context.Context, teamName string) (*domain.Team, error)
+	GetPR(ctx context.Context, prID string) (*domain.PullRequest, error)
+	GetUsers(ctx context.Context, userIDs []string) (map[string]*domain.User, error)
+	GetTeamMembers(ctx context.Context, teamNames []string) (map[string][]domain.TeamMember, error)
+	GetPRReviewers(ctx context.Context, prIDs []string) (map[string][]string, error)
+	GetReviewerPRs(ctx context.Context, reviewerIDs []string) (map[string][]domain.PullRequest, error)
+}
+
+// EventUseCase reads the event log that backs the /events stream.
+type EventUseCase interface {
+	// ListEvents returns up to domain.MaxEventPage events with ids greater
+	// than afterID that match filter, oldest first.
+	ListEvents(ctx context.Context, filter domain.EventFilter, afterID int64) ([]domain.Event, error)
+	LastEventID(ctx context.Context) (int64, error)
+}
+
+type IdempotencyUseCase interface {
+	// Begin reserves key for a request identified by requestHash. It returns
+	// nil when the request should run and the stored record when it already
+	// completed and its response should be replayed.
+	Begin(ctx context.Context, key, requestHash string) (*domain.IdempotencyRecord, error)
+	Complete(ctx context.Context, record *domain.IdempotencyRecord) error
+	Release(ctx context.Context, key string) error
+	PurgeExpired(ctx context.Context) (int64, error)
 }
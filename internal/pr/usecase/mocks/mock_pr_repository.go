@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -55,6 +56,35 @@ func (mr *MockPRRepositoryMockRecorder) CreatePR(ctx, pr any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePR", reflect.TypeOf((*MockPRRepository)(nil).CreatePR), ctx, pr)
 }
 
+// CreatePRs mocks base method.
+func (m *MockPRRepository) CreatePRs(ctx context.Context, prs []*domain.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePRs", ctx, prs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePRs indicates an expected call of CreatePRs.
+func (mr *MockPRRepositoryMockRecorder) CreatePRs(ctx, prs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePRs", reflect.TypeOf((*MockPRRepository)(nil).CreatePRs), ctx, prs)
+}
+
+// FlagStalePRs mocks base method.
+func (m *MockPRRepository) FlagStalePRs(ctx context.Context, defaultAge time.Duration) ([]domain.PullRequestShort, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlagStalePRs", ctx, defaultAge)
+	ret0, _ := ret[0].([]domain.PullRequestShort)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlagStalePRs indicates an expected call of FlagStalePRs.
+func (mr *MockPRRepositoryMockRecorder) FlagStalePRs(ctx, defaultAge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlagStalePRs", reflect.TypeOf((*MockPRRepository)(nil).FlagStalePRs), ctx, defaultAge)
+}
+
 // GetPR mocks base method.
 func (m *MockPRRepository) GetPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +115,21 @@ func (mr *MockPRRepositoryMockRecorder) GetPRAuthorID(ctx, prID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRAuthorID", reflect.TypeOf((*MockPRRepository)(nil).GetPRAuthorID), ctx, prID)
 }
 
+// GetPRForUpdate mocks base method.
+func (m *MockPRRepository) GetPRForUpdate(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPRForUpdate", ctx, prID)
+	ret0, _ := ret[0].(*domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPRForUpdate indicates an expected call of GetPRForUpdate.
+func (mr *MockPRRepositoryMockRecorder) GetPRForUpdate(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRForUpdate", reflect.TypeOf((*MockPRRepository)(nil).GetPRForUpdate), ctx, prID)
+}
+
 // GetPRWithReviewers mocks base method.
 func (m *MockPRRepository) GetPRWithReviewers(ctx context.Context, prID string) (*domain.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +145,51 @@ func (mr *MockPRRepositoryMockRecorder) GetPRWithReviewers(ctx, prID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRWithReviewers", reflect.TypeOf((*MockPRRepository)(nil).GetPRWithReviewers), ctx, prID)
 }
 
+// IncrementVersion mocks base method.
+func (m *MockPRRepository) IncrementVersion(ctx context.Context, prID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementVersion", ctx, prID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementVersion indicates an expected call of IncrementVersion.
+func (mr *MockPRRepositoryMockRecorder) IncrementVersion(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementVersion", reflect.TypeOf((*MockPRRepository)(nil).IncrementVersion), ctx, prID)
+}
+
+// ListExistingPRIDs mocks base method.
+func (m *MockPRRepository) ListExistingPRIDs(ctx context.Context, prIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExistingPRIDs", ctx, prIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExistingPRIDs indicates an expected call of ListExistingPRIDs.
+func (mr *MockPRRepositoryMockRecorder) ListExistingPRIDs(ctx, prIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExistingPRIDs", reflect.TypeOf((*MockPRRepository)(nil).ListExistingPRIDs), ctx, prIDs)
+}
+
+// ListOpenPRsByAuthor mocks base method.
+func (m *MockPRRepository) ListOpenPRsByAuthor(ctx context.Context, authorID string) ([]domain.PullRequestShort, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenPRsByAuthor", ctx, authorID)
+	ret0, _ := ret[0].([]domain.PullRequestShort)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenPRsByAuthor indicates an expected call of ListOpenPRsByAuthor.
+func (mr *MockPRRepositoryMockRecorder) ListOpenPRsByAuthor(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenPRsByAuthor", reflect.TypeOf((*MockPRRepository)(nil).ListOpenPRsByAuthor), ctx, authorID)
+}
+
 // MergePR mocks base method.
 func (m *MockPRRepository) MergePR(ctx context.Context, prID string) (*domain.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -129,3 +219,18 @@ func (mr *MockPRRepositoryMockRecorder) PRExists(ctx, prID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PRExists", reflect.TypeOf((*MockPRRepository)(nil).PRExists), ctx, prID)
 }
+
+// TransactionTime mocks base method.
+func (m *MockPRRepository) TransactionTime(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionTime", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionTime indicates an expected call of TransactionTime.
+func (mr *MockPRRepositoryMockRecorder) TransactionTime(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionTime", reflect.TypeOf((*MockPRRepository)(nil).TransactionTime), ctx)
+}
@@ -55,6 +55,21 @@ func (mr *MockReviewerRepositoryMockRecorder) AssignReviewer(ctx, prID, reviewer
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignReviewer", reflect.TypeOf((*MockReviewerRepository)(nil).AssignReviewer), ctx, prID, reviewerID)
 }
 
+// CountOpenReviews mocks base method.
+func (m *MockReviewerRepository) CountOpenReviews(ctx context.Context, reviewerID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenReviews", ctx, reviewerID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenReviews indicates an expected call of CountOpenReviews.
+func (mr *MockReviewerRepositoryMockRecorder) CountOpenReviews(ctx, reviewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenReviews", reflect.TypeOf((*MockReviewerRepository)(nil).CountOpenReviews), ctx, reviewerID)
+}
+
 // FindCandidatesForNewPR mocks base method.
 func (m *MockReviewerRepository) FindCandidatesForNewPR(ctx context.Context, teamName, authorID string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +100,21 @@ func (mr *MockReviewerRepositoryMockRecorder) FindCandidatesForReassignment(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCandidatesForReassignment", reflect.TypeOf((*MockReviewerRepository)(nil).FindCandidatesForReassignment), ctx, teamName, authorID, prID)
 }
 
+// FindLeadCandidatesForNewPR mocks base method.
+func (m *MockReviewerRepository) FindLeadCandidatesForNewPR(ctx context.Context, teamName, authorID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLeadCandidatesForNewPR", ctx, teamName, authorID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLeadCandidatesForNewPR indicates an expected call of FindLeadCandidatesForNewPR.
+func (mr *MockReviewerRepositoryMockRecorder) FindLeadCandidatesForNewPR(ctx, teamName, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeadCandidatesForNewPR", reflect.TypeOf((*MockReviewerRepository)(nil).FindLeadCandidatesForNewPR), ctx, teamName, authorID)
+}
+
 // GetAssignedReviewers mocks base method.
 func (m *MockReviewerRepository) GetAssignedReviewers(ctx context.Context, prID string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +130,21 @@ func (mr *MockReviewerRepositoryMockRecorder) GetAssignedReviewers(ctx, prID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedReviewers", reflect.TypeOf((*MockReviewerRepository)(nil).GetAssignedReviewers), ctx, prID)
 }
 
+// GetAssignedReviewersByPRs mocks base method.
+func (m *MockReviewerRepository) GetAssignedReviewersByPRs(ctx context.Context, prIDs []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedReviewersByPRs", ctx, prIDs)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignedReviewersByPRs indicates an expected call of GetAssignedReviewersByPRs.
+func (mr *MockReviewerRepositoryMockRecorder) GetAssignedReviewersByPRs(ctx, prIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedReviewersByPRs", reflect.TypeOf((*MockReviewerRepository)(nil).GetAssignedReviewersByPRs), ctx, prIDs)
+}
+
 // IsReviewerAssigned mocks base method.
 func (m *MockReviewerRepository) IsReviewerAssigned(ctx context.Context, prID, reviewerID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +175,36 @@ func (mr *MockReviewerRepositoryMockRecorder) ListPRsByReviewer(ctx, reviewerID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPRsByReviewer", reflect.TypeOf((*MockReviewerRepository)(nil).ListPRsByReviewer), ctx, reviewerID)
 }
 
+// ListPRsByReviewers mocks base method.
+func (m *MockReviewerRepository) ListPRsByReviewers(ctx context.Context, reviewerIDs []string) (map[string][]domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPRsByReviewers", ctx, reviewerIDs)
+	ret0, _ := ret[0].(map[string][]domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPRsByReviewers indicates an expected call of ListPRsByReviewers.
+func (mr *MockReviewerRepositoryMockRecorder) ListPRsByReviewers(ctx, reviewerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPRsByReviewers", reflect.TypeOf((*MockReviewerRepository)(nil).ListPRsByReviewers), ctx, reviewerIDs)
+}
+
+// ListReviewerCandidates mocks base method.
+func (m *MockReviewerRepository) ListReviewerCandidates(ctx context.Context, teamName string) ([]domain.ReviewerCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewerCandidates", ctx, teamName)
+	ret0, _ := ret[0].([]domain.ReviewerCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviewerCandidates indicates an expected call of ListReviewerCandidates.
+func (mr *MockReviewerRepositoryMockRecorder) ListReviewerCandidates(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewerCandidates", reflect.TypeOf((*MockReviewerRepository)(nil).ListReviewerCandidates), ctx, teamName)
+}
+
 // ReplaceReviewer mocks base method.
 func (m *MockReviewerRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
 	m.ctrl.T.Helper()
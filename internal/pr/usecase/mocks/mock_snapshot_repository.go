@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository (interfaces: SnapshotRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mocks/mock_snapshot_repository.go -package=mocks github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository SnapshotRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSnapshotRepository is a mock of SnapshotRepository interface.
+type MockSnapshotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSnapshotRepositoryMockRecorder is the mock recorder for MockSnapshotRepository.
+type MockSnapshotRepositoryMockRecorder struct {
+	mock *MockSnapshotRepository
+}
+
+// NewMockSnapshotRepository creates a new mock instance.
+func NewMockSnapshotRepository(ctrl *gomock.Controller) *MockSnapshotRepository {
+	mock := &MockSnapshotRepository{ctrl: ctrl}
+	mock.recorder = &MockSnapshotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotRepository) EXPECT() *MockSnapshotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// HasData mocks base method.
+func (m *MockSnapshotRepository) HasData(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasData", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasData indicates an expected call of HasData.
+func (mr *MockSnapshotRepositoryMockRecorder) HasData(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasData", reflect.TypeOf((*MockSnapshotRepository)(nil).HasData), ctx)
+}
+
+// ListMemberships mocks base method.
+func (m *MockSnapshotRepository) ListMemberships(ctx context.Context) ([]domain.TeamMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberships", ctx)
+	ret0, _ := ret[0].([]domain.TeamMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberships indicates an expected call of ListMemberships.
+func (mr *MockSnapshotRepositoryMockRecorder) ListMemberships(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberships", reflect.TypeOf((*MockSnapshotRepository)(nil).ListMemberships), ctx)
+}
+
+// ListPullRequests mocks base method.
+func (m *MockSnapshotRepository) ListPullRequests(ctx context.Context) ([]domain.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequests", ctx)
+	ret0, _ := ret[0].([]domain.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequests indicates an expected call of ListPullRequests.
+func (mr *MockSnapshotRepositoryMockRecorder) ListPullRequests(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequests", reflect.TypeOf((*MockSnapshotRepository)(nil).ListPullRequests), ctx)
+}
+
+// ListTeams mocks base method.
+func (m *MockSnapshotRepository) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeams", ctx)
+	ret0, _ := ret[0].([]domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeams indicates an expected call of ListTeams.
+func (mr *MockSnapshotRepositoryMockRecorder) ListTeams(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockSnapshotRepository)(nil).ListTeams), ctx)
+}
+
+// ListUsers mocks base method.
+func (m *MockSnapshotRepository) ListUsers(ctx context.Context) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockSnapshotRepositoryMockRecorder) ListUsers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockSnapshotRepository)(nil).ListUsers), ctx)
+}
+
+// RestorePullRequest mocks base method.
+func (m *MockSnapshotRepository) RestorePullRequest(ctx context.Context, pr *domain.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePullRequest", ctx, pr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestorePullRequest indicates an expected call of RestorePullRequest.
+func (mr *MockSnapshotRepositoryMockRecorder) RestorePullRequest(ctx, pr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePullRequest", reflect.TypeOf((*MockSnapshotRepository)(nil).RestorePullRequest), ctx, pr)
+}
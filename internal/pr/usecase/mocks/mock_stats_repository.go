@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -41,47 +42,327 @@ func (m *MockStatsRepository) EXPECT() *MockStatsRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetAggregatedPRStats mocks base method.
+func (m *MockStatsRepository) GetAggregatedPRStats(ctx context.Context, filter domain.StatsFilter) (*domain.PRStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregatedPRStats", ctx, filter)
+	ret0, _ := ret[0].(*domain.PRStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregatedPRStats indicates an expected call of GetAggregatedPRStats.
+func (mr *MockStatsRepositoryMockRecorder) GetAggregatedPRStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregatedPRStats", reflect.TypeOf((*MockStatsRepository)(nil).GetAggregatedPRStats), ctx, filter)
+}
+
+// GetAggregatedTeamMemberAssignmentCounts mocks base method.
+func (m *MockStatsRepository) GetAggregatedTeamMemberAssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregatedTeamMemberAssignmentCounts", ctx, filter)
+	ret0, _ := ret[0].([]domain.UserAssignmentStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregatedTeamMemberAssignmentCounts indicates an expected call of GetAggregatedTeamMemberAssignmentCounts.
+func (mr *MockStatsRepositoryMockRecorder) GetAggregatedTeamMemberAssignmentCounts(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregatedTeamMemberAssignmentCounts", reflect.TypeOf((*MockStatsRepository)(nil).GetAggregatedTeamMemberAssignmentCounts), ctx, filter)
+}
+
+// GetAggregatedTimeSeries mocks base method.
+func (m *MockStatsRepository) GetAggregatedTimeSeries(ctx context.Context, filter domain.StatsFilter, interval domain.StatsInterval) ([]domain.TimeSeriesPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregatedTimeSeries", ctx, filter, interval)
+	ret0, _ := ret[0].([]domain.TimeSeriesPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregatedTimeSeries indicates an expected call of GetAggregatedTimeSeries.
+func (mr *MockStatsRepositoryMockRecorder) GetAggregatedTimeSeries(ctx, filter, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregatedTimeSeries", reflect.TypeOf((*MockStatsRepository)(nil).GetAggregatedTimeSeries), ctx, filter, interval)
+}
+
+// GetAggregatedUserAssignmentStats mocks base method.
+func (m *MockStatsRepository) GetAggregatedUserAssignmentStats(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregatedUserAssignmentStats", ctx, filter)
+	ret0, _ := ret[0].([]domain.UserAssignmentStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregatedUserAssignmentStats indicates an expected call of GetAggregatedUserAssignmentStats.
+func (mr *MockStatsRepositoryMockRecorder) GetAggregatedUserAssignmentStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregatedUserAssignmentStats", reflect.TypeOf((*MockStatsRepository)(nil).GetAggregatedUserAssignmentStats), ctx, filter)
+}
+
+// GetAuthorCycleTimes mocks base method.
+func (m *MockStatsRepository) GetAuthorCycleTimes(ctx context.Context, filter domain.StatsFilter) ([]domain.AuthorCycleTime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorCycleTimes", ctx, filter)
+	ret0, _ := ret[0].([]domain.AuthorCycleTime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorCycleTimes indicates an expected call of GetAuthorCycleTimes.
+func (mr *MockStatsRepositoryMockRecorder) GetAuthorCycleTimes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorCycleTimes", reflect.TypeOf((*MockStatsRepository)(nil).GetAuthorCycleTimes), ctx, filter)
+}
+
+// GetCycleTimeHistogram mocks base method.
+func (m *MockStatsRepository) GetCycleTimeHistogram(ctx context.Context, filter domain.StatsFilter, bounds []time.Duration) ([]domain.CycleTimeBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCycleTimeHistogram", ctx, filter, bounds)
+	ret0, _ := ret[0].([]domain.CycleTimeBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCycleTimeHistogram indicates an expected call of GetCycleTimeHistogram.
+func (mr *MockStatsRepositoryMockRecorder) GetCycleTimeHistogram(ctx, filter, bounds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCycleTimeHistogram", reflect.TypeOf((*MockStatsRepository)(nil).GetCycleTimeHistogram), ctx, filter, bounds)
+}
+
+// GetFreshness mocks base method.
+func (m *MockStatsRepository) GetFreshness(ctx context.Context) (*domain.StatsFreshness, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreshness", ctx)
+	ret0, _ := ret[0].(*domain.StatsFreshness)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFreshness indicates an expected call of GetFreshness.
+func (mr *MockStatsRepositoryMockRecorder) GetFreshness(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreshness", reflect.TypeOf((*MockStatsRepository)(nil).GetFreshness), ctx)
+}
+
 // GetPRStats mocks base method.
-func (m *MockStatsRepository) GetPRStats(ctx context.Context) (*domain.PRStats, error) {
+func (m *MockStatsRepository) GetPRStats(ctx context.Context, filter domain.StatsFilter) (*domain.PRStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPRStats", ctx)
+	ret := m.ctrl.Call(m, "GetPRStats", ctx, filter)
 	ret0, _ := ret[0].(*domain.PRStats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPRStats indicates an expected call of GetPRStats.
-func (mr *MockStatsRepositoryMockRecorder) GetPRStats(ctx any) *gomock.Call {
+func (mr *MockStatsRepositoryMockRecorder) GetPRStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRStats", reflect.TypeOf((*MockStatsRepository)(nil).GetPRStats), ctx, filter)
+}
+
+// GetReviewerPairs mocks base method.
+func (m *MockStatsRepository) GetReviewerPairs(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewerPairs", ctx, filter)
+	ret0, _ := ret[0].([]domain.ReviewerPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewerPairs indicates an expected call of GetReviewerPairs.
+func (mr *MockStatsRepositoryMockRecorder) GetReviewerPairs(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRStats", reflect.TypeOf((*MockStatsRepository)(nil).GetPRStats), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewerPairs", reflect.TypeOf((*MockStatsRepository)(nil).GetReviewerPairs), ctx, filter)
 }
 
 // GetReviewerWorkload mocks base method.
-func (m *MockStatsRepository) GetReviewerWorkload(ctx context.Context) ([]domain.ReviewerWorkload, error) {
+func (m *MockStatsRepository) GetReviewerWorkload(ctx context.Context, filter domain.StatsFilter, sort domain.WorkloadSort) ([]domain.ReviewerWorkload, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReviewerWorkload", ctx)
+	ret := m.ctrl.Call(m, "GetReviewerWorkload", ctx, filter, sort)
 	ret0, _ := ret[0].([]domain.ReviewerWorkload)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReviewerWorkload indicates an expected call of GetReviewerWorkload.
-func (mr *MockStatsRepositoryMockRecorder) GetReviewerWorkload(ctx any) *gomock.Call {
+func (mr *MockStatsRepositoryMockRecorder) GetReviewerWorkload(ctx, filter, sort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewerWorkload", reflect.TypeOf((*MockStatsRepository)(nil).GetReviewerWorkload), ctx, filter, sort)
+}
+
+// GetStalePRs mocks base method.
+func (m *MockStatsRepository) GetStalePRs(ctx context.Context, filter domain.StatsFilter, olderThan, defaultAge time.Duration) ([]domain.StalePR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStalePRs", ctx, filter, olderThan, defaultAge)
+	ret0, _ := ret[0].([]domain.StalePR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStalePRs indicates an expected call of GetStalePRs.
+func (mr *MockStatsRepositoryMockRecorder) GetStalePRs(ctx, filter, olderThan, defaultAge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStalePRs", reflect.TypeOf((*MockStatsRepository)(nil).GetStalePRs), ctx, filter, olderThan, defaultAge)
+}
+
+// GetTeamCycleTimes mocks base method.
+func (m *MockStatsRepository) GetTeamCycleTimes(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamCycleTime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamCycleTimes", ctx, filter)
+	ret0, _ := ret[0].([]domain.TeamCycleTime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamCycleTimes indicates an expected call of GetTeamCycleTimes.
+func (mr *MockStatsRepositoryMockRecorder) GetTeamCycleTimes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamCycleTimes", reflect.TypeOf((*MockStatsRepository)(nil).GetTeamCycleTimes), ctx, filter)
+}
+
+// GetTeamMemberAssignmentCounts mocks base method.
+func (m *MockStatsRepository) GetTeamMemberAssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamMemberAssignmentCounts", ctx, filter)
+	ret0, _ := ret[0].([]domain.UserAssignmentStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamMemberAssignmentCounts indicates an expected call of GetTeamMemberAssignmentCounts.
+func (mr *MockStatsRepositoryMockRecorder) GetTeamMemberAssignmentCounts(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewerWorkload", reflect.TypeOf((*MockStatsRepository)(nil).GetReviewerWorkload), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamMemberAssignmentCounts", reflect.TypeOf((*MockStatsRepository)(nil).GetTeamMemberAssignmentCounts), ctx, filter)
+}
+
+// GetTeamOpenWorkload mocks base method.
+func (m *MockStatsRepository) GetTeamOpenWorkload(ctx context.Context) ([]domain.TeamOpenWorkload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamOpenWorkload", ctx)
+	ret0, _ := ret[0].([]domain.TeamOpenWorkload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamOpenWorkload indicates an expected call of GetTeamOpenWorkload.
+func (mr *MockStatsRepositoryMockRecorder) GetTeamOpenWorkload(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamOpenWorkload", reflect.TypeOf((*MockStatsRepository)(nil).GetTeamOpenWorkload), ctx)
+}
+
+// GetTeamRollupStats mocks base method.
+func (m *MockStatsRepository) GetTeamRollupStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamRollupStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamRollupStats", ctx, filter)
+	ret0, _ := ret[0].([]domain.TeamRollupStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamRollupStats indicates an expected call of GetTeamRollupStats.
+func (mr *MockStatsRepositoryMockRecorder) GetTeamRollupStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamRollupStats", reflect.TypeOf((*MockStatsRepository)(nil).GetTeamRollupStats), ctx, filter)
+}
+
+// GetTimeSeries mocks base method.
+func (m *MockStatsRepository) GetTimeSeries(ctx context.Context, filter domain.StatsFilter, interval domain.StatsInterval) ([]domain.TimeSeriesPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeSeries", ctx, filter, interval)
+	ret0, _ := ret[0].([]domain.TimeSeriesPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeSeries indicates an expected call of GetTimeSeries.
+func (mr *MockStatsRepositoryMockRecorder) GetTimeSeries(ctx, filter, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeSeries", reflect.TypeOf((*MockStatsRepository)(nil).GetTimeSeries), ctx, filter, interval)
 }
 
 // GetUserAssignmentStats mocks base method.
-func (m *MockStatsRepository) GetUserAssignmentStats(ctx context.Context) ([]domain.UserAssignmentStats, error) {
+func (m *MockStatsRepository) GetUserAssignmentStats(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserAssignmentStats", ctx)
+	ret := m.ctrl.Call(m, "GetUserAssignmentStats", ctx, filter)
 	ret0, _ := ret[0].([]domain.UserAssignmentStats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserAssignmentStats indicates an expected call of GetUserAssignmentStats.
-func (mr *MockStatsRepositoryMockRecorder) GetUserAssignmentStats(ctx any) *gomock.Call {
+func (mr *MockStatsRepositoryMockRecorder) GetUserAssignmentStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAssignmentStats", reflect.TypeOf((*MockStatsRepository)(nil).GetUserAssignmentStats), ctx, filter)
+}
+
+// RebuildAggregates mocks base method.
+func (m *MockStatsRepository) RebuildAggregates(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildAggregates", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildAggregates indicates an expected call of RebuildAggregates.
+func (mr *MockStatsRepositoryMockRecorder) RebuildAggregates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildAggregates", reflect.TypeOf((*MockStatsRepository)(nil).RebuildAggregates), ctx)
+}
+
+// RecordPRCreated mocks base method.
+func (m *MockStatsRepository) RecordPRCreated(ctx context.Context, pr *domain.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPRCreated", ctx, pr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPRCreated indicates an expected call of RecordPRCreated.
+func (mr *MockStatsRepositoryMockRecorder) RecordPRCreated(ctx, pr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPRCreated", reflect.TypeOf((*MockStatsRepository)(nil).RecordPRCreated), ctx, pr)
+}
+
+// RecordPRMerged mocks base method.
+func (m *MockStatsRepository) RecordPRMerged(ctx context.Context, pr *domain.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPRMerged", ctx, pr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPRMerged indicates an expected call of RecordPRMerged.
+func (mr *MockStatsRepositoryMockRecorder) RecordPRMerged(ctx, pr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPRMerged", reflect.TypeOf((*MockStatsRepository)(nil).RecordPRMerged), ctx, pr)
+}
+
+// RecordPRsCreated mocks base method.
+func (m *MockStatsRepository) RecordPRsCreated(ctx context.Context, prs []*domain.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPRsCreated", ctx, prs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPRsCreated indicates an expected call of RecordPRsCreated.
+func (mr *MockStatsRepositoryMockRecorder) RecordPRsCreated(ctx, prs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPRsCreated", reflect.TypeOf((*MockStatsRepository)(nil).RecordPRsCreated), ctx, prs)
+}
+
+// RecordReviewerReplaced mocks base method.
+func (m *MockStatsRepository) RecordReviewerReplaced(ctx context.Context, prID, teamName, oldReviewerID, newReviewerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordReviewerReplaced", ctx, prID, teamName, oldReviewerID, newReviewerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordReviewerReplaced indicates an expected call of RecordReviewerReplaced.
+func (mr *MockStatsRepositoryMockRecorder) RecordReviewerReplaced(ctx, prID, teamName, oldReviewerID, newReviewerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAssignmentStats", reflect.TypeOf((*MockStatsRepository)(nil).GetUserAssignmentStats), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordReviewerReplaced", reflect.TypeOf((*MockStatsRepository)(nil).RecordReviewerReplaced), ctx, prID, teamName, oldReviewerID, newReviewerID)
 }
@@ -41,6 +41,35 @@ func (m *MockTeamRepository) EXPECT() *MockTeamRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddMember mocks base method.
+func (m *MockTeamRepository) AddMember(ctx context.Context, teamName, userID string, role domain.TeamRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, teamName, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockTeamRepositoryMockRecorder) AddMember(ctx, teamName, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockTeamRepository)(nil).AddMember), ctx, teamName, userID, role)
+}
+
+// CountLeads mocks base method.
+func (m *MockTeamRepository) CountLeads(ctx context.Context, teamName string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLeads", ctx, teamName)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLeads indicates an expected call of CountLeads.
+func (mr *MockTeamRepositoryMockRecorder) CountLeads(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLeads", reflect.TypeOf((*MockTeamRepository)(nil).CountLeads), ctx, teamName)
+}
+
 // CreateTeam mocks base method.
 func (m *MockTeamRepository) CreateTeam(ctx context.Context, teamName string) error {
 	m.ctrl.T.Helper()
@@ -55,6 +84,36 @@ func (mr *MockTeamRepositoryMockRecorder) CreateTeam(ctx, teamName any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockTeamRepository)(nil).CreateTeam), ctx, teamName)
 }
 
+// GetMemberRole mocks base method.
+func (m *MockTeamRepository) GetMemberRole(ctx context.Context, teamName, userID string) (domain.TeamRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberRole", ctx, teamName, userID)
+	ret0, _ := ret[0].(domain.TeamRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberRole indicates an expected call of GetMemberRole.
+func (mr *MockTeamRepositoryMockRecorder) GetMemberRole(ctx, teamName, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberRole", reflect.TypeOf((*MockTeamRepository)(nil).GetMemberRole), ctx, teamName, userID)
+}
+
+// GetMembersByTeams mocks base method.
+func (m *MockTeamRepository) GetMembersByTeams(ctx context.Context, teamNames []string) (map[string][]domain.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembersByTeams", ctx, teamNames)
+	ret0, _ := ret[0].(map[string][]domain.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembersByTeams indicates an expected call of GetMembersByTeams.
+func (mr *MockTeamRepositoryMockRecorder) GetMembersByTeams(ctx, teamNames any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersByTeams", reflect.TypeOf((*MockTeamRepository)(nil).GetMembersByTeams), ctx, teamNames)
+}
+
 // GetTeam mocks base method.
 func (m *MockTeamRepository) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +129,94 @@ func (mr *MockTeamRepositoryMockRecorder) GetTeam(ctx, teamName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockTeamRepository)(nil).GetTeam), ctx, teamName)
 }
 
+// GetTeamAncestors mocks base method.
+func (m *MockTeamRepository) GetTeamAncestors(ctx context.Context, teamName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamAncestors", ctx, teamName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamAncestors indicates an expected call of GetTeamAncestors.
+func (mr *MockTeamRepositoryMockRecorder) GetTeamAncestors(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamAncestors", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamAncestors), ctx, teamName)
+}
+
+// GetTeamSettings mocks base method.
+func (m *MockTeamRepository) GetTeamSettings(ctx context.Context, teamName string) (*domain.TeamSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamSettings", ctx, teamName)
+	ret0, _ := ret[0].(*domain.TeamSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamSettings indicates an expected call of GetTeamSettings.
+func (mr *MockTeamRepositoryMockRecorder) GetTeamSettings(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamSettings", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamSettings), ctx, teamName)
+}
+
+// IsMember mocks base method.
+func (m *MockTeamRepository) IsMember(ctx context.Context, teamName, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMember", ctx, teamName, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsMember indicates an expected call of IsMember.
+func (mr *MockTeamRepositoryMockRecorder) IsMember(ctx, teamName, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMember", reflect.TypeOf((*MockTeamRepository)(nil).IsMember), ctx, teamName, userID)
+}
+
+// ListTeams mocks base method.
+func (m *MockTeamRepository) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeams", ctx)
+	ret0, _ := ret[0].([]domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeams indicates an expected call of ListTeams.
+func (mr *MockTeamRepositoryMockRecorder) ListTeams(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockTeamRepository)(nil).ListTeams), ctx)
+}
+
+// LockHierarchy mocks base method.
+func (m *MockTeamRepository) LockHierarchy(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockHierarchy", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockHierarchy indicates an expected call of LockHierarchy.
+func (mr *MockTeamRepositoryMockRecorder) LockHierarchy(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockHierarchy", reflect.TypeOf((*MockTeamRepository)(nil).LockHierarchy), ctx)
+}
+
+// SetParentTeam mocks base method.
+func (m *MockTeamRepository) SetParentTeam(ctx context.Context, teamName, parentTeamName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetParentTeam", ctx, teamName, parentTeamName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetParentTeam indicates an expected call of SetParentTeam.
+func (mr *MockTeamRepositoryMockRecorder) SetParentTeam(ctx, teamName, parentTeamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParentTeam", reflect.TypeOf((*MockTeamRepository)(nil).SetParentTeam), ctx, teamName, parentTeamName)
+}
+
 // TeamExists mocks base method.
 func (m *MockTeamRepository) TeamExists(ctx context.Context, teamName string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -84,3 +231,17 @@ func (mr *MockTeamRepositoryMockRecorder) TeamExists(ctx, teamName any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TeamExists", reflect.TypeOf((*MockTeamRepository)(nil).TeamExists), ctx, teamName)
 }
+
+// UpdateTeamSettings mocks base method.
+func (m *MockTeamRepository) UpdateTeamSettings(ctx context.Context, teamName string, settings domain.TeamSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamSettings", ctx, teamName, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamSettings indicates an expected call of UpdateTeamSettings.
+func (mr *MockTeamRepositoryMockRecorder) UpdateTeamSettings(ctx, teamName, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamSettings", reflect.TypeOf((*MockTeamRepository)(nil).UpdateTeamSettings), ctx, teamName, settings)
+}
@@ -41,6 +41,20 @@ func (m *MockUnitOfWork) EXPECT() *MockUnitOfWorkMockRecorder {
 	return m.recorder
 }
 
+// Events mocks base method.
+func (m *MockUnitOfWork) Events() repository.EventRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events")
+	ret0, _ := ret[0].(repository.EventRepository)
+	return ret0
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockUnitOfWorkMockRecorder) Events() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockUnitOfWork)(nil).Events))
+}
+
 // PullRequests mocks base method.
 func (m *MockUnitOfWork) PullRequests() repository.PRRepository {
 	m.ctrl.T.Helper()
@@ -69,6 +83,20 @@ func (mr *MockUnitOfWorkMockRecorder) Reviewers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reviewers", reflect.TypeOf((*MockUnitOfWork)(nil).Reviewers))
 }
 
+// Snapshots mocks base method.
+func (m *MockUnitOfWork) Snapshots() repository.SnapshotRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshots")
+	ret0, _ := ret[0].(repository.SnapshotRepository)
+	return ret0
+}
+
+// Snapshots indicates an expected call of Snapshots.
+func (mr *MockUnitOfWorkMockRecorder) Snapshots() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockUnitOfWork)(nil).Snapshots))
+}
+
 // Stats mocks base method.
 func (m *MockUnitOfWork) Stats() repository.StatsRepository {
 	m.ctrl.T.Helper()
@@ -111,6 +139,20 @@ func (mr *MockUnitOfWorkMockRecorder) Users() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockUnitOfWork)(nil).Users))
 }
 
+// WithinSnapshot mocks base method.
+func (m *MockUnitOfWork) WithinSnapshot(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinSnapshot", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinSnapshot indicates an expected call of WithinSnapshot.
+func (mr *MockUnitOfWorkMockRecorder) WithinSnapshot(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinSnapshot", reflect.TypeOf((*MockUnitOfWork)(nil).WithinSnapshot), ctx, fn)
+}
+
 // WithinTransaction mocks base method.
 func (m *MockUnitOfWork) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
 	m.ctrl.T.Helper()
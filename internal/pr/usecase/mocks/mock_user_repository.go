@@ -56,6 +56,36 @@ func (mr *MockUserRepositoryMockRecorder) GetUser(ctx, userID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserRepository)(nil).GetUser), ctx, userID)
 }
 
+// GetUserForUpdate mocks base method.
+func (m *MockUserRepository) GetUserForUpdate(ctx context.Context, userID string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserForUpdate", ctx, userID)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserForUpdate indicates an expected call of GetUserForUpdate.
+func (mr *MockUserRepositoryMockRecorder) GetUserForUpdate(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserForUpdate", reflect.TypeOf((*MockUserRepository)(nil).GetUserForUpdate), ctx, userID)
+}
+
+// GetUsersByIDs mocks base method.
+func (m *MockUserRepository) GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, userIDs)
+	ret0, _ := ret[0].([]domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUserRepositoryMockRecorder) GetUsersByIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserRepository)(nil).GetUsersByIDs), ctx, userIDs)
+}
+
 // GetUsersByTeam mocks base method.
 func (m *MockUserRepository) GetUsersByTeam(ctx context.Context, teamName string) ([]domain.User, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +101,21 @@ func (mr *MockUserRepositoryMockRecorder) GetUsersByTeam(ctx, teamName any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByTeam", reflect.TypeOf((*MockUserRepository)(nil).GetUsersByTeam), ctx, teamName)
 }
 
+// ListUserTeams mocks base method.
+func (m *MockUserRepository) ListUserTeams(ctx context.Context, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserTeams", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserTeams indicates an expected call of ListUserTeams.
+func (mr *MockUserRepositoryMockRecorder) ListUserTeams(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserTeams", reflect.TypeOf((*MockUserRepository)(nil).ListUserTeams), ctx, userID)
+}
+
 // SetUserIsActive mocks base method.
 func (m *MockUserRepository) SetUserIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +131,21 @@ func (mr *MockUserRepositoryMockRecorder) SetUserIsActive(ctx, userID, isActive
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserIsActive", reflect.TypeOf((*MockUserRepository)(nil).SetUserIsActive), ctx, userID, isActive)
 }
 
+// UpdateUsername mocks base method.
+func (m *MockUserRepository) UpdateUsername(ctx context.Context, userID, username string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsername", ctx, userID, username)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUsername indicates an expected call of UpdateUsername.
+func (mr *MockUserRepositoryMockRecorder) UpdateUsername(ctx, userID, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsername", reflect.TypeOf((*MockUserRepository)(nil).UpdateUsername), ctx, userID, username)
+}
+
 // UpsertUser mocks base method.
 func (m *MockUserRepository) UpsertUser(ctx context.Context, user *domain.User) error {
 	m.ctrl.T.Helper()
@@ -6,15 +6,27 @@ type CreatePRRequest struct {
 	PullRequestID   string
 	PullRequestName string
 	AuthorID        string
+	TeamName        string
 }
 
+// BatchCreatePRResult holds either the created PR or the error that kept the
+// item out of the batch.
+type BatchCreatePRResult struct {
+	PullRequest *domain.PullRequest
+	Err         error
+}
+
+// ExpectedVersion, when non-zero, must match the current version of the PR or
+// the change fails with domain.ErrPRVersionMismatch.
 type MergePRRequest struct {
-	PullRequestID string
+	PullRequestID   string
+	ExpectedVersion int64
 }
 
 type ReassignReviewerRequest struct {
-	PullRequestID string
-	OldReviewerID string
+	PullRequestID   string
+	OldReviewerID   string
+	ExpectedVersion int64
 }
 
 type ReassignReviewerResponse struct {
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+//go:generate mockgen -destination=../mocks/mock_event_repository.go -package=mocks github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository EventRepository
+type EventRepository interface {
+	// RecordEvents appends events to the log. Inside a transaction it holds
+	// a lock on the log until commit, so ids become visible in order and a
+	// reader that has seen an id never misses a smaller one later.
+	RecordEvents(ctx context.Context, events []domain.Event) error
+	// ListEvents returns up to limit events with ids greater than afterID,
+	// oldest first.
+	ListEvents(ctx context.Context, filter domain.EventFilter, afterID int64, limit int) ([]domain.Event, error)
+	LastEventID(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+//go:generate mockgen -destination=../mocks/mock_idempotency_repository.go -package=mocks github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository IdempotencyRepository
+type IdempotencyRepository interface {
+	// Reserve claims key for a new request for the length of lease and reports
+	// whether it succeeded; it fails while an unexpired record for the key
+	// exists.
+	Reserve(ctx context.Context, key, requestHash string, lease time.Duration) (bool, error)
+	Get(ctx context.Context, key string) (*domain.IdempotencyRecord, error)
+	// Complete stores the response for key and keeps it for ttl.
+	Complete(ctx context.Context, record *domain.IdempotencyRecord, ttl time.Duration) error
+	Release(ctx context.Context, key string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 )
@@ -9,11 +10,20 @@ import (
 //go:generate mockgen -destination=../mocks/mock_pr_repository.go -package=mocks github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository PRRepository
 type PRRepository interface {
 	CreatePR(ctx context.Context, pr *domain.PullRequest) error
+	CreatePRs(ctx context.Context, prs []*domain.PullRequest) error
 	GetPR(ctx context.Context, prID string) (*domain.PullRequest, error)
 	GetPRWithReviewers(ctx context.Context, prID string) (*domain.PullRequest, error)
+	GetPRForUpdate(ctx context.Context, prID string) (*domain.PullRequest, error)
+	IncrementVersion(ctx context.Context, prID string) (int64, error)
 	PRExists(ctx context.Context, prID string) (bool, error)
+	ListExistingPRIDs(ctx context.Context, prIDs []string) ([]string, error)
 	MergePR(ctx context.Context, prID string) (*domain.PullRequest, error)
 	GetPRAuthorID(ctx context.Context, prID string) (string, error)
+	ListOpenPRsByAuthor(ctx context.Context, authorID string) ([]domain.PullRequestShort, error)
+	FlagStalePRs(ctx context.Context, defaultAge time.Duration) ([]domain.PullRequestShort, error)
+	// TransactionTime returns the start time of the current transaction as
+	// the database stores it in created_at, or the current time outside one.
+	TransactionTime(ctx context.Context) (time.Time, error)
 }
 
 //go:generate mockgen -destination=../mocks/mock_reviewer_repository.go -package=mocks github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository ReviewerRepository
@@ -22,7 +32,12 @@ type ReviewerRepository interface {
 	ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error
 	IsReviewerAssigned(ctx context.Context, prID, reviewerID string) (bool, error)
 	GetAssignedReviewers(ctx context.Context, prID string) ([]string, error)
+	GetAssignedReviewersByPRs(ctx context.Context, prIDs []string) (map[string][]string, error)
 	FindCandidatesForNewPR(ctx context.Context, teamName, authorID string) ([]string, error)
+	FindLeadCandidatesForNewPR(ctx context.Context, teamName, authorID string) ([]string, error)
+	ListReviewerCandidates(ctx context.Context, teamName string) ([]domain.ReviewerCandidate, error)
 	FindCandidatesForReassignment(ctx context.Context, teamName, authorID, prID string) ([]string, error)
 	ListPRsByReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequestShort, error)
+	ListPRsByReviewers(ctx context.Context, reviewerIDs []string) (map[string][]domain.PullRequest, error)
+	CountOpenReviews(ctx context.Context, reviewerID string) (int64, error)
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+)
+
+//go:generate mockgen -destination=../mocks/mock_snapshot_repository.go -package=mocks github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository SnapshotRepository
+type SnapshotRepository interface {
+	HasData(ctx context.Context) (bool, error)
+	ListTeams(ctx context.Context) ([]domain.Team, error)
+	ListUsers(ctx context.Context) ([]domain.User, error)
+	ListMemberships(ctx context.Context) ([]domain.TeamMembership, error)
+	ListPullRequests(ctx context.Context) ([]domain.PullRequest, error)
+	RestorePullRequest(ctx context.Context, pr *domain.PullRequest) error
+}
@@ -2,13 +2,34 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 )
 
 //go:generate mockgen -destination=../mocks/mock_stats_repository.go -package=mocks github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository StatsRepository
 type StatsRepository interface {
-	GetUserAssignmentStats(ctx context.Context) ([]domain.UserAssignmentStats, error)
-	GetPRStats(ctx context.Context) (*domain.PRStats, error)
-	GetReviewerWorkload(ctx context.Context) ([]domain.ReviewerWorkload, error)
+	GetUserAssignmentStats(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error)
+	GetPRStats(ctx context.Context, filter domain.StatsFilter) (*domain.PRStats, error)
+	GetReviewerWorkload(ctx context.Context, filter domain.StatsFilter, sort domain.WorkloadSort) ([]domain.ReviewerWorkload, error)
+	GetTeamRollupStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamRollupStats, error)
+	GetReviewerPairs(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerPair, error)
+	GetTeamOpenWorkload(ctx context.Context) ([]domain.TeamOpenWorkload, error)
+	GetTeamMemberAssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error)
+	GetTeamCycleTimes(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamCycleTime, error)
+	GetAuthorCycleTimes(ctx context.Context, filter domain.StatsFilter) ([]domain.AuthorCycleTime, error)
+	GetCycleTimeHistogram(ctx context.Context, filter domain.StatsFilter, bounds []time.Duration) ([]domain.CycleTimeBucket, error)
+	GetTimeSeries(ctx context.Context, filter domain.StatsFilter, interval domain.StatsInterval) ([]domain.TimeSeriesPoint, error)
+	GetStalePRs(ctx context.Context, filter domain.StatsFilter, olderThan, defaultAge time.Duration) ([]domain.StalePR, error)
+
+	RecordPRCreated(ctx context.Context, pr *domain.PullRequest) error
+	RecordPRsCreated(ctx context.Context, prs []*domain.PullRequest) error
+	RecordPRMerged(ctx context.Context, pr *domain.PullRequest) error
+	RecordReviewerReplaced(ctx context.Context, prID, teamName, oldReviewerID, newReviewerID string) error
+	RebuildAggregates(ctx context.Context) error
+	GetFreshness(ctx context.Context) (*domain.StatsFreshness, error)
+	GetAggregatedUserAssignmentStats(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error)
+	GetAggregatedPRStats(ctx context.Context, filter domain.StatsFilter) (*domain.PRStats, error)
+	GetAggregatedTimeSeries(ctx context.Context, filter domain.StatsFilter, interval domain.StatsInterval) ([]domain.TimeSeriesPoint, error)
+	GetAggregatedTeamMemberAssignmentCounts(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error)
 }
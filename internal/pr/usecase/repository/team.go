@@ -11,4 +11,17 @@ type TeamRepository interface {
 	CreateTeam(ctx context.Context, teamName string) error
 	GetTeam(ctx context.Context, teamName string) (*domain.Team, error)
 	TeamExists(ctx context.Context, teamName string) (bool, error)
+	ListTeams(ctx context.Context) ([]domain.Team, error)
+	// LockHierarchy serializes changes of team parents until the surrounding
+	// transaction ends, so concurrent changes cannot form a cycle together.
+	LockHierarchy(ctx context.Context) error
+	SetParentTeam(ctx context.Context, teamName, parentTeamName string) error
+	GetTeamAncestors(ctx context.Context, teamName string) ([]string, error)
+	AddMember(ctx context.Context, teamName, userID string, role domain.TeamRole) error
+	GetMembersByTeams(ctx context.Context, teamNames []string) (map[string][]domain.TeamMember, error)
+	IsMember(ctx context.Context, teamName, userID string) (bool, error)
+	GetMemberRole(ctx context.Context, teamName, userID string) (domain.TeamRole, error)
+	CountLeads(ctx context.Context, teamName string) (int64, error)
+	GetTeamSettings(ctx context.Context, teamName string) (*domain.TeamSettings, error)
+	UpdateTeamSettings(ctx context.Context, teamName string, settings domain.TeamSettings) error
 }
@@ -4,6 +4,9 @@ import "context"
 
 type Transactor interface {
 	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithinSnapshot runs fn in a read-only transaction in which every query
+	// sees the same snapshot of the data.
+	WithinSnapshot(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 //go:generate mockgen -destination=../mocks/mock_unit_of_work.go -package=mocks github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository UnitOfWork
@@ -15,4 +18,6 @@ type UnitOfWork interface {
 	PullRequests() PRRepository
 	Reviewers() ReviewerRepository
 	Stats() StatsRepository
+	Snapshots() SnapshotRepository
+	Events() EventRepository
 }
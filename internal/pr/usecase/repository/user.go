@@ -10,7 +10,13 @@ import (
 type UserRepository interface {
 	UpsertUser(ctx context.Context, user *domain.User) error
 	GetUser(ctx context.Context, userID string) (*domain.User, error)
+	// GetUserForUpdate locks the user row until the surrounding transaction
+	// ends.
+	GetUserForUpdate(ctx context.Context, userID string) (*domain.User, error)
+	GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error)
 	GetUsersByTeam(ctx context.Context, teamName string) ([]domain.User, error)
 	SetUserIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error)
 	UserExists(ctx context.Context, userID string) (bool, error)
+	UpdateUsername(ctx context.Context, userID, username string) (*domain.User, error)
+	ListUserTeams(ctx context.Context, userID string) ([]string, error)
 }
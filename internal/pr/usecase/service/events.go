@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository"
+)
+
+type EventService struct {
+	events repository.EventRepository
+}
+
+func NewEventService(events repository.EventRepository) *EventService {
+	return &EventService{events: events}
+}
+
+func (s *EventService) ListEvents(ctx context.Context, filter domain.EventFilter, afterID int64) ([]domain.Event, error) {
+	if afterID < 0 {
+		return nil, domain.NewValidationError("last_event_id", "min", "must not be negative")
+	}
+
+	events, err := s.events.ListEvents(ctx, filter, afterID, domain.MaxEventPage)
+	if err != nil {
+		return nil, fmt.Errorf("list events: %w", err)
+	}
+	return events, nil
+}
+
+func (s *EventService) LastEventID(ctx context.Context) (int64, error) {
+	return s.events.LastEventID(ctx)
+}
+
+// prCreatedEvents reports a new PR followed by the assignment of each of its
+// reviewers.
+func prCreatedEvents(pr *domain.PullRequest) []domain.Event {
+	events := make([]domain.Event, 0, 1+len(pr.AssignedReviewers))
+	events = append(events, domain.Event{
+		Type:          domain.EventPRCreated,
+		TeamName:      pr.TeamName,
+		PullRequestID: pr.PullRequestID,
+		UserID:        pr.AuthorID,
+	})
+	for _, reviewerID := range pr.AssignedReviewers {
+		events = append(events, domain.Event{
+			Type:          domain.EventReviewerAssigned,
+			TeamName:      pr.TeamName,
+			PullRequestID: pr.PullRequestID,
+			UserID:        reviewerID,
+		})
+	}
+	return events
+}
+
+func prMergedEvent(pr *domain.PullRequest) domain.Event {
+	return domain.Event{
+		Type:          domain.EventPRMerged,
+		TeamName:      pr.TeamName,
+		PullRequestID: pr.PullRequestID,
+		UserID:        pr.AuthorID,
+	}
+}
+
+func reviewerReassignedEvent(pr *domain.PullRequest, oldReviewerID, newReviewerID string) domain.Event {
+	return domain.Event{
+		Type:           domain.EventReviewerReassigned,
+		TeamName:       pr.TeamName,
+		PullRequestID:  pr.PullRequestID,
+		UserID:         newReviewerID,
+		PreviousUserID: oldReviewerID,
+	}
+}
+
+func userDeactivatedEvent(user *domain.User) domain.Event {
+	return domain.Event{
+		Type:     domain.EventUserDeactivated,
+		TeamName: user.TeamName,
+		UserID:   user.UserID,
+	}
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/mocks"
+)
+
+func TestEventService_ListEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := mocks.NewMockEventRepository(ctrl)
+	service := NewEventService(mockEventRepo)
+	ctx := context.Background()
+
+	t.Run("success - reads a page after the given id", func(t *testing.T) {
+		filter := domain.EventFilter{TeamName: "backend"}
+		events := []domain.Event{{ID: 8, Type: domain.EventPRMerged, TeamName: "backend"}}
+
+		mockEventRepo.EXPECT().ListEvents(ctx, filter, int64(7), domain.MaxEventPage).Return(events, nil)
+
+		result, err := service.ListEvents(ctx, filter, 7)
+		require.NoError(t, err)
+		assert.Equal(t, events, result)
+	})
+
+	t.Run("error - negative id", func(t *testing.T) {
+		_, err := service.ListEvents(ctx, domain.EventFilter{}, -1)
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "last_event_id", validationErr.Field)
+	})
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository"
+)
+
+// DefaultIdempotencyKeyTTL is how long a stored response is replayed when the
+// configuration does not say otherwise.
+const DefaultIdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyLease is how long a key stays reserved for a request that has
+// not finished yet. It outlasts the request timeout, so a key is only taken
+// over from a request that crashed before completing or releasing it.
+const IdempotencyLease = time.Minute
+
+// IdempotencyService remembers the responses of requests sent with an
+// Idempotency-Key so that retries get the original response instead of
+// repeating the change.
+type IdempotencyService struct {
+	repo repository.IdempotencyRepository
+	ttl  time.Duration
+}
+
+// NewIdempotencyService keeps responses for ttl; zero or less means
+// DefaultIdempotencyKeyTTL.
+func NewIdempotencyService(repo repository.IdempotencyRepository, ttl time.Duration) *IdempotencyService {
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyKeyTTL
+	}
+	return &IdempotencyService{repo: repo, ttl: ttl}
+}
+
+func (s *IdempotencyService) Begin(ctx context.Context, key, requestHash string) (*domain.IdempotencyRecord, error) {
+	if len(key) > domain.MaxIdempotencyKeyLength {
+		return nil, domain.NewValidationError("Idempotency-Key", "max", fmt.Sprintf("must be at most %d characters", domain.MaxIdempotencyKeyLength))
+	}
+
+	reserved, err := s.repo.Reserve(ctx, key, requestHash, IdempotencyLease)
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	record, err := s.repo.Get(ctx, key)
+	if err != nil {
+		// The first request failed and released the key between the two
+		// calls; the client may simply retry.
+		if errors.Is(err, domain.ErrIdempotencyKeyNotFound) {
+			return nil, domain.ErrIdempotencyKeyInProgress
+		}
+		return nil, err
+	}
+	if record.RequestHash != requestHash {
+		return nil, domain.ErrIdempotencyKeyReused
+	}
+	if !record.Completed() {
+		return nil, domain.ErrIdempotencyKeyInProgress
+	}
+	return record, nil
+}
+
+func (s *IdempotencyService) Complete(ctx context.Context, record *domain.IdempotencyRecord) error {
+	return s.repo.Complete(ctx, record, s.ttl)
+}
+
+func (s *IdempotencyService) Release(ctx context.Context, key string) error {
+	return s.repo.Release(ctx, key)
+}
+
+func (s *IdempotencyService) PurgeExpired(ctx context.Context) (int64, error) {
+	return s.repo.DeleteExpired(ctx)
+}
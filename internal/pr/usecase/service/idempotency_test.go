@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/mocks"
+)
+
+func TestIdempotencyService_Begin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIdempotencyRepository(ctrl)
+	service := NewIdempotencyService(mockRepo, 0)
+	ctx := context.Background()
+
+	t.Run("success - new key is reserved", func(t *testing.T) {
+		mockRepo.EXPECT().
+			Reserve(ctx, "key-1", "hash-1", IdempotencyLease).
+			Return(true, nil).
+			Times(1)
+
+		record, err := service.Begin(ctx, "key-1", "hash-1")
+		require.NoError(t, err)
+		assert.Nil(t, record)
+	})
+
+	t.Run("success - completed request is replayed", func(t *testing.T) {
+		stored := &domain.IdempotencyRecord{
+			Key:         "key-1",
+			RequestHash: "hash-1",
+			StatusCode:  201,
+			ContentType: "application/json",
+			Body:        []byte(`{"pr":{}}`),
+		}
+		mockRepo.EXPECT().Reserve(ctx, "key-1", "hash-1", IdempotencyLease).Return(false, nil).Times(1)
+		mockRepo.EXPECT().Get(ctx, "key-1").Return(stored, nil).Times(1)
+
+		record, err := service.Begin(ctx, "key-1", "hash-1")
+		require.NoError(t, err)
+		assert.Equal(t, stored, record)
+	})
+
+	t.Run("error - key reused for a different request", func(t *testing.T) {
+		mockRepo.EXPECT().Reserve(ctx, "key-1", "hash-2", IdempotencyLease).Return(false, nil).Times(1)
+		mockRepo.EXPECT().
+			Get(ctx, "key-1").
+			Return(&domain.IdempotencyRecord{Key: "key-1", RequestHash: "hash-1", StatusCode: 201}, nil).
+			Times(1)
+
+		record, err := service.Begin(ctx, "key-1", "hash-2")
+		assert.ErrorIs(t, err, domain.ErrIdempotencyKeyReused)
+		assert.Nil(t, record)
+	})
+
+	t.Run("error - first request still running", func(t *testing.T) {
+		mockRepo.EXPECT().Reserve(ctx, "key-1", "hash-1", IdempotencyLease).Return(false, nil).Times(1)
+		mockRepo.EXPECT().
+			Get(ctx, "key-1").
+			Return(&domain.IdempotencyRecord{Key: "key-1", RequestHash: "hash-1"}, nil).
+			Times(1)
+
+		_, err := service.Begin(ctx, "key-1", "hash-1")
+		assert.ErrorIs(t, err, domain.ErrIdempotencyKeyInProgress)
+	})
+
+	t.Run("error - key released between reserve and get", func(t *testing.T) {
+		mockRepo.EXPECT().Reserve(ctx, "key-1", "hash-1", IdempotencyLease).Return(false, nil).Times(1)
+		mockRepo.EXPECT().Get(ctx, "key-1").Return(nil, domain.ErrIdempotencyKeyNotFound).Times(1)
+
+		_, err := service.Begin(ctx, "key-1", "hash-1")
+		assert.ErrorIs(t, err, domain.ErrIdempotencyKeyInProgress)
+	})
+
+	t.Run("error - key too long", func(t *testing.T) {
+		_, err := service.Begin(ctx, strings.Repeat("k", domain.MaxIdempotencyKeyLength+1), "hash-1")
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "Idempotency-Key", validationErr.Field)
+	})
+
+	t.Run("error - repository failure", func(t *testing.T) {
+		mockRepo.EXPECT().
+			Reserve(ctx, "key-1", "hash-1", IdempotencyLease).
+			Return(false, errors.New("connection refused")).
+			Times(1)
+
+		_, err := service.Begin(ctx, "key-1", "hash-1")
+		assert.Error(t, err)
+	})
+}
+
+func TestIdempotencyService_Complete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIdempotencyRepository(ctrl)
+	service := NewIdempotencyService(mockRepo, time.Hour)
+	ctx := context.Background()
+
+	t.Run("success - response is kept for the configured ttl", func(t *testing.T) {
+		record := &domain.IdempotencyRecord{Key: "key-1", RequestHash: "hash-1", StatusCode: 201}
+		mockRepo.EXPECT().Complete(ctx, record, time.Hour).Return(nil).Times(1)
+
+		require.NoError(t, service.Complete(ctx, record))
+	})
+}
@@ -3,29 +3,38 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository"
 )
 
+const maxReviewersPerPR = 2
+
 type PRService struct {
-	uow repository.UnitOfWork
+	uow     repository.UnitOfWork
+	metrics usecase.PRMetrics
 }
 
-func NewPRService(uow repository.UnitOfWork) *PRService {
-	return &PRService{uow: uow}
+// NewPRService creates the service; metrics may be nil.
+func NewPRService(uow repository.UnitOfWork, metrics usecase.PRMetrics) *PRService {
+	if metrics == nil {
+		metrics = nopPRMetrics{}
+	}
+	return &PRService{uow: uow, metrics: metrics}
 }
 
 func (s *PRService) CreatePR(ctx context.Context, req usecase.CreatePRRequest) (*domain.PullRequest, error) {
 	if req.PullRequestID == "" {
-		return nil, fmt.Errorf("pull_request_id is required")
+		return nil, domain.RequiredError("pull_request_id")
 	}
 	if req.PullRequestName == "" {
-		return nil, fmt.Errorf("pull_request_name is required")
+		return nil, domain.RequiredError("pull_request_name")
 	}
 	if req.AuthorID == "" {
-		return nil, fmt.Errorf("author_id is required")
+		return nil, domain.RequiredError("author_id")
 	}
 
 	exists, err := s.uow.PullRequests().PRExists(ctx, req.PullRequestID)
@@ -41,25 +50,34 @@ func (s *PRService) CreatePR(ctx context.Context, req usecase.CreatePRRequest) (
 		return nil, err
 	}
 
+	teamName := author.TeamName
+	if req.TeamName != "" {
+		isMember, err := s.uow.Teams().IsMember(ctx, req.TeamName, req.AuthorID)
+		if err != nil {
+			return nil, fmt.Errorf("check team membership: %w", err)
+		}
+		if !isMember {
+			return nil, domain.ErrNotTeamMember
+		}
+		teamName = req.TeamName
+	}
+
 	var createdPR *domain.PullRequest
 	err = s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
 		pr := &domain.PullRequest{
 			PullRequestID:   req.PullRequestID,
 			PullRequestName: req.PullRequestName,
 			AuthorID:        req.AuthorID,
+			TeamName:        teamName,
 			Status:          domain.PRStatusOpen,
 		}
 		if err := s.uow.PullRequests().CreatePR(txCtx, pr); err != nil {
 			return fmt.Errorf("create PR: %w", err)
 		}
 
-		candidates, err := s.uow.Reviewers().FindCandidatesForNewPR(
-			txCtx,
-			author.TeamName,
-			req.AuthorID,
-		)
+		candidates, err := s.findReviewersForNewPR(txCtx, teamName, req.AuthorID)
 		if err != nil {
-			return fmt.Errorf("find candidates: %w", err)
+			return err
 		}
 
 		for _, candidateID := range candidates {
@@ -69,7 +87,17 @@ func (s *PRService) CreatePR(ctx context.Context, req usecase.CreatePRRequest) (
 		}
 
 		createdPR, err = s.uow.PullRequests().GetPRWithReviewers(txCtx, req.PullRequestID)
-		return err
+		if err != nil {
+			return err
+		}
+
+		if err := s.uow.Stats().RecordPRCreated(txCtx, createdPR); err != nil {
+			return fmt.Errorf("record PR stats: %w", err)
+		}
+		if err := s.uow.Events().RecordEvents(txCtx, prCreatedEvents(createdPR)); err != nil {
+			return fmt.Errorf("record PR events: %w", err)
+		}
+		return nil
 	})
 
 	if err != nil {
@@ -81,92 +109,278 @@ func (s *PRService) CreatePR(ctx context.Context, req usecase.CreatePRRequest) (
 
 func (s *PRService) MergePR(ctx context.Context, req usecase.MergePRRequest) (*domain.PullRequest, error) {
 	if req.PullRequestID == "" {
-		return nil, fmt.Errorf("pull_request_id is required")
+		return nil, domain.RequiredError("pull_request_id")
 	}
 
-	pr, err := s.uow.PullRequests().MergePR(ctx, req.PullRequestID)
+	var merged *domain.PullRequest
+	err := s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.lockPR(txCtx, req.PullRequestID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		merged, err = s.uow.PullRequests().MergePR(txCtx, req.PullRequestID)
+		if err != nil {
+			return err
+		}
+
+		// Merging is idempotent; only the first merge is counted.
+		if pr.Status == domain.PRStatusMerged {
+			return nil
+		}
+		if err := s.uow.Stats().RecordPRMerged(txCtx, merged); err != nil {
+			return fmt.Errorf("record merge stats: %w", err)
+		}
+		if err := s.uow.Events().RecordEvents(txCtx, []domain.Event{prMergedEvent(merged)}); err != nil {
+			return fmt.Errorf("record merge event: %w", err)
+		}
+		return nil
+	})
+
 	if err != nil {
 		return nil, err
 	}
 
-	return pr, nil
+	return merged, nil
 }
 
+// ReassignReviewer runs entirely under the PR row lock, so two concurrent
+// reassignments of the same PR cannot both pass the assignment check.
 func (s *PRService) ReassignReviewer(ctx context.Context, req usecase.ReassignReviewerRequest) (*usecase.ReassignReviewerResponse, error) {
 	if req.PullRequestID == "" {
-		return nil, fmt.Errorf("pull_request_id is required")
+		return nil, domain.RequiredError("pull_request_id")
 	}
 	if req.OldReviewerID == "" {
-		return nil, fmt.Errorf("old_reviewer_id is required")
+		return nil, domain.RequiredError("old_reviewer_id")
 	}
 
-	pr, err := s.uow.PullRequests().GetPR(ctx, req.PullRequestID)
+	var result *usecase.ReassignReviewerResponse
+	err := s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
+		pr, err := s.lockPR(txCtx, req.PullRequestID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		if pr.Status == domain.PRStatusMerged {
+			return domain.ErrPRMerged
+		}
+
+		isAssigned, err := s.uow.Reviewers().IsReviewerAssigned(txCtx, req.PullRequestID, req.OldReviewerID)
+		if err != nil {
+			return fmt.Errorf("check reviewer assigned: %w", err)
+		}
+		if !isAssigned {
+			return domain.ErrReviewerNotAssigned
+		}
+
+		// Replacements come from the team the PR was opened in, which may be
+		// a secondary team of the old reviewer.
+		candidates, err := s.findCandidatesForReassignment(txCtx, pr.TeamName, pr.AuthorID, req.PullRequestID)
+		if err != nil {
+			return err
+		}
+
+		if len(candidates) == 0 {
+			s.metrics.NoCandidate()
+			return domain.ErrNoCandidates
+		}
+
+		newReviewerID := candidates[0]
+
+		if err := s.uow.Stats().RecordReviewerReplaced(txCtx, req.PullRequestID, pr.TeamName, req.OldReviewerID, newReviewerID); err != nil {
+			return fmt.Errorf("record reassignment stats: %w", err)
+		}
+		if err := s.uow.Reviewers().ReplaceReviewer(txCtx, req.PullRequestID, req.OldReviewerID, newReviewerID); err != nil {
+			return fmt.Errorf("replace reviewer: %w", err)
+		}
+		if _, err := s.uow.PullRequests().IncrementVersion(txCtx, req.PullRequestID); err != nil {
+			return err
+		}
+
+		updatedPR, err := s.uow.PullRequests().GetPRWithReviewers(txCtx, req.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("get updated PR: %w", err)
+		}
+
+		event := reviewerReassignedEvent(updatedPR, req.OldReviewerID, newReviewerID)
+		if err := s.uow.Events().RecordEvents(txCtx, []domain.Event{event}); err != nil {
+			return fmt.Errorf("record reassignment event: %w", err)
+		}
+
+		result = &usecase.ReassignReviewerResponse{
+			PullRequest: updatedPR,
+			ReplacedBy:  newReviewerID,
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	s.metrics.ReviewerReassigned()
 
-	if pr.Status == domain.PRStatusMerged {
-		return nil, domain.ErrPRMerged
-	}
+	return result, nil
+}
 
-	isAssigned, err := s.uow.Reviewers().IsReviewerAssigned(ctx, req.PullRequestID, req.OldReviewerID)
+// lockPR locks the PR for the rest of the transaction and checks it against
+// the version the client expects, if any.
+func (s *PRService) lockPR(ctx context.Context, prID string, expectedVersion int64) (*domain.PullRequest, error) {
+	pr, err := s.uow.PullRequests().GetPRForUpdate(ctx, prID)
 	if err != nil {
-		return nil, fmt.Errorf("check reviewer assigned: %w", err)
+		return nil, err
 	}
-	if !isAssigned {
-		return nil, domain.ErrReviewerNotAssigned
+	if expectedVersion != 0 && pr.Version != expectedVersion {
+		return nil, domain.ErrPRVersionMismatch
 	}
+	return pr, nil
+}
 
-	oldReviewer, err := s.uow.Users().GetUser(ctx, req.OldReviewerID)
+// GetPR reads the PR and its reviewers from one snapshot, so the version
+// matches the reviewers it is returned with.
+func (s *PRService) GetPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	if prID == "" {
+		return nil, domain.RequiredError("pull_request_id")
+	}
+
+	var pr *domain.PullRequest
+	err := s.uow.WithinSnapshot(ctx, func(txCtx context.Context) error {
+		var err error
+		pr, err = s.uow.PullRequests().GetPRWithReviewers(txCtx, prID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
+	return pr, nil
+}
+
+func (s *PRService) GetReviewerPRs(ctx context.Context, reviewerID string) ([]domain.PullRequestShort, error) {
+	if reviewerID == "" {
+		return nil, domain.RequiredError("reviewer_id")
+	}
 
-	authorID, err := s.uow.PullRequests().GetPRAuthorID(ctx, req.PullRequestID)
+	prs, err := s.uow.Reviewers().ListPRsByReviewer(ctx, reviewerID)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("list PRs by reviewer: %w", err)
+	}
+
+	return prs, nil
+}
+
+// FlagStalePRs marks open PRs that exceeded their team's stale threshold,
+// or defaultAge (DefaultStaleAfter when zero), and returns those flagged for
+// the first time.
+func (s *PRService) FlagStalePRs(ctx context.Context, defaultAge time.Duration) ([]domain.PullRequestShort, error) {
+	if defaultAge <= 0 {
+		defaultAge = DefaultStaleAfter
 	}
 
-	candidates, err := s.uow.Reviewers().FindCandidatesForReassignment(
-		ctx,
-		oldReviewer.TeamName,
-		authorID,
-		req.PullRequestID,
-	)
+	prs, err := s.uow.PullRequests().FlagStalePRs(ctx, defaultAge)
 	if err != nil {
-		return nil, fmt.Errorf("find replacement candidates: %w", err)
+		return nil, fmt.Errorf("flag stale PRs: %w", err)
+	}
+	return prs, nil
+}
+
+// findReviewersForNewPR puts an active team lead first when the team
+// requires lead review and fills the remaining slots as usual.
+func (s *PRService) findReviewersForNewPR(ctx context.Context, teamName, authorID string) ([]string, error) {
+	settings, err := s.uow.Teams().GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get team settings: %w", err)
+	}
+	if !settings.RequireLeadReview {
+		return s.findCandidatesForNewPR(ctx, teamName, authorID)
+	}
+
+	leads, err := s.uow.Reviewers().FindLeadCandidatesForNewPR(ctx, teamName, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("find lead candidates: %w", err)
+	}
+	if len(leads) == 0 {
+		return s.findCandidatesForNewPR(ctx, teamName, authorID)
+	}
+
+	candidates, err := s.findCandidatesForNewPR(ctx, teamName, authorID)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(candidates) == 0 {
-		return nil, domain.ErrNoCandidates
+	reviewers := []string{leads[0]}
+	for _, candidateID := range candidates {
+		if len(reviewers) == maxReviewersPerPR {
+			break
+		}
+		if candidateID != leads[0] {
+			reviewers = append(reviewers, candidateID)
+		}
 	}
 
-	newReviewerID := candidates[0]
+	return reviewers, nil
+}
 
-	if err := s.uow.Reviewers().ReplaceReviewer(ctx, req.PullRequestID, req.OldReviewerID, newReviewerID); err != nil {
-		return nil, fmt.Errorf("replace reviewer: %w", err)
+func (s *PRService) findCandidatesForNewPR(ctx context.Context, teamName, authorID string) ([]string, error) {
+	candidates, err := s.uow.Reviewers().FindCandidatesForNewPR(ctx, teamName, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("find candidates: %w", err)
+	}
+	if len(candidates) >= maxReviewersPerPR {
+		return candidates, nil
 	}
 
-	updatedPR, err := s.uow.PullRequests().GetPRWithReviewers(ctx, req.PullRequestID)
+	ancestors, err := s.uow.Teams().GetTeamAncestors(ctx, teamName)
 	if err != nil {
-		return nil, fmt.Errorf("get updated PR: %w", err)
+		return nil, fmt.Errorf("get team ancestors: %w", err)
 	}
 
-	return &usecase.ReassignReviewerResponse{
-		PullRequest: updatedPR,
-		ReplacedBy:  newReviewerID,
-	}, nil
+	for _, ancestor := range ancestors {
+		more, err := s.uow.Reviewers().FindCandidatesForNewPR(ctx, ancestor, authorID)
+		if err != nil {
+			return nil, fmt.Errorf("find candidates in %s: %w", ancestor, err)
+		}
+
+		for _, candidateID := range more {
+			if len(candidates) == maxReviewersPerPR {
+				return candidates, nil
+			}
+			// A member of both the team and its ancestor is found twice.
+			if slices.Contains(candidates, candidateID) {
+				continue
+			}
+			candidates = append(candidates, candidateID)
+		}
+	}
+
+	return candidates, nil
 }
 
-func (s *PRService) GetReviewerPRs(ctx context.Context, reviewerID string) ([]domain.PullRequestShort, error) {
-	if reviewerID == "" {
-		return nil, fmt.Errorf("reviewer_id is required")
+func (s *PRService) findCandidatesForReassignment(ctx context.Context, teamName, authorID, prID string) ([]string, error) {
+	candidates, err := s.uow.Reviewers().FindCandidatesForReassignment(ctx, teamName, authorID, prID)
+	if err != nil {
+		return nil, fmt.Errorf("find replacement candidates: %w", err)
+	}
+	if len(candidates) > 0 {
+		return candidates, nil
 	}
 
-	prs, err := s.uow.Reviewers().ListPRsByReviewer(ctx, reviewerID)
+	ancestors, err := s.uow.Teams().GetTeamAncestors(ctx, teamName)
 	if err != nil {
-		return nil, fmt.Errorf("list PRs by reviewer: %w", err)
+		return nil, fmt.Errorf("get team ancestors: %w", err)
 	}
 
-	return prs, nil
+	for _, ancestor := range ancestors {
+		candidates, err = s.uow.Reviewers().FindCandidatesForReassignment(ctx, ancestor, authorID, prID)
+		if err != nil {
+			return nil, fmt.Errorf("find replacement candidates in %s: %w", ancestor, err)
+		}
+		if len(candidates) > 0 {
+			return candidates, nil
+		}
+	}
+
+	return candidates, nil
 }
+
+type nopPRMetrics struct{}
+
+func (nopPRMetrics) ReviewerReassigned() {}
+func (nopPRMetrics) NoCandidate()        {}
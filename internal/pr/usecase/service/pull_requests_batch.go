@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository"
+)
+
+// maxBatchAttempts bounds how many times a batch is planned again after
+// losing an id to a concurrent insert.
+const maxBatchAttempts = 3
+
+// BatchCreatePRs creates up to domain.MaxPRBatchSize PRs at once. Items that
+// cannot be created get their error in the result and do not affect the
+// others; the rest are inserted in bulk in a single transaction.
+//
+// Reviewers follow the same rules as in CreatePR, but instead of random
+// candidates the least loaded ones are taken, counting the PRs assigned
+// earlier in the same batch, so an import does not pile up on a few people.
+func (s *PRService) BatchCreatePRs(ctx context.Context, reqs []usecase.CreatePRRequest) ([]usecase.BatchCreatePRResult, error) {
+	if len(reqs) == 0 {
+		return nil, domain.RequiredError("pull_requests")
+	}
+	if len(reqs) > domain.MaxPRBatchSize {
+		return nil, domain.NewValidationError("pull_requests", "max",
+			fmt.Sprintf("must contain at most %d item(s)", domain.MaxPRBatchSize))
+	}
+
+	// A PR created by another request after the existence check makes the
+	// insert fail. That PR is committed by then, so the next attempt reports
+	// it on its own item.
+	for attempt := 1; ; attempt++ {
+		results, err := s.batchCreatePRs(ctx, reqs)
+		if errors.Is(err, domain.ErrPRAlreadyExists) && attempt < maxBatchAttempts {
+			continue
+		}
+		return results, err
+	}
+}
+
+// batchCreatePRs checks, plans and inserts the batch in one transaction.
+func (s *PRService) batchCreatePRs(ctx context.Context, reqs []usecase.CreatePRRequest) ([]usecase.BatchCreatePRResult, error) {
+	ids := make([]string, len(reqs))
+	for i, req := range reqs {
+		ids[i] = req.PullRequestID
+	}
+
+	var results []usecase.BatchCreatePRResult
+	err := s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
+		existing, err := s.uow.PullRequests().ListExistingPRIDs(txCtx, ids)
+		if err != nil {
+			return fmt.Errorf("list existing PRs: %w", err)
+		}
+
+		taken := make(map[string]bool, len(reqs)+len(existing))
+		for _, id := range existing {
+			taken[id] = true
+		}
+
+		planner := newBatchPlanner(s.uow)
+		results = make([]usecase.BatchCreatePRResult, len(reqs))
+		var prs []*domain.PullRequest
+
+		for i, req := range reqs {
+			pr, err := planner.plan(txCtx, req, taken)
+			if err != nil {
+				if !isBatchItemError(err) {
+					return err
+				}
+				results[i].Err = err
+				continue
+			}
+
+			taken[pr.PullRequestID] = true
+			results[i].PullRequest = pr
+			prs = append(prs, pr)
+		}
+
+		if len(prs) == 0 {
+			return nil
+		}
+
+		// Stamp the PRs with the database clock, as single creation does.
+		createdAt, err := s.uow.PullRequests().TransactionTime(txCtx)
+		if err != nil {
+			return err
+		}
+		for _, pr := range prs {
+			pr.CreatedAt = &createdAt
+		}
+
+		if err := s.uow.PullRequests().CreatePRs(txCtx, prs); err != nil {
+			return fmt.Errorf("create PRs: %w", err)
+		}
+		if err := s.uow.Stats().RecordPRsCreated(txCtx, prs); err != nil {
+			return fmt.Errorf("record PR stats: %w", err)
+		}
+
+		var events []domain.Event
+		for _, pr := range prs {
+			events = append(events, prCreatedEvents(pr)...)
+		}
+		if err := s.uow.Events().RecordEvents(txCtx, events); err != nil {
+			return fmt.Errorf("record PR events: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// isBatchItemError tells the errors of a single batch item, reported in its
+// result, from failures that abort the whole batch.
+func isBatchItemError(err error) bool {
+	var validationErr *domain.ValidationError
+	return errors.As(err, &validationErr) ||
+		errors.Is(err, domain.ErrPRAlreadyExists) ||
+		errors.Is(err, domain.ErrUserNotFound) ||
+		errors.Is(err, domain.ErrNotTeamMember)
+}
+
+// batchPlanner resolves authors, teams and reviewers for a batch, reading
+// every author, team and candidate list from the database once. It tracks
+// the open reviews of every candidate it has seen and bumps them on each
+// assignment.
+type batchPlanner struct {
+	uow        repository.UnitOfWork
+	authors    map[string]*domain.User
+	membership map[[2]string]bool
+	settings   map[string]*domain.TeamSettings
+	ancestors  map[string][]string
+	candidates map[string][]domain.ReviewerCandidate
+	load       map[string]int64
+}
+
+func newBatchPlanner(uow repository.UnitOfWork) *batchPlanner {
+	return &batchPlanner{
+		uow:        uow,
+		authors:    make(map[string]*domain.User),
+		membership: make(map[[2]string]bool),
+		settings:   make(map[string]*domain.TeamSettings),
+		ancestors:  make(map[string][]string),
+		candidates: make(map[string][]domain.ReviewerCandidate),
+		load:       make(map[string]int64),
+	}
+}
+
+// plan checks a batch item and returns its PR with reviewers assigned.
+// taken holds the ids already used in the database or earlier in the batch.
+func (p *batchPlanner) plan(ctx context.Context, req usecase.CreatePRRequest, taken map[string]bool) (*domain.PullRequest, error) {
+	if req.PullRequestID == "" {
+		return nil, domain.RequiredError("pull_request_id")
+	}
+	if req.PullRequestName == "" {
+		return nil, domain.RequiredError("pull_request_name")
+	}
+	if req.AuthorID == "" {
+		return nil, domain.RequiredError("author_id")
+	}
+	if taken[req.PullRequestID] {
+		return nil, domain.ErrPRAlreadyExists
+	}
+
+	author, err := p.author(ctx, req.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamName := author.TeamName
+	if req.TeamName != "" {
+		isMember, err := p.isMember(ctx, req.TeamName, req.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, domain.ErrNotTeamMember
+		}
+		teamName = req.TeamName
+	}
+
+	reviewers, err := p.pickReviewers(ctx, teamName, req.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PullRequest{
+		PullRequestID:     req.PullRequestID,
+		PullRequestName:   req.PullRequestName,
+		AuthorID:          req.AuthorID,
+		TeamName:          teamName,
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: reviewers,
+		Version:           1,
+	}, nil
+}
+
+// pickReviewers mirrors findReviewersForNewPR: an active lead first when the
+// team requires lead review, then the remaining slots from the team and its
+// ancestors, nearest first.
+func (p *batchPlanner) pickReviewers(ctx context.Context, teamName, authorID string) ([]string, error) {
+	settings, err := p.teamSettings(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewers := make([]string, 0, maxReviewersPerPR)
+
+	own, err := p.teamCandidates(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if settings.RequireLeadReview {
+		if lead, ok := p.leastLoaded(own, authorID, reviewers, true); ok {
+			reviewers = append(reviewers, lead)
+		}
+	}
+	reviewers = p.fill(reviewers, own, authorID)
+
+	if len(reviewers) < maxReviewersPerPR {
+		ancestors, err := p.teamAncestors(ctx, teamName)
+		if err != nil {
+			return nil, err
+		}
+		for _, ancestor := range ancestors {
+			pool, err := p.teamCandidates(ctx, ancestor)
+			if err != nil {
+				return nil, err
+			}
+			reviewers = p.fill(reviewers, pool, authorID)
+			if len(reviewers) == maxReviewersPerPR {
+				break
+			}
+		}
+	}
+
+	for _, reviewerID := range reviewers {
+		p.load[reviewerID]++
+	}
+	slices.Sort(reviewers)
+	return reviewers, nil
+}
+
+func (p *batchPlanner) fill(reviewers []string, pool []domain.ReviewerCandidate, authorID string) []string {
+	for len(reviewers) < maxReviewersPerPR {
+		candidateID, ok := p.leastLoaded(pool, authorID, reviewers, false)
+		if !ok {
+			break
+		}
+		reviewers = append(reviewers, candidateID)
+	}
+	return reviewers
+}
+
+// leastLoaded returns the candidate with the fewest open reviews, skipping
+// the author and those already chosen. Ties go to the smallest user id.
+func (p *batchPlanner) leastLoaded(pool []domain.ReviewerCandidate, authorID string, chosen []string, leadsOnly bool) (string, bool) {
+	best := ""
+	for _, candidate := range pool {
+		if candidate.UserID == authorID || slices.Contains(chosen, candidate.UserID) {
+			continue
+		}
+		if leadsOnly && candidate.Role != domain.TeamRoleLead {
+			continue
+		}
+		if best == "" || p.load[candidate.UserID] < p.load[best] {
+			best = candidate.UserID
+		}
+	}
+	return best, best != ""
+}
+
+func (p *batchPlanner) author(ctx context.Context, userID string) (*domain.User, error) {
+	if author, ok := p.authors[userID]; ok {
+		if author == nil {
+			return nil, domain.ErrUserNotFound
+		}
+		return author, nil
+	}
+
+	author, err := p.uow.Users().GetUser(ctx, userID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		p.authors[userID] = nil
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	p.authors[userID] = author
+	return author, nil
+}
+
+func (p *batchPlanner) isMember(ctx context.Context, teamName, userID string) (bool, error) {
+	key := [2]string{teamName, userID}
+	if isMember, ok := p.membership[key]; ok {
+		return isMember, nil
+	}
+
+	isMember, err := p.uow.Teams().IsMember(ctx, teamName, userID)
+	if err != nil {
+		return false, fmt.Errorf("check team membership: %w", err)
+	}
+	p.membership[key] = isMember
+	return isMember, nil
+}
+
+func (p *batchPlanner) teamSettings(ctx context.Context, teamName string) (*domain.TeamSettings, error) {
+	if settings, ok := p.settings[teamName]; ok {
+		return settings, nil
+	}
+
+	settings, err := p.uow.Teams().GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get team settings: %w", err)
+	}
+	p.settings[teamName] = settings
+	return settings, nil
+}
+
+func (p *batchPlanner) teamAncestors(ctx context.Context, teamName string) ([]string, error) {
+	if ancestors, ok := p.ancestors[teamName]; ok {
+		return ancestors, nil
+	}
+
+	ancestors, err := p.uow.Teams().GetTeamAncestors(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get team ancestors: %w", err)
+	}
+	p.ancestors[teamName] = ancestors
+	return ancestors, nil
+}
+
+func (p *batchPlanner) teamCandidates(ctx context.Context, teamName string) ([]domain.ReviewerCandidate, error) {
+	if candidates, ok := p.candidates[teamName]; ok {
+		return candidates, nil
+	}
+
+	candidates, err := p.uow.Reviewers().ListReviewerCandidates(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("list candidates of %s: %w", teamName, err)
+	}
+	for _, candidate := range candidates {
+		if _, ok := p.load[candidate.UserID]; !ok {
+			p.load[candidate.UserID] = candidate.OpenReviews
+		}
+	}
+	p.candidates[teamName] = candidates
+	return candidates, nil
+}
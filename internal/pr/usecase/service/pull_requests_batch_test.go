@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/mocks"
+)
+
+func TestPRService_BatchCreatePRs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockPRRepo := mocks.NewMockPRRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockReviewerRepo := mocks.NewMockReviewerRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	mockEventRepo := mocks.NewMockEventRepository(ctrl)
+
+	mockUOW.EXPECT().PullRequests().Return(mockPRRepo).AnyTimes()
+	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
+	mockUOW.EXPECT().Reviewers().Return(mockReviewerRepo).AnyTimes()
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+	mockUOW.EXPECT().Stats().Return(mockStatsRepo).AnyTimes()
+	mockUOW.EXPECT().Events().Return(mockEventRepo).AnyTimes()
+
+	service := NewPRService(mockUOW, nil)
+	ctx := context.Background()
+
+	author := &domain.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	inTransaction := func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}
+	createdAt := time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	t.Run("success - reviewers are balanced across the batch", func(t *testing.T) {
+		reqs := []usecase.CreatePRRequest{
+			{PullRequestID: "pr-1", PullRequestName: "One", AuthorID: "u1"},
+			{PullRequestID: "pr-2", PullRequestName: "Two", AuthorID: "u1"},
+			{PullRequestID: "pr-3", PullRequestName: "Three", AuthorID: "u1"},
+		}
+
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(inTransaction)
+		mockPRRepo.EXPECT().ListExistingPRIDs(ctx, []string{"pr-1", "pr-2", "pr-3"}).Return(nil, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil).Times(1)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{}, nil).Times(1)
+		mockReviewerRepo.EXPECT().
+			ListReviewerCandidates(ctx, "backend").
+			Return([]domain.ReviewerCandidate{
+				{UserID: "u1", Role: domain.TeamRoleMember},
+				{UserID: "u2", Role: domain.TeamRoleMember, OpenReviews: 1},
+				{UserID: "u3", Role: domain.TeamRoleMember},
+				{UserID: "u4", Role: domain.TeamRoleMember},
+			}, nil).
+			Times(1)
+
+		mockPRRepo.EXPECT().TransactionTime(ctx).Return(createdAt, nil)
+		mockPRRepo.EXPECT().
+			CreatePRs(ctx, gomock.Len(3)).
+			DoAndReturn(func(_ context.Context, prs []*domain.PullRequest) error {
+				for _, pr := range prs {
+					assert.Equal(t, &createdAt, pr.CreatedAt)
+					assert.Equal(t, "backend", pr.TeamName)
+				}
+				return nil
+			})
+		mockStatsRepo.EXPECT().RecordPRsCreated(ctx, gomock.Len(3)).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Len(9)).Return(nil)
+
+		results, err := service.BatchCreatePRs(ctx, reqs)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		var reviewers [][]string
+		for _, result := range results {
+			require.NoError(t, result.Err)
+			assert.Equal(t, domain.PRStatusOpen, result.PullRequest.Status)
+			reviewers = append(reviewers, result.PullRequest.AssignedReviewers)
+		}
+		assert.Equal(t, [][]string{{"u3", "u4"}, {"u2", "u3"}, {"u2", "u4"}}, reviewers)
+	})
+
+	t.Run("success - failed items do not stop the batch", func(t *testing.T) {
+		reqs := []usecase.CreatePRRequest{
+			{PullRequestID: "pr-1", PullRequestName: "One", AuthorID: "u1"},
+			{PullRequestID: "pr-1", PullRequestName: "Duplicate", AuthorID: "u1"},
+			{PullRequestID: "pr-2", PullRequestName: "Exists", AuthorID: "u1"},
+			{PullRequestID: "pr-3", PullRequestName: "Ghost", AuthorID: "ghost"},
+			{PullRequestID: "pr-4", AuthorID: "u1"},
+			{PullRequestID: "pr-5", PullRequestName: "Foreign", AuthorID: "u1", TeamName: "frontend"},
+		}
+
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(inTransaction)
+		mockPRRepo.EXPECT().ListExistingPRIDs(ctx, gomock.Len(6)).Return([]string{"pr-2"}, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "ghost").Return(nil, domain.ErrUserNotFound)
+		mockTeamRepo.EXPECT().IsMember(ctx, "frontend", "u1").Return(false, nil)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{}, nil)
+		mockReviewerRepo.EXPECT().ListReviewerCandidates(ctx, "backend").Return([]domain.ReviewerCandidate{
+			{UserID: "u2", Role: domain.TeamRoleMember},
+			{UserID: "u3", Role: domain.TeamRoleMember},
+		}, nil)
+
+		mockPRRepo.EXPECT().TransactionTime(ctx).Return(createdAt, nil)
+		mockPRRepo.EXPECT().CreatePRs(ctx, gomock.Len(1)).Return(nil)
+		mockStatsRepo.EXPECT().RecordPRsCreated(ctx, gomock.Len(1)).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Len(3)).Return(nil)
+
+		results, err := service.BatchCreatePRs(ctx, reqs)
+		require.NoError(t, err)
+		require.Len(t, results, 6)
+
+		require.NoError(t, results[0].Err)
+		assert.Equal(t, []string{"u2", "u3"}, results[0].PullRequest.AssignedReviewers)
+		assert.ErrorIs(t, results[1].Err, domain.ErrPRAlreadyExists)
+		assert.ErrorIs(t, results[2].Err, domain.ErrPRAlreadyExists)
+		assert.ErrorIs(t, results[3].Err, domain.ErrUserNotFound)
+		assert.ErrorIs(t, results[5].Err, domain.ErrNotTeamMember)
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, results[4].Err, &validationErr)
+		assert.Equal(t, "pull_request_name", validationErr.Field)
+
+		for _, result := range results[1:] {
+			assert.Nil(t, result.PullRequest)
+		}
+	})
+
+	t.Run("success - lead first, then candidates from ancestors", func(t *testing.T) {
+		reqs := []usecase.CreatePRRequest{
+			{PullRequestID: "pr-1", PullRequestName: "One", AuthorID: "u1"},
+		}
+
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(inTransaction)
+		mockPRRepo.EXPECT().ListExistingPRIDs(ctx, gomock.Any()).Return(nil, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{RequireLeadReview: true}, nil)
+		mockReviewerRepo.EXPECT().ListReviewerCandidates(ctx, "backend").Return([]domain.ReviewerCandidate{
+			{UserID: "lead", Role: domain.TeamRoleLead, OpenReviews: 5},
+		}, nil)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "backend").Return([]string{"platform"}, nil)
+		mockReviewerRepo.EXPECT().ListReviewerCandidates(ctx, "platform").Return([]domain.ReviewerCandidate{
+			{UserID: "p1", Role: domain.TeamRoleMember, OpenReviews: 2},
+			{UserID: "p2", Role: domain.TeamRoleMember},
+		}, nil)
+
+		mockPRRepo.EXPECT().TransactionTime(ctx).Return(createdAt, nil)
+		mockPRRepo.EXPECT().CreatePRs(ctx, gomock.Len(1)).Return(nil)
+		mockStatsRepo.EXPECT().RecordPRsCreated(ctx, gomock.Len(1)).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Len(3)).Return(nil)
+
+		results, err := service.BatchCreatePRs(ctx, reqs)
+		require.NoError(t, err)
+		require.NoError(t, results[0].Err)
+		assert.Equal(t, []string{"lead", "p2"}, results[0].PullRequest.AssignedReviewers)
+	})
+
+	t.Run("success - nothing to insert when every item fails", func(t *testing.T) {
+		reqs := []usecase.CreatePRRequest{
+			{PullRequestID: "pr-2", PullRequestName: "Exists", AuthorID: "u1"},
+		}
+
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(inTransaction)
+		mockPRRepo.EXPECT().ListExistingPRIDs(ctx, gomock.Any()).Return([]string{"pr-2"}, nil)
+
+		results, err := service.BatchCreatePRs(ctx, reqs)
+		require.NoError(t, err)
+		assert.ErrorIs(t, results[0].Err, domain.ErrPRAlreadyExists)
+	})
+
+	t.Run("error - empty batch", func(t *testing.T) {
+		_, err := service.BatchCreatePRs(ctx, nil)
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "pull_requests", validationErr.Field)
+	})
+
+	t.Run("error - batch too large", func(t *testing.T) {
+		_, err := service.BatchCreatePRs(ctx, make([]usecase.CreatePRRequest, domain.MaxPRBatchSize+1))
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "max", validationErr.Rule)
+	})
+
+	t.Run("error - repository failure aborts the batch", func(t *testing.T) {
+		reqs := []usecase.CreatePRRequest{
+			{PullRequestID: "pr-1", PullRequestName: "One", AuthorID: "u1"},
+		}
+
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(inTransaction)
+		mockPRRepo.EXPECT().ListExistingPRIDs(ctx, gomock.Any()).Return(nil, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(nil, errors.New("connection refused"))
+
+		results, err := service.BatchCreatePRs(ctx, reqs)
+		assert.Error(t, err)
+		assert.Nil(t, results)
+	})
+
+	t.Run("success - PR created concurrently is reported on its item", func(t *testing.T) {
+		reqs := []usecase.CreatePRRequest{
+			{PullRequestID: "pr-1", PullRequestName: "One", AuthorID: "u1"},
+			{PullRequestID: "pr-2", PullRequestName: "Two", AuthorID: "u1"},
+		}
+
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(inTransaction).Times(2)
+		gomock.InOrder(
+			mockPRRepo.EXPECT().ListExistingPRIDs(ctx, gomock.Any()).Return(nil, nil),
+			mockPRRepo.EXPECT().ListExistingPRIDs(ctx, gomock.Any()).Return([]string{"pr-1"}, nil),
+		)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil).Times(2)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{}, nil).Times(2)
+		mockReviewerRepo.EXPECT().ListReviewerCandidates(ctx, "backend").Return(nil, nil).Times(2)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "backend").Return(nil, nil).Times(2)
+
+		mockPRRepo.EXPECT().TransactionTime(ctx).Return(createdAt, nil).Times(2)
+		gomock.InOrder(
+			mockPRRepo.EXPECT().CreatePRs(ctx, gomock.Len(2)).Return(domain.ErrPRAlreadyExists),
+			mockPRRepo.EXPECT().CreatePRs(ctx, gomock.Len(1)).Return(nil),
+		)
+		mockStatsRepo.EXPECT().RecordPRsCreated(ctx, gomock.Len(1)).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Len(1)).Return(nil)
+
+		results, err := service.BatchCreatePRs(ctx, reqs)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.ErrorIs(t, results[0].Err, domain.ErrPRAlreadyExists)
+		require.NoError(t, results[1].Err)
+		assert.Equal(t, "pr-2", results[1].PullRequest.PullRequestID)
+	})
+
+	t.Run("error - repeated insert conflicts fail the whole batch", func(t *testing.T) {
+		reqs := []usecase.CreatePRRequest{
+			{PullRequestID: "pr-1", PullRequestName: "One", AuthorID: "u1"},
+		}
+
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(inTransaction).Times(maxBatchAttempts)
+		mockPRRepo.EXPECT().ListExistingPRIDs(ctx, gomock.Any()).Return(nil, nil).Times(maxBatchAttempts)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil).Times(maxBatchAttempts)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{}, nil).Times(maxBatchAttempts)
+		mockReviewerRepo.EXPECT().ListReviewerCandidates(ctx, "backend").Return(nil, nil).Times(maxBatchAttempts)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "backend").Return(nil, nil).Times(maxBatchAttempts)
+
+		mockPRRepo.EXPECT().TransactionTime(ctx).Return(createdAt, nil).Times(maxBatchAttempts)
+		mockPRRepo.EXPECT().CreatePRs(ctx, gomock.Any()).Return(domain.ErrPRAlreadyExists).Times(maxBatchAttempts)
+
+		_, err := service.BatchCreatePRs(ctx, reqs)
+		assert.ErrorIs(t, err, domain.ErrPRAlreadyExists)
+	})
+}
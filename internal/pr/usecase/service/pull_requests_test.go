@@ -23,12 +23,18 @@ func TestPRService_CreatePR(t *testing.T) {
 	mockPRRepo := mocks.NewMockPRRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockReviewerRepo := mocks.NewMockReviewerRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	mockEventRepo := mocks.NewMockEventRepository(ctrl)
 
 	mockUOW.EXPECT().PullRequests().Return(mockPRRepo).AnyTimes()
 	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
 	mockUOW.EXPECT().Reviewers().Return(mockReviewerRepo).AnyTimes()
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+	mockUOW.EXPECT().Stats().Return(mockStatsRepo).AnyTimes()
+	mockUOW.EXPECT().Events().Return(mockEventRepo).AnyTimes()
 
-	service := NewPRService(mockUOW)
+	service := NewPRService(mockUOW, nil)
 	ctx := context.Background()
 
 	t.Run("success - create PR with 2 reviewers", func(t *testing.T) {
@@ -52,6 +58,7 @@ func TestPRService_CreatePR(t *testing.T) {
 			PullRequestID:     "pr-1001",
 			PullRequestName:   "Add authentication",
 			AuthorID:          "u1",
+			TeamName:          "backend",
 			Status:            domain.PRStatusOpen,
 			AssignedReviewers: []string{"u2", "u3"},
 			CreatedAt:         &now,
@@ -66,12 +73,19 @@ func TestPRService_CreatePR(t *testing.T) {
 			})
 
 		mockPRRepo.EXPECT().CreatePR(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{}, nil)
 		mockReviewerRepo.EXPECT().
 			FindCandidatesForNewPR(ctx, "backend", "u1").
 			Return(candidates, nil)
 		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1001", "u2").Return(nil)
 		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1001", "u3").Return(nil)
 		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1001").Return(expectedPR, nil)
+		mockStatsRepo.EXPECT().RecordPRCreated(ctx, expectedPR).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, []domain.Event{
+			{Type: domain.EventPRCreated, TeamName: "backend", PullRequestID: "pr-1001", UserID: "u1"},
+			{Type: domain.EventReviewerAssigned, TeamName: "backend", PullRequestID: "pr-1001", UserID: "u2"},
+			{Type: domain.EventReviewerAssigned, TeamName: "backend", PullRequestID: "pr-1001", UserID: "u3"},
+		}).Return(nil)
 
 		result, err := service.CreatePR(ctx, req)
 
@@ -112,11 +126,15 @@ func TestPRService_CreatePR(t *testing.T) {
 				return fn(ctx)
 			})
 		mockPRRepo.EXPECT().CreatePR(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{}, nil)
 		mockReviewerRepo.EXPECT().
 			FindCandidatesForNewPR(ctx, "backend", "u1").
 			Return(candidates, nil)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "backend").Return([]string{}, nil)
 		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1002", "u2").Return(nil)
 		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1002").Return(expectedPR, nil)
+		mockStatsRepo.EXPECT().RecordPRCreated(ctx, expectedPR).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Any()).Return(nil)
 
 		result, err := service.CreatePR(ctx, req)
 
@@ -151,10 +169,14 @@ func TestPRService_CreatePR(t *testing.T) {
 				return fn(ctx)
 			})
 		mockPRRepo.EXPECT().CreatePR(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{}, nil)
 		mockReviewerRepo.EXPECT().
 			FindCandidatesForNewPR(ctx, "backend", "u1").
 			Return(candidates, nil)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "backend").Return([]string{}, nil)
 		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1003").Return(expectedPR, nil)
+		mockStatsRepo.EXPECT().RecordPRCreated(ctx, expectedPR).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Any()).Return(nil)
 
 		result, err := service.CreatePR(ctx, req)
 
@@ -162,6 +184,140 @@ func TestPRService_CreatePR(t *testing.T) {
 		assert.Empty(t, result.AssignedReviewers)
 	})
 
+	t.Run("success - fallback to parent team candidates", func(t *testing.T) {
+		req := usecase.CreatePRRequest{
+			PullRequestID:   "pr-1004",
+			PullRequestName: "Tune queries",
+			AuthorID:        "u1",
+		}
+
+		author := &domain.User{UserID: "u1", TeamName: "backend", IsActive: true}
+
+		now := time.Now()
+		expectedPR := &domain.PullRequest{
+			PullRequestID:     "pr-1004",
+			PullRequestName:   "Tune queries",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u2", "u7"},
+			CreatedAt:         &now,
+		}
+
+		mockPRRepo.EXPECT().PRExists(ctx, "pr-1004").Return(false, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil)
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockPRRepo.EXPECT().CreatePR(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{}, nil)
+		mockReviewerRepo.EXPECT().
+			FindCandidatesForNewPR(ctx, "backend", "u1").
+			Return([]string{"u2"}, nil)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "backend").Return([]string{"platform", "engineering"}, nil)
+		mockReviewerRepo.EXPECT().
+			FindCandidatesForNewPR(ctx, "platform", "u1").
+			Return([]string{"u7", "u8"}, nil)
+		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1004", "u2").Return(nil)
+		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1004", "u7").Return(nil)
+		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1004").Return(expectedPR, nil)
+		mockStatsRepo.EXPECT().RecordPRCreated(ctx, expectedPR).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Any()).Return(nil)
+
+		result, err := service.CreatePR(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"u2", "u7"}, result.AssignedReviewers)
+	})
+
+	t.Run("success - member of both squad and parent picked once", func(t *testing.T) {
+		req := usecase.CreatePRRequest{
+			PullRequestID:   "pr-1006",
+			PullRequestName: "Split payments squad",
+			AuthorID:        "u1",
+		}
+
+		author := &domain.User{UserID: "u1", TeamName: "backend", IsActive: true}
+		expectedPR := &domain.PullRequest{
+			PullRequestID:     "pr-1006",
+			PullRequestName:   "Split payments squad",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u2", "u8"},
+		}
+
+		mockPRRepo.EXPECT().PRExists(ctx, "pr-1006").Return(false, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil)
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockPRRepo.EXPECT().CreatePR(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "backend").Return(&domain.TeamSettings{}, nil)
+		mockReviewerRepo.EXPECT().
+			FindCandidatesForNewPR(ctx, "backend", "u1").
+			Return([]string{"u2"}, nil)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "backend").Return([]string{"platform"}, nil)
+		mockReviewerRepo.EXPECT().
+			FindCandidatesForNewPR(ctx, "platform", "u1").
+			Return([]string{"u2", "u8"}, nil)
+		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1006", "u2").Return(nil)
+		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1006", "u8").Return(nil)
+		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1006").Return(expectedPR, nil)
+		mockStatsRepo.EXPECT().RecordPRCreated(ctx, expectedPR).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Any()).Return(nil)
+
+		result, err := service.CreatePR(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"u2", "u8"}, result.AssignedReviewers)
+	})
+
+	t.Run("success - team lead required as reviewer", func(t *testing.T) {
+		req := usecase.CreatePRRequest{
+			PullRequestID:   "pr-1005",
+			PullRequestName: "Change billing schema",
+			AuthorID:        "u1",
+		}
+
+		author := &domain.User{UserID: "u1", TeamName: "backend", IsActive: true}
+		expectedPR := &domain.PullRequest{
+			PullRequestID:     "pr-1005",
+			PullRequestName:   "Change billing schema",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u9", "u2"},
+		}
+
+		mockPRRepo.EXPECT().PRExists(ctx, "pr-1005").Return(false, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil)
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockPRRepo.EXPECT().CreatePR(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().
+			GetTeamSettings(ctx, "backend").
+			Return(&domain.TeamSettings{RequireLeadReview: true}, nil)
+		mockReviewerRepo.EXPECT().
+			FindLeadCandidatesForNewPR(ctx, "backend", "u1").
+			Return([]string{"u9"}, nil)
+		mockReviewerRepo.EXPECT().
+			FindCandidatesForNewPR(ctx, "backend", "u1").
+			Return([]string{"u9", "u2"}, nil)
+		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1005", "u9").Return(nil)
+		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1005", "u2").Return(nil)
+		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1005").Return(expectedPR, nil)
+		mockStatsRepo.EXPECT().RecordPRCreated(ctx, expectedPR).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Any()).Return(nil)
+
+		result, err := service.CreatePR(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "u9", result.AssignedReviewers[0])
+		assert.Len(t, result.AssignedReviewers, 2)
+	})
+
 	t.Run("error - PR already exists", func(t *testing.T) {
 		req := usecase.CreatePRRequest{
 			PullRequestID:   "pr-1001",
@@ -195,6 +351,68 @@ func TestPRService_CreatePR(t *testing.T) {
 		assert.ErrorIs(t, err, domain.ErrUserNotFound)
 	})
 
+	t.Run("success - create PR in secondary team", func(t *testing.T) {
+		req := usecase.CreatePRRequest{
+			PullRequestID:   "pr-1010",
+			PullRequestName: "Shared platform fix",
+			AuthorID:        "u1",
+			TeamName:        "platform",
+		}
+
+		author := &domain.User{UserID: "u1", TeamName: "backend", IsActive: true}
+		expectedPR := &domain.PullRequest{
+			PullRequestID:     "pr-1010",
+			PullRequestName:   "Shared platform fix",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u7", "u8"},
+		}
+
+		mockPRRepo.EXPECT().PRExists(ctx, "pr-1010").Return(false, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil)
+		mockTeamRepo.EXPECT().IsMember(ctx, "platform", "u1").Return(true, nil)
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockPRRepo.EXPECT().CreatePR(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamSettings(ctx, "platform").Return(&domain.TeamSettings{}, nil)
+		mockReviewerRepo.EXPECT().
+			FindCandidatesForNewPR(ctx, "platform", "u1").
+			Return([]string{"u7", "u8"}, nil)
+		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1010", "u7").Return(nil)
+		mockReviewerRepo.EXPECT().AssignReviewer(ctx, "pr-1010", "u8").Return(nil)
+		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1010").Return(expectedPR, nil)
+		mockStatsRepo.EXPECT().RecordPRCreated(ctx, expectedPR).Return(nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Any()).Return(nil)
+
+		result, err := service.CreatePR(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"u7", "u8"}, result.AssignedReviewers)
+	})
+
+	t.Run("error - author is not a member of team", func(t *testing.T) {
+		req := usecase.CreatePRRequest{
+			PullRequestID:   "pr-1011",
+			PullRequestName: "Shared platform fix",
+			AuthorID:        "u1",
+			TeamName:        "platform",
+		}
+
+		author := &domain.User{UserID: "u1", TeamName: "backend", IsActive: true}
+
+		mockPRRepo.EXPECT().PRExists(ctx, "pr-1011").Return(false, nil)
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(author, nil)
+		mockTeamRepo.EXPECT().IsMember(ctx, "platform", "u1").Return(false, nil)
+
+		result, err := service.CreatePR(ctx, req)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrNotTeamMember)
+	})
+
 	t.Run("error - empty pull request ID", func(t *testing.T) {
 		req := usecase.CreatePRRequest{
 			PullRequestID:   "",
@@ -207,6 +425,11 @@ func TestPRService_CreatePR(t *testing.T) {
 		require.Error(t, err)
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "pull_request_id is required")
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "pull_request_id", validationErr.Field)
+		assert.Equal(t, "required", validationErr.Rule)
 	})
 
 	t.Run("error - empty pull request name", func(t *testing.T) {
@@ -244,10 +467,19 @@ func TestPRService_MergePR(t *testing.T) {
 
 	mockUOW := mocks.NewMockUnitOfWork(ctrl)
 	mockPRRepo := mocks.NewMockPRRepository(ctrl)
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	mockEventRepo := mocks.NewMockEventRepository(ctrl)
 
 	mockUOW.EXPECT().PullRequests().Return(mockPRRepo).AnyTimes()
-
-	service := NewPRService(mockUOW)
+	mockUOW.EXPECT().Stats().Return(mockStatsRepo).AnyTimes()
+	mockUOW.EXPECT().Events().Return(mockEventRepo).AnyTimes()
+	mockUOW.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
+	service := NewPRService(mockUOW, nil)
 	ctx := context.Background()
 
 	t.Run("success - merge PR", func(t *testing.T) {
@@ -256,6 +488,11 @@ func TestPRService_MergePR(t *testing.T) {
 		}
 
 		now := time.Now()
+		openPR := &domain.PullRequest{
+			PullRequestID: "pr-1001",
+			Status:        domain.PRStatusOpen,
+			CreatedAt:     &now,
+		}
 		expectedPR := &domain.PullRequest{
 			PullRequestID:     "pr-1001",
 			PullRequestName:   "Add auth",
@@ -266,10 +503,13 @@ func TestPRService_MergePR(t *testing.T) {
 			MergedAt:          &now,
 		}
 
+		mockPRRepo.EXPECT().GetPRForUpdate(ctx, "pr-1001").Return(openPR, nil).Times(1)
 		mockPRRepo.EXPECT().
 			MergePR(ctx, "pr-1001").
 			Return(expectedPR, nil).
 			Times(1)
+		mockStatsRepo.EXPECT().RecordPRMerged(ctx, expectedPR).Return(nil).Times(1)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Len(1)).Return(nil).Times(1)
 
 		result, err := service.MergePR(ctx, req)
 
@@ -292,6 +532,7 @@ func TestPRService_MergePR(t *testing.T) {
 			MergedAt:      &now,
 		}
 
+		mockPRRepo.EXPECT().GetPRForUpdate(ctx, "pr-1001").Return(alreadyMergedPR, nil).Times(1)
 		mockPRRepo.EXPECT().
 			MergePR(ctx, "pr-1001").
 			Return(alreadyMergedPR, nil).
@@ -303,13 +544,30 @@ func TestPRService_MergePR(t *testing.T) {
 		assert.Equal(t, domain.PRStatusMerged, result.Status)
 	})
 
+	t.Run("error - version mismatch", func(t *testing.T) {
+		req := usecase.MergePRRequest{
+			PullRequestID:   "pr-1001",
+			ExpectedVersion: 1,
+		}
+
+		mockPRRepo.EXPECT().
+			GetPRForUpdate(ctx, "pr-1001").
+			Return(&domain.PullRequest{PullRequestID: "pr-1001", Status: domain.PRStatusOpen, Version: 2}, nil).
+			Times(1)
+
+		result, err := service.MergePR(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrPRVersionMismatch)
+		assert.Nil(t, result)
+	})
+
 	t.Run("error - PR not found", func(t *testing.T) {
 		req := usecase.MergePRRequest{
 			PullRequestID: "nonexistent",
 		}
 
 		mockPRRepo.EXPECT().
-			MergePR(ctx, "nonexistent").
+			GetPRForUpdate(ctx, "nonexistent").
 			Return(nil, domain.ErrPRNotFound).
 			Times(1)
 
@@ -341,12 +599,24 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 	mockPRRepo := mocks.NewMockPRRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockReviewerRepo := mocks.NewMockReviewerRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	mockEventRepo := mocks.NewMockEventRepository(ctrl)
 
 	mockUOW.EXPECT().PullRequests().Return(mockPRRepo).AnyTimes()
 	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
 	mockUOW.EXPECT().Reviewers().Return(mockReviewerRepo).AnyTimes()
-
-	service := NewPRService(mockUOW)
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+	mockUOW.EXPECT().Stats().Return(mockStatsRepo).AnyTimes()
+	mockUOW.EXPECT().Events().Return(mockEventRepo).AnyTimes()
+	mockUOW.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
+	prMetrics := &fakePRMetrics{}
+	service := NewPRService(mockUOW, prMetrics)
 	ctx := context.Background()
 
 	t.Run("success - reassign reviewer", func(t *testing.T) {
@@ -359,34 +629,38 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 		openPR := &domain.PullRequest{
 			PullRequestID: "pr-1001",
 			AuthorID:      "u1",
+			TeamName:      "backend",
 			Status:        domain.PRStatusOpen,
 			CreatedAt:     &now,
 		}
 
-		oldReviewer := &domain.User{
-			UserID:   "u2",
-			TeamName: "backend",
-		}
-
 		candidates := []string{"u4"}
 
 		updatedPR := &domain.PullRequest{
 			PullRequestID:     "pr-1001",
 			AuthorID:          "u1",
+			TeamName:          "backend",
 			Status:            domain.PRStatusOpen,
 			AssignedReviewers: []string{"u3", "u4"},
 			CreatedAt:         &now,
 		}
 
-		mockPRRepo.EXPECT().GetPR(ctx, "pr-1001").Return(openPR, nil)
+		mockPRRepo.EXPECT().GetPRForUpdate(ctx, "pr-1001").Return(openPR, nil)
 		mockReviewerRepo.EXPECT().IsReviewerAssigned(ctx, "pr-1001", "u2").Return(true, nil)
-		mockUserRepo.EXPECT().GetUser(ctx, "u2").Return(oldReviewer, nil)
-		mockPRRepo.EXPECT().GetPRAuthorID(ctx, "pr-1001").Return("u1", nil)
 		mockReviewerRepo.EXPECT().
 			FindCandidatesForReassignment(ctx, "backend", "u1", "pr-1001").
 			Return(candidates, nil)
+		mockStatsRepo.EXPECT().RecordReviewerReplaced(ctx, "pr-1001", "backend", "u2", "u4").Return(nil)
 		mockReviewerRepo.EXPECT().ReplaceReviewer(ctx, "pr-1001", "u2", "u4").Return(nil)
+		mockPRRepo.EXPECT().IncrementVersion(ctx, "pr-1001").Return(int64(2), nil)
 		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1001").Return(updatedPR, nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, []domain.Event{{
+			Type:           domain.EventReviewerReassigned,
+			TeamName:       "backend",
+			PullRequestID:  "pr-1001",
+			UserID:         "u4",
+			PreviousUserID: "u2",
+		}}).Return(nil)
 
 		result, err := service.ReassignReviewer(ctx, req)
 
@@ -395,6 +669,7 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 		assert.Equal(t, "u4", result.ReplacedBy)
 		assert.Contains(t, result.PullRequest.AssignedReviewers, "u4")
 		assert.NotContains(t, result.PullRequest.AssignedReviewers, "u2")
+		assert.Equal(t, 1, prMetrics.reassigned)
 	})
 
 	t.Run("error - PR is merged", func(t *testing.T) {
@@ -410,7 +685,7 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 			MergedAt:      &now,
 		}
 
-		mockPRRepo.EXPECT().GetPR(ctx, "pr-1001").Return(mergedPR, nil)
+		mockPRRepo.EXPECT().GetPRForUpdate(ctx, "pr-1001").Return(mergedPR, nil)
 
 		result, err := service.ReassignReviewer(ctx, req)
 
@@ -430,7 +705,7 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 			Status:        domain.PRStatusOpen,
 		}
 
-		mockPRRepo.EXPECT().GetPR(ctx, "pr-1001").Return(openPR, nil)
+		mockPRRepo.EXPECT().GetPRForUpdate(ctx, "pr-1001").Return(openPR, nil)
 		mockReviewerRepo.EXPECT().IsReviewerAssigned(ctx, "pr-1001", "u5").Return(false, nil)
 
 		result, err := service.ReassignReviewer(ctx, req)
@@ -449,29 +724,121 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 		openPR := &domain.PullRequest{
 			PullRequestID: "pr-1001",
 			AuthorID:      "u1",
+			TeamName:      "backend",
 			Status:        domain.PRStatusOpen,
 		}
 
-		oldReviewer := &domain.User{
-			UserID:   "u2",
-			TeamName: "backend",
-		}
-
 		candidates := []string{}
 
-		mockPRRepo.EXPECT().GetPR(ctx, "pr-1001").Return(openPR, nil)
+		mockPRRepo.EXPECT().GetPRForUpdate(ctx, "pr-1001").Return(openPR, nil)
 		mockReviewerRepo.EXPECT().IsReviewerAssigned(ctx, "pr-1001", "u2").Return(true, nil)
-		mockUserRepo.EXPECT().GetUser(ctx, "u2").Return(oldReviewer, nil)
-		mockPRRepo.EXPECT().GetPRAuthorID(ctx, "pr-1001").Return("u1", nil)
 		mockReviewerRepo.EXPECT().
 			FindCandidatesForReassignment(ctx, "backend", "u1", "pr-1001").
 			Return(candidates, nil)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "backend").Return([]string{}, nil)
 
 		result, err := service.ReassignReviewer(ctx, req)
 
 		require.Error(t, err)
 		assert.Nil(t, result)
 		assert.ErrorIs(t, err, domain.ErrNoCandidates)
+		assert.Equal(t, 1, prMetrics.noCandidates)
+	})
+
+	t.Run("success - fallback to parent team on reassignment", func(t *testing.T) {
+		req := usecase.ReassignReviewerRequest{
+			PullRequestID: "pr-1001",
+			OldReviewerID: "u2",
+		}
+
+		openPR := &domain.PullRequest{
+			PullRequestID: "pr-1001",
+			AuthorID:      "u1",
+			TeamName:      "backend",
+			Status:        domain.PRStatusOpen,
+		}
+
+		updatedPR := &domain.PullRequest{
+			PullRequestID:     "pr-1001",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u3", "u9"},
+		}
+
+		mockPRRepo.EXPECT().GetPRForUpdate(ctx, "pr-1001").Return(openPR, nil)
+		mockReviewerRepo.EXPECT().IsReviewerAssigned(ctx, "pr-1001", "u2").Return(true, nil)
+		mockReviewerRepo.EXPECT().
+			FindCandidatesForReassignment(ctx, "backend", "u1", "pr-1001").
+			Return([]string{}, nil)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "backend").Return([]string{"platform"}, nil)
+		mockReviewerRepo.EXPECT().
+			FindCandidatesForReassignment(ctx, "platform", "u1", "pr-1001").
+			Return([]string{"u9"}, nil)
+		mockStatsRepo.EXPECT().RecordReviewerReplaced(ctx, "pr-1001", "backend", "u2", "u9").Return(nil)
+		mockReviewerRepo.EXPECT().ReplaceReviewer(ctx, "pr-1001", "u2", "u9").Return(nil)
+		mockPRRepo.EXPECT().IncrementVersion(ctx, "pr-1001").Return(int64(2), nil)
+		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1001").Return(updatedPR, nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Len(1)).Return(nil)
+
+		result, err := service.ReassignReviewer(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "u9", result.ReplacedBy)
+	})
+
+	t.Run("success - candidates come from the PR team", func(t *testing.T) {
+		req := usecase.ReassignReviewerRequest{
+			PullRequestID: "pr-1002",
+			OldReviewerID: "u2",
+		}
+
+		// u2's primary team is backend, but the PR was opened in payments.
+		openPR := &domain.PullRequest{
+			PullRequestID: "pr-1002",
+			AuthorID:      "u1",
+			TeamName:      "payments",
+			Status:        domain.PRStatusOpen,
+		}
+		updatedPR := &domain.PullRequest{
+			PullRequestID:     "pr-1002",
+			AuthorID:          "u1",
+			TeamName:          "payments",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u6"},
+		}
+
+		mockPRRepo.EXPECT().GetPRForUpdate(ctx, "pr-1002").Return(openPR, nil)
+		mockReviewerRepo.EXPECT().IsReviewerAssigned(ctx, "pr-1002", "u2").Return(true, nil)
+		mockReviewerRepo.EXPECT().
+			FindCandidatesForReassignment(ctx, "payments", "u1", "pr-1002").
+			Return([]string{"u6"}, nil)
+		mockStatsRepo.EXPECT().RecordReviewerReplaced(ctx, "pr-1002", "payments", "u2", "u6").Return(nil)
+		mockReviewerRepo.EXPECT().ReplaceReviewer(ctx, "pr-1002", "u2", "u6").Return(nil)
+		mockPRRepo.EXPECT().IncrementVersion(ctx, "pr-1002").Return(int64(2), nil)
+		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1002").Return(updatedPR, nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Len(1)).Return(nil)
+
+		result, err := service.ReassignReviewer(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "u6", result.ReplacedBy)
+	})
+
+	t.Run("error - version mismatch", func(t *testing.T) {
+		req := usecase.ReassignReviewerRequest{
+			PullRequestID:   "pr-1001",
+			OldReviewerID:   "u2",
+			ExpectedVersion: 3,
+		}
+
+		mockPRRepo.EXPECT().
+			GetPRForUpdate(ctx, "pr-1001").
+			Return(&domain.PullRequest{PullRequestID: "pr-1001", Status: domain.PRStatusOpen, Version: 4}, nil)
+
+		result, err := service.ReassignReviewer(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrPRVersionMismatch)
+		assert.Nil(t, result)
 	})
 
 	t.Run("error - PR not found", func(t *testing.T) {
@@ -480,7 +847,7 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 			OldReviewerID: "u2",
 		}
 
-		mockPRRepo.EXPECT().GetPR(ctx, "nonexistent").Return(nil, domain.ErrPRNotFound)
+		mockPRRepo.EXPECT().GetPRForUpdate(ctx, "nonexistent").Return(nil, domain.ErrPRNotFound)
 
 		result, err := service.ReassignReviewer(ctx, req)
 
@@ -516,6 +883,62 @@ func TestPRService_ReassignReviewer(t *testing.T) {
 	})
 }
 
+func TestPRService_GetPR(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockPRRepo := mocks.NewMockPRRepository(ctrl)
+
+	mockUOW.EXPECT().PullRequests().Return(mockPRRepo).AnyTimes()
+
+	service := NewPRService(mockUOW, nil)
+	ctx := context.Background()
+
+	t.Run("success - PR with reviewers and version", func(t *testing.T) {
+		expectedPR := &domain.PullRequest{
+			PullRequestID:     "pr-1001",
+			PullRequestName:   "Add auth",
+			AuthorID:          "u1",
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u2", "u3"},
+			Version:           3,
+		}
+
+		mockUOW.EXPECT().
+			WithinSnapshot(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "pr-1001").Return(expectedPR, nil)
+
+		result, err := service.GetPR(ctx, "pr-1001")
+		require.NoError(t, err)
+		assert.Equal(t, expectedPR, result)
+	})
+
+	t.Run("error - PR not found", func(t *testing.T) {
+		mockUOW.EXPECT().
+			WithinSnapshot(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockPRRepo.EXPECT().GetPRWithReviewers(ctx, "nonexistent").Return(nil, domain.ErrPRNotFound)
+
+		result, err := service.GetPR(ctx, "nonexistent")
+		assert.ErrorIs(t, err, domain.ErrPRNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - empty PR ID", func(t *testing.T) {
+		result, err := service.GetPR(ctx, "")
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Nil(t, result)
+	})
+}
+
 func TestPRService_GetReviewerPRs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -525,7 +948,7 @@ func TestPRService_GetReviewerPRs(t *testing.T) {
 
 	mockUOW.EXPECT().Reviewers().Return(mockReviewerRepo).AnyTimes()
 
-	service := NewPRService(mockUOW)
+	service := NewPRService(mockUOW, nil)
 	ctx := context.Background()
 
 	t.Run("success - get reviewer PRs", func(t *testing.T) {
@@ -591,3 +1014,55 @@ func TestPRService_GetReviewerPRs(t *testing.T) {
 		assert.Contains(t, err.Error(), "list PRs by reviewer")
 	})
 }
+
+func TestPRService_FlagStalePRs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockPRRepo := mocks.NewMockPRRepository(ctrl)
+
+	mockUOW.EXPECT().PullRequests().Return(mockPRRepo).AnyTimes()
+
+	service := NewPRService(mockUOW, nil)
+	ctx := context.Background()
+
+	t.Run("success - newly flagged PRs are returned", func(t *testing.T) {
+		flagged := []domain.PullRequestShort{
+			{PullRequestID: "pr-1001", PullRequestName: "Add auth", AuthorID: "u1", Status: domain.PRStatusOpen},
+		}
+		mockPRRepo.EXPECT().FlagStalePRs(ctx, 48*time.Hour).Return(flagged, nil).Times(1)
+
+		result, err := service.FlagStalePRs(ctx, 48*time.Hour)
+
+		require.NoError(t, err)
+		assert.Equal(t, flagged, result)
+	})
+
+	t.Run("success - zero age uses default", func(t *testing.T) {
+		mockPRRepo.EXPECT().FlagStalePRs(ctx, DefaultStaleAfter).Return([]domain.PullRequestShort{}, nil).Times(1)
+
+		result, err := service.FlagStalePRs(ctx, 0)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("error - database error", func(t *testing.T) {
+		mockPRRepo.EXPECT().FlagStalePRs(ctx, DefaultStaleAfter).Return(nil, errors.New("db error")).Times(1)
+
+		result, err := service.FlagStalePRs(ctx, 0)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "flag stale PRs")
+	})
+}
+
+type fakePRMetrics struct {
+	reassigned   int
+	noCandidates int
+}
+
+func (m *fakePRMetrics) ReviewerReassigned() { m.reassigned++ }
+func (m *fakePRMetrics) NoCandidate()        { m.noCandidates++ }
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository"
+)
+
+// ReadService gives read-only access to teams, users and PRs for clients
+// that walk the relations between them, such as the GraphQL API.
+type ReadService struct {
+	uow repository.UnitOfWork
+}
+
+func NewReadService(uow repository.UnitOfWork) *ReadService {
+	return &ReadService{uow: uow}
+}
+
+func (s *ReadService) ListTeams(ctx context.Context) ([]domain.Team, error) {
+	teams, err := s.uow.Teams().ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list teams: %w", err)
+	}
+	return teams, nil
+}
+
+func (s *ReadService) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
+	if teamName == "" {
+		return nil, domain.RequiredError("team_name")
+	}
+	return s.uow.Teams().GetTeam(ctx, teamName)
+}
+
+func (s *ReadService) GetPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	if prID == "" {
+		return nil, domain.RequiredError("pull_request_id")
+	}
+	return s.uow.PullRequests().GetPR(ctx, prID)
+}
+
+func (s *ReadService) GetUsers(ctx context.Context, userIDs []string) (map[string]*domain.User, error) {
+	if len(userIDs) == 0 {
+		return map[string]*domain.User{}, nil
+	}
+
+	users, err := s.uow.Users().GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get users: %w", err)
+	}
+
+	result := make(map[string]*domain.User, len(users))
+	for i := range users {
+		result[users[i].UserID] = &users[i]
+	}
+	return result, nil
+}
+
+func (s *ReadService) GetTeamMembers(ctx context.Context, teamNames []string) (map[string][]domain.TeamMember, error) {
+	if len(teamNames) == 0 {
+		return map[string][]domain.TeamMember{}, nil
+	}
+
+	members, err := s.uow.Teams().GetMembersByTeams(ctx, teamNames)
+	if err != nil {
+		return nil, fmt.Errorf("get team members: %w", err)
+	}
+	return members, nil
+}
+
+func (s *ReadService) GetPRReviewers(ctx context.Context, prIDs []string) (map[string][]string, error) {
+	if len(prIDs) == 0 {
+		return map[string][]string{}, nil
+	}
+
+	reviewers, err := s.uow.Reviewers().GetAssignedReviewersByPRs(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get PR reviewers: %w", err)
+	}
+	return reviewers, nil
+}
+
+func (s *ReadService) GetReviewerPRs(ctx context.Context, reviewerIDs []string) (map[string][]domain.PullRequest, error) {
+	if len(reviewerIDs) == 0 {
+		return map[string][]domain.PullRequest{}, nil
+	}
+
+	prs, err := s.uow.Reviewers().ListPRsByReviewers(ctx, reviewerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get reviewer PRs: %w", err)
+	}
+	return prs, nil
+}
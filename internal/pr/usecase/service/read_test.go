@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/mocks"
+)
+
+func TestReadService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockReviewerRepo := mocks.NewMockReviewerRepository(ctrl)
+
+	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+	mockUOW.EXPECT().Reviewers().Return(mockReviewerRepo).AnyTimes()
+
+	service := NewReadService(mockUOW)
+	ctx := context.Background()
+
+	t.Run("success - users are keyed by id", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetUsersByIDs(ctx, []string{"u1", "u2", "ghost"}).Return([]domain.User{
+			{UserID: "u1", Username: "Alice"},
+			{UserID: "u2", Username: "Bob"},
+		}, nil)
+
+		users, err := service.GetUsers(ctx, []string{"u1", "u2", "ghost"})
+		require.NoError(t, err)
+		assert.Len(t, users, 2)
+		assert.Equal(t, "Bob", users["u2"].Username)
+		assert.NotContains(t, users, "ghost")
+	})
+
+	t.Run("success - empty input does not hit the database", func(t *testing.T) {
+		users, err := service.GetUsers(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, users)
+
+		members, err := service.GetTeamMembers(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, members)
+
+		reviewers, err := service.GetPRReviewers(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, reviewers)
+
+		prs, err := service.GetReviewerPRs(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, prs)
+	})
+
+	t.Run("error - repository failure", func(t *testing.T) {
+		mockReviewerRepo.EXPECT().
+			ListPRsByReviewers(ctx, []string{"u1"}).
+			Return(nil, errors.New("connection refused"))
+
+		_, err := service.GetReviewerPRs(ctx, []string{"u1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("error - team name is required", func(t *testing.T) {
+		_, err := service.GetTeam(ctx, "")
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "team_name", validationErr.Field)
+	})
+}
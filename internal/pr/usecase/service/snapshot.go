@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository"
+)
+
+type SnapshotService struct {
+	uow repository.UnitOfWork
+}
+
+func NewSnapshotService(uow repository.UnitOfWork) *SnapshotService {
+	return &SnapshotService{uow: uow}
+}
+
+func (s *SnapshotService) Export(ctx context.Context) (*domain.Snapshot, error) {
+	var snapshot domain.Snapshot
+	err := s.uow.WithinSnapshot(ctx, func(txCtx context.Context) error {
+		var err error
+
+		snapshot.Teams, err = s.uow.Snapshots().ListTeams(txCtx)
+		if err != nil {
+			return err
+		}
+
+		snapshot.Users, err = s.uow.Snapshots().ListUsers(txCtx)
+		if err != nil {
+			return err
+		}
+
+		snapshot.Memberships, err = s.uow.Snapshots().ListMemberships(txCtx)
+		if err != nil {
+			return err
+		}
+
+		snapshot.PullRequests, err = s.uow.Snapshots().ListPullRequests(txCtx)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+func (s *SnapshotService) Import(ctx context.Context, snapshot *domain.Snapshot) error {
+	if snapshot == nil {
+		return domain.RequiredError("snapshot")
+	}
+
+	return s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
+		hasData, err := s.uow.Snapshots().HasData(txCtx)
+		if err != nil {
+			return fmt.Errorf("check database is empty: %w", err)
+		}
+		if hasData {
+			return domain.ErrDatabaseNotEmpty
+		}
+
+		for _, team := range snapshot.Teams {
+			if err := s.uow.Teams().CreateTeam(txCtx, team.TeamName); err != nil {
+				return fmt.Errorf("restore team %s: %w", team.TeamName, err)
+			}
+		}
+
+		for _, team := range snapshot.Teams {
+			if team.ParentTeamName != "" {
+				if err := s.uow.Teams().SetParentTeam(txCtx, team.TeamName, team.ParentTeamName); err != nil {
+					return fmt.Errorf("restore parent of team %s: %w", team.TeamName, err)
+				}
+			}
+			if team.Settings != (domain.TeamSettings{}) {
+				if err := s.uow.Teams().UpdateTeamSettings(txCtx, team.TeamName, team.Settings); err != nil {
+					return fmt.Errorf("restore settings of team %s: %w", team.TeamName, err)
+				}
+			}
+		}
+
+		for i := range snapshot.Users {
+			if err := s.uow.Users().UpsertUser(txCtx, &snapshot.Users[i]); err != nil {
+				return fmt.Errorf("restore user %s: %w", snapshot.Users[i].UserID, err)
+			}
+		}
+
+		for _, m := range snapshot.Memberships {
+			if err := s.uow.Teams().AddMember(txCtx, m.TeamName, m.UserID, m.Role); err != nil {
+				return fmt.Errorf("restore membership %s/%s: %w", m.TeamName, m.UserID, err)
+			}
+		}
+
+		for i := range snapshot.PullRequests {
+			pr := &snapshot.PullRequests[i]
+			if err := s.uow.Snapshots().RestorePullRequest(txCtx, pr); err != nil {
+				return fmt.Errorf("restore PR %s: %w", pr.PullRequestID, err)
+			}
+		}
+
+		if err := s.uow.Stats().RebuildAggregates(txCtx); err != nil {
+			return fmt.Errorf("rebuild stats aggregates: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/mocks"
+)
+
+func TestSnapshotService_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockSnapshotRepo := mocks.NewMockSnapshotRepository(ctrl)
+
+	mockUOW.EXPECT().Snapshots().Return(mockSnapshotRepo).AnyTimes()
+
+	service := NewSnapshotService(mockUOW)
+	ctx := context.Background()
+
+	t.Run("success - export all data", func(t *testing.T) {
+		createdAt := time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+		mergedAt := time.Date(2025, 11, 2, 12, 30, 0, 0, time.UTC)
+
+		mockUOW.EXPECT().
+			WithinSnapshot(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockSnapshotRepo.EXPECT().ListTeams(ctx).Return([]domain.Team{{TeamName: "backend"}}, nil)
+		mockSnapshotRepo.EXPECT().ListUsers(ctx).Return([]domain.User{
+			{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+			{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		}, nil)
+		mockSnapshotRepo.EXPECT().ListMemberships(ctx).Return([]domain.TeamMembership{
+			{TeamName: "backend", UserID: "u1", Role: domain.TeamRoleMember},
+			{TeamName: "backend", UserID: "u2", Role: domain.TeamRoleMember},
+		}, nil)
+		mockSnapshotRepo.EXPECT().ListPullRequests(ctx).Return([]domain.PullRequest{
+			{
+				PullRequestID:     "pr-1001",
+				PullRequestName:   "Add authentication",
+				AuthorID:          "u1",
+				Status:            domain.PRStatusMerged,
+				AssignedReviewers: []string{"u2"},
+				CreatedAt:         &createdAt,
+				MergedAt:          &mergedAt,
+			},
+		}, nil)
+
+		result, err := service.Export(ctx)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Len(t, result.Teams, 1)
+		assert.Len(t, result.Users, 2)
+		assert.Len(t, result.Memberships, 2)
+		require.Len(t, result.PullRequests, 1)
+		assert.Equal(t, createdAt, *result.PullRequests[0].CreatedAt)
+		assert.Equal(t, mergedAt, *result.PullRequests[0].MergedAt)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		mockUOW.EXPECT().
+			WithinSnapshot(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockSnapshotRepo.EXPECT().ListTeams(ctx).Return(nil, errors.New("db error"))
+
+		result, err := service.Export(ctx)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSnapshotService_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockSnapshotRepo := mocks.NewMockSnapshotRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+
+	mockUOW.EXPECT().Snapshots().Return(mockSnapshotRepo).AnyTimes()
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
+	mockUOW.EXPECT().Stats().Return(mockStatsRepo).AnyTimes()
+
+	service := NewSnapshotService(mockUOW)
+	ctx := context.Background()
+
+	createdAt := time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+	snapshot := &domain.Snapshot{
+		Teams: []domain.Team{{TeamName: "backend"}},
+		Users: []domain.User{
+			{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		},
+		Memberships: []domain.TeamMembership{
+			{TeamName: "backend", UserID: "u1", Role: domain.TeamRoleMember},
+		},
+		PullRequests: []domain.PullRequest{
+			{
+				PullRequestID:   "pr-1001",
+				PullRequestName: "Add authentication",
+				AuthorID:        "u1",
+				Status:          domain.PRStatusOpen,
+				CreatedAt:       &createdAt,
+			},
+		},
+	}
+
+	t.Run("success - import into empty database", func(t *testing.T) {
+		mockUOW.EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockSnapshotRepo.EXPECT().HasData(ctx).Return(false, nil)
+		mockTeamRepo.EXPECT().CreateTeam(ctx, "backend").Return(nil)
+		mockUserRepo.EXPECT().UpsertUser(ctx, &snapshot.Users[0]).Return(nil)
+		mockTeamRepo.EXPECT().AddMember(ctx, "backend", "u1", domain.TeamRoleMember).Return(nil)
+		mockSnapshotRepo.EXPECT().RestorePullRequest(ctx, &snapshot.PullRequests[0]).Return(nil)
+		mockStatsRepo.EXPECT().RebuildAggregates(ctx).Return(nil)
+
+		err := service.Import(ctx, snapshot)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error - database not empty", func(t *testing.T) {
+		mockUOW.EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockSnapshotRepo.EXPECT().HasData(ctx).Return(true, nil)
+
+		err := service.Import(ctx, snapshot)
+
+		require.ErrorIs(t, err, domain.ErrDatabaseNotEmpty)
+	})
+
+	t.Run("error - restore PR fails", func(t *testing.T) {
+		mockUOW.EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockSnapshotRepo.EXPECT().HasData(ctx).Return(false, nil)
+		mockTeamRepo.EXPECT().CreateTeam(ctx, "backend").Return(nil)
+		mockUserRepo.EXPECT().UpsertUser(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().AddMember(ctx, "backend", "u1", domain.TeamRoleMember).Return(nil)
+		mockSnapshotRepo.EXPECT().
+			RestorePullRequest(ctx, gomock.Any()).
+			Return(errors.New("foreign key violation"))
+
+		err := service.Import(ctx, snapshot)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "restore PR pr-1001")
+	})
+
+	t.Run("error - nil snapshot", func(t *testing.T) {
+		err := service.Import(ctx, nil)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "snapshot is required")
+	})
+}
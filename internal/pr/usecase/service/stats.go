@@ -2,29 +2,272 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase/repository"
 )
 
+// DefaultCycleTimeBuckets are the histogram bounds used when the caller does
+// not provide its own.
+var DefaultCycleTimeBuckets = []time.Duration{
+	time.Hour,
+	4 * time.Hour,
+	24 * time.Hour,
+	3 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+}
+
+// DefaultStaleAfter is the age after which an open PR is reported as stale
+// when neither the request nor the team settings say otherwise.
+const DefaultStaleAfter = 72 * time.Hour
+
+// StatsService answers user, PR, time series and fairness statistics from
+// the daily aggregate tables whenever the filter is day-aligned and falls
+// back to the live tables otherwise.
 type StatsService struct {
-	statsRepo repository.StatsRepository
+	statsRepo  repository.StatsRepository
+	transactor repository.Transactor
+	staleAfter time.Duration
 }
 
-func NewStatsService(statsRepo repository.StatsRepository) *StatsService {
+// NewStatsService creates the service; a zero staleAfter selects
+// DefaultStaleAfter.
+func NewStatsService(statsRepo repository.StatsRepository, transactor repository.Transactor, staleAfter time.Duration) *StatsService {
+	if staleAfter <= 0 {
+		staleAfter = DefaultStaleAfter
+	}
 	return &StatsService{
-		statsRepo: statsRepo,
+		statsRepo:  statsRepo,
+		transactor: transactor,
+		staleAfter: staleAfter,
+	}
+}
+
+func (s *StatsService) GetUserAssignmentStats(ctx context.Context, filter domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	if filter.DayAligned() {
+		return s.statsRepo.GetAggregatedUserAssignmentStats(ctx, filter)
+	}
+	return s.statsRepo.GetUserAssignmentStats(ctx, filter)
+}
+
+func (s *StatsService) GetPRStats(ctx context.Context, filter domain.StatsFilter) (*domain.PRStats, error) {
+	if filter.DayAligned() {
+		return s.statsRepo.GetAggregatedPRStats(ctx, filter)
+	}
+	return s.statsRepo.GetPRStats(ctx, filter)
+}
+
+// GetFreshness returns when the aggregates serving the filter were last
+// updated, or nil when the filter is answered from the live tables.
+func (s *StatsService) GetFreshness(ctx context.Context, filter domain.StatsFilter) (*domain.StatsFreshness, error) {
+	if !filter.DayAligned() {
+		return nil, nil
+	}
+	return s.statsRepo.GetFreshness(ctx)
+}
+
+func (s *StatsService) RebuildAggregates(ctx context.Context) error {
+	return s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		return s.statsRepo.RebuildAggregates(txCtx)
+	})
+}
+
+func (s *StatsService) GetReviewerWorkload(ctx context.Context, filter domain.StatsFilter, sort domain.WorkloadSort) ([]domain.ReviewerWorkload, error) {
+	if sort == "" {
+		sort = domain.WorkloadSortOpenPRs
+	}
+	return s.statsRepo.GetReviewerWorkload(ctx, filter, sort)
+}
+
+func (s *StatsService) GetTeamRollupStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamRollupStats, error) {
+	return s.statsRepo.GetTeamRollupStats(ctx, filter)
+}
+
+func (s *StatsService) GetReviewerPairs(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerPair, error) {
+	return s.statsRepo.GetReviewerPairs(ctx, filter)
+}
+
+// GetFairnessReport groups member assignment counts by team and computes the
+// spread of each distribution. Rows arrive ordered by team name.
+func (s *StatsService) GetFairnessReport(ctx context.Context, filter domain.StatsFilter, thresholdPct float64) ([]domain.TeamFairness, error) {
+	getCounts := s.statsRepo.GetTeamMemberAssignmentCounts
+	if filter.DayAligned() {
+		getCounts = s.statsRepo.GetAggregatedTeamMemberAssignmentCounts
+	}
+
+	counts, err := getCounts(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("get team member assignment counts: %w", err)
+	}
+
+	report := []domain.TeamFairness{}
+	for start := 0; start < len(counts); {
+		end := start
+		for end < len(counts) && counts[end].TeamName == counts[start].TeamName {
+			end++
+		}
+		report = append(report, teamFairness(counts[start:end], thresholdPct))
+		start = end
+	}
+	return report, nil
+}
+
+func teamFairness(members []domain.UserAssignmentStats, thresholdPct float64) domain.TeamFairness {
+	values := make([]int64, len(members))
+	var total int64
+	for i, m := range members {
+		values[i] = m.AssignmentsCount
+		total += m.AssignmentsCount
+	}
+	slices.Sort(values)
+
+	n := float64(len(values))
+	mean := float64(total) / n
+
+	var variance, weighted float64
+	for i, v := range values {
+		diff := float64(v) - mean
+		variance += diff * diff
+		weighted += float64(i+1) * float64(v)
+	}
+
+	// Gini coefficient over values sorted in ascending order:
+	// G = 2*sum(i*x_i) / (n*sum(x)) - (n+1)/n.
+	var gini float64
+	if total > 0 {
+		gini = 2*weighted/(n*float64(total)) - (n+1)/n
+	}
+
+	limit := mean * (1 + thresholdPct/100)
+	overloaded := []domain.UserAssignmentStats{}
+	for _, m := range members {
+		if float64(m.AssignmentsCount) > limit {
+			overloaded = append(overloaded, m)
+		}
 	}
+
+	return domain.TeamFairness{
+		TeamName:         members[0].TeamName,
+		MembersCount:     len(members),
+		TotalAssignments: total,
+		Min:              values[0],
+		Max:              values[len(values)-1],
+		Mean:             mean,
+		StdDev:           math.Sqrt(variance / n),
+		Gini:             gini,
+		Overloaded:       overloaded,
+	}
+}
+
+func (s *StatsService) GetCycleTimeStats(ctx context.Context, filter domain.StatsFilter, buckets []time.Duration) (*domain.CycleTimeStats, error) {
+	if len(buckets) == 0 {
+		buckets = DefaultCycleTimeBuckets
+	}
+
+	teams, err := s.statsRepo.GetTeamCycleTimes(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("get team cycle times: %w", err)
+	}
+
+	authors, err := s.statsRepo.GetAuthorCycleTimes(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("get author cycle times: %w", err)
+	}
+
+	histogram, err := s.statsRepo.GetCycleTimeHistogram(ctx, filter, buckets)
+	if err != nil {
+		return nil, fmt.Errorf("get cycle time histogram: %w", err)
+	}
+
+	return &domain.CycleTimeStats{
+		Teams:     teams,
+		Authors:   authors,
+		Histogram: histogram,
+	}, nil
 }
 
-func (s *StatsService) GetUserAssignmentStats(ctx context.Context) ([]domain.UserAssignmentStats, error) {
-	return s.statsRepo.GetUserAssignmentStats(ctx)
+// maxTimeSeriesPoints caps the length of a zero-filled series, so that a wide
+// range with a short interval is rejected instead of being allocated.
+const maxTimeSeriesPoints = 1000
+
+// GetTimeSeries returns one point per interval. Intervals without activity
+// are filled with zeros so that the series covers the requested range, or the
+// range between the first and last recorded activity when it is open-ended.
+func (s *StatsService) GetTimeSeries(ctx context.Context, filter domain.StatsFilter, interval domain.StatsInterval) ([]domain.TimeSeriesPoint, error) {
+	// A closed range is checked before the query, an open one once the data
+	// has given it its bounds.
+	if filter.From != nil && filter.To != nil {
+		start := interval.Truncate(*filter.From)
+		end := interval.Truncate(filter.To.Add(-time.Nanosecond))
+		if err := checkSeriesLength(start, end, interval); err != nil {
+			return nil, err
+		}
+	}
+
+	getPoints := s.statsRepo.GetTimeSeries
+	if filter.DayAligned() {
+		getPoints = s.statsRepo.GetAggregatedTimeSeries
+	}
+
+	points, err := getPoints(ctx, filter, interval)
+	if err != nil {
+		return nil, fmt.Errorf("get time series: %w", err)
+	}
+
+	var start, end time.Time
+	if len(points) > 0 {
+		start = points[0].Bucket
+		end = points[len(points)-1].Bucket
+	}
+	if filter.From != nil {
+		start = interval.Truncate(*filter.From)
+	}
+	if filter.To != nil {
+		end = interval.Truncate(filter.To.Add(-time.Nanosecond))
+	}
+	if start.IsZero() || end.Before(start) {
+		return points, nil
+	}
+	if err := checkSeriesLength(start, end, interval); err != nil {
+		return nil, err
+	}
+
+	byBucket := make(map[time.Time]domain.TimeSeriesPoint, len(points))
+	for _, p := range points {
+		byBucket[p.Bucket] = p
+	}
+
+	series := make([]domain.TimeSeriesPoint, 0, len(points))
+	for bucket := start; !bucket.After(end); bucket = interval.Next(bucket) {
+		p, ok := byBucket[bucket]
+		if !ok {
+			p = domain.TimeSeriesPoint{Bucket: bucket}
+		}
+		series = append(series, p)
+	}
+	return series, nil
 }
 
-func (s *StatsService) GetPRStats(ctx context.Context) (*domain.PRStats, error) {
-	return s.statsRepo.GetPRStats(ctx)
+// checkSeriesLength rejects a series from start to end with more than
+// maxTimeSeriesPoints points, without stepping past the limit.
+func checkSeriesLength(start, end time.Time, interval domain.StatsInterval) error {
+	n := 0
+	for bucket := start; !bucket.After(end); bucket = interval.Next(bucket) {
+		n++
+		if n > maxTimeSeriesPoints {
+			return domain.NewValidationError("interval", "max", fmt.Sprintf(
+				"covers more than %d points; narrow the range or use a longer interval", maxTimeSeriesPoints))
+		}
+	}
+	return nil
 }
 
-func (s *StatsService) GetReviewerWorkload(ctx context.Context) ([]domain.ReviewerWorkload, error) {
-	return s.statsRepo.GetReviewerWorkload(ctx)
+// GetStalePRs lists open PRs older than olderThan, or than each team's own
+// threshold when olderThan is zero.
+func (s *StatsService) GetStalePRs(ctx context.Context, filter domain.StatsFilter, olderThan time.Duration) ([]domain.StalePR, error) {
+	return s.statsRepo.GetStalePRs(ctx, filter, olderThan, s.staleAfter)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,32 +19,63 @@ func TestStatsService_GetUserAssignmentStats(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
-	service := NewStatsService(mockStatsRepo)
+	service := NewStatsService(mockStatsRepo, nil, 0)
 	ctx := context.Background()
 
 	t.Run("success - return user assignment stats", func(t *testing.T) {
 		mockStatsRepo.EXPECT().
-			GetUserAssignmentStats(ctx).
+			GetAggregatedUserAssignmentStats(ctx, domain.StatsFilter{}).
 			Return([]domain.UserAssignmentStats{
 				{UserID: "u1", Username: "Alice", TeamName: "backend", AssignmentsCount: 3},
 				{UserID: "u2", Username: "Bob", TeamName: "backend", AssignmentsCount: 1},
 			}, nil).
 			Times(1)
 
-		result, err := service.GetUserAssignmentStats(ctx)
+		result, err := service.GetUserAssignmentStats(ctx, domain.StatsFilter{})
 		require.NoError(t, err)
 		assert.Len(t, result, 2)
 		assert.Equal(t, "u1", result[0].UserID)
 		assert.Equal(t, int64(3), result[0].AssignmentsCount)
 	})
 
+	t.Run("success - filter is passed to repository", func(t *testing.T) {
+		from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+		filter := domain.StatsFilter{From: &from, To: &to, TeamName: "backend"}
+
+		mockStatsRepo.EXPECT().
+			GetAggregatedUserAssignmentStats(ctx, filter).
+			Return([]domain.UserAssignmentStats{
+				{UserID: "u1", Username: "Alice", TeamName: "backend", AssignmentsCount: 1},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetUserAssignmentStats(ctx, filter)
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("success - filter not aligned to days uses live tables", func(t *testing.T) {
+		from := time.Date(2025, 10, 1, 12, 30, 0, 0, time.UTC)
+		filter := domain.StatsFilter{From: &from}
+
+		mockStatsRepo.EXPECT().
+			GetUserAssignmentStats(ctx, filter).
+			Return([]domain.UserAssignmentStats{}, nil).
+			Times(1)
+
+		result, err := service.GetUserAssignmentStats(ctx, filter)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
 	t.Run("error - repository error", func(t *testing.T) {
 		mockStatsRepo.EXPECT().
-			GetUserAssignmentStats(ctx).
+			GetAggregatedUserAssignmentStats(ctx, domain.StatsFilter{}).
 			Return(nil, errors.New("db error")).
 			Times(1)
 
-		result, err := service.GetUserAssignmentStats(ctx)
+		result, err := service.GetUserAssignmentStats(ctx, domain.StatsFilter{})
 		require.Error(t, err)
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "db error")
@@ -55,16 +87,16 @@ func TestStatsService_GetPRStats(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
-	service := NewStatsService(mockStatsRepo)
+	service := NewStatsService(mockStatsRepo, nil, 0)
 	ctx := context.Background()
 
 	t.Run("success - return PR stats", func(t *testing.T) {
 		mockStatsRepo.EXPECT().
-			GetPRStats(ctx).
+			GetAggregatedPRStats(ctx, domain.StatsFilter{}).
 			Return(&domain.PRStats{TotalPRs: 5, OpenPRs: 2, MergedPRs: 3}, nil).
 			Times(1)
 
-		result, err := service.GetPRStats(ctx)
+		result, err := service.GetPRStats(ctx, domain.StatsFilter{})
 		require.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, int64(5), result.TotalPRs)
@@ -74,47 +106,516 @@ func TestStatsService_GetPRStats(t *testing.T) {
 
 	t.Run("error - repository error", func(t *testing.T) {
 		mockStatsRepo.EXPECT().
-			GetPRStats(ctx).
+			GetAggregatedPRStats(ctx, domain.StatsFilter{}).
 			Return(nil, errors.New("db error")).
 			Times(1)
 
-		result, err := service.GetPRStats(ctx)
+		result, err := service.GetPRStats(ctx, domain.StatsFilter{})
 		require.Error(t, err)
 		assert.Nil(t, result)
 	})
 }
 
+func TestStatsService_GetFreshness(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(mockStatsRepo, nil, 0)
+	ctx := context.Background()
+
+	t.Run("success - day-aligned filter returns freshness", func(t *testing.T) {
+		updatedAt := time.Date(2025, 10, 5, 9, 0, 0, 0, time.UTC)
+		mockStatsRepo.EXPECT().
+			GetFreshness(ctx).
+			Return(&domain.StatsFreshness{UpdatedAt: updatedAt, RebuiltAt: updatedAt}, nil).
+			Times(1)
+
+		result, err := service.GetFreshness(ctx, domain.StatsFilter{})
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, updatedAt, result.UpdatedAt)
+	})
+
+	t.Run("success - live filter has no freshness", func(t *testing.T) {
+		to := time.Date(2025, 10, 5, 9, 0, 0, 0, time.UTC)
+
+		result, err := service.GetFreshness(ctx, domain.StatsFilter{To: &to})
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestStatsService_RebuildAggregates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(mockStatsRepo, mockUOW, 0)
+	ctx := context.Background()
+
+	t.Run("success - rebuild runs in transaction", func(t *testing.T) {
+		mockUOW.EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).
+			Times(1)
+		mockStatsRepo.EXPECT().RebuildAggregates(ctx).Return(nil).Times(1)
+
+		require.NoError(t, service.RebuildAggregates(ctx))
+	})
+
+	t.Run("error - rebuild fails", func(t *testing.T) {
+		mockUOW.EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).
+			Times(1)
+		mockStatsRepo.EXPECT().RebuildAggregates(ctx).Return(errors.New("db error")).Times(1)
+
+		err := service.RebuildAggregates(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "db error")
+	})
+}
+
 func TestStatsService_GetReviewerWorkload(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
-	service := NewStatsService(mockStatsRepo)
+	service := NewStatsService(mockStatsRepo, nil, 0)
 	ctx := context.Background()
 
 	t.Run("success - return reviewer workload", func(t *testing.T) {
 		mockStatsRepo.EXPECT().
-			GetReviewerWorkload(ctx).
+			GetReviewerWorkload(ctx, domain.StatsFilter{}, domain.WorkloadSortOpenPRs).
 			Return([]domain.ReviewerWorkload{
 				{UserID: "u1", Username: "Alice", TeamName: "backend", OpenPRsCount: 2},
 				{UserID: "u2", Username: "Bob", TeamName: "backend", OpenPRsCount: 1},
 			}, nil).
 			Times(1)
 
-		result, err := service.GetReviewerWorkload(ctx)
+		result, err := service.GetReviewerWorkload(ctx, domain.StatsFilter{}, "")
 		require.NoError(t, err)
 		assert.Len(t, result, 2)
 		assert.Equal(t, int64(2), result[0].OpenPRsCount)
 		assert.Equal(t, "Alice", result[0].Username)
 	})
 
+	t.Run("success - sort is passed to repository", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetReviewerWorkload(ctx, domain.StatsFilter{}, domain.WorkloadSortOldestOpen).
+			Return([]domain.ReviewerWorkload{
+				{UserID: "u2", Username: "Bob", TeamName: "backend", OpenPRsCount: 1, OldestOpenAge: 72 * time.Hour},
+				{UserID: "u1", Username: "Alice", TeamName: "backend", OpenPRsCount: 2, OldestOpenAge: 5 * time.Hour},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetReviewerWorkload(ctx, domain.StatsFilter{}, domain.WorkloadSortOldestOpen)
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "u2", result[0].UserID)
+		assert.Equal(t, 72*time.Hour, result[0].OldestOpenAge)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetReviewerWorkload(ctx, domain.StatsFilter{}, domain.WorkloadSortOpenPRs).
+			Return(nil, errors.New("db error")).
+			Times(1)
+
+		result, err := service.GetReviewerWorkload(ctx, domain.StatsFilter{}, "")
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestStatsService_GetTeamRollupStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(mockStatsRepo, nil, 0)
+	ctx := context.Background()
+
+	t.Run("success - return rolled up team stats", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetTeamRollupStats(ctx, domain.StatsFilter{}).
+			Return([]domain.TeamRollupStats{
+				{TeamName: "backend", ParentTeamName: "engineering", TeamsCount: 1, MembersCount: 3, OpenPRs: 2},
+				{TeamName: "engineering", TeamsCount: 3, MembersCount: 8, OpenPRs: 5, MergedPRs: 4},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetTeamRollupStats(ctx, domain.StatsFilter{})
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, int64(3), result[1].TeamsCount)
+		assert.Equal(t, int64(8), result[1].MembersCount)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetTeamRollupStats(ctx, domain.StatsFilter{}).
+			Return(nil, errors.New("db error")).
+			Times(1)
+
+		result, err := service.GetTeamRollupStats(ctx, domain.StatsFilter{})
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestStatsService_GetCycleTimeStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(mockStatsRepo, nil, 0)
+	ctx := context.Background()
+	filter := domain.StatsFilter{TeamName: "backend"}
+
+	t.Run("success - default buckets", func(t *testing.T) {
+		summary := domain.CycleTimeSummary{
+			MergedPRs: 4,
+			Mean:      3 * time.Hour,
+			Median:    2 * time.Hour,
+			P90:       6 * time.Hour,
+			P99:       8 * time.Hour,
+		}
+		mockStatsRepo.EXPECT().
+			GetTeamCycleTimes(ctx, filter).
+			Return([]domain.TeamCycleTime{{TeamName: "backend", CycleTimeSummary: summary}}, nil).
+			Times(1)
+		mockStatsRepo.EXPECT().
+			GetAuthorCycleTimes(ctx, filter).
+			Return([]domain.AuthorCycleTime{{UserID: "u1", Username: "Alice", TeamName: "backend", CycleTimeSummary: summary}}, nil).
+			Times(1)
+		mockStatsRepo.EXPECT().
+			GetCycleTimeHistogram(ctx, filter, DefaultCycleTimeBuckets).
+			Return([]domain.CycleTimeBucket{{To: &DefaultCycleTimeBuckets[0], Count: 1}}, nil).
+			Times(1)
+
+		result, err := service.GetCycleTimeStats(ctx, filter, nil)
+		require.NoError(t, err)
+		require.Len(t, result.Teams, 1)
+		assert.Equal(t, 2*time.Hour, result.Teams[0].Median)
+		require.Len(t, result.Authors, 1)
+		assert.Equal(t, "u1", result.Authors[0].UserID)
+		require.Len(t, result.Histogram, 1)
+		assert.Equal(t, int64(1), result.Histogram[0].Count)
+	})
+
+	t.Run("success - custom buckets are passed to repository", func(t *testing.T) {
+		buckets := []time.Duration{30 * time.Minute, 2 * time.Hour}
+
+		mockStatsRepo.EXPECT().GetTeamCycleTimes(ctx, filter).Return(nil, nil).Times(1)
+		mockStatsRepo.EXPECT().GetAuthorCycleTimes(ctx, filter).Return(nil, nil).Times(1)
+		mockStatsRepo.EXPECT().
+			GetCycleTimeHistogram(ctx, filter, buckets).
+			Return([]domain.CycleTimeBucket{{}, {}, {}}, nil).
+			Times(1)
+
+		result, err := service.GetCycleTimeStats(ctx, filter, buckets)
+		require.NoError(t, err)
+		assert.Len(t, result.Histogram, 3)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetTeamCycleTimes(ctx, filter).
+			Return(nil, errors.New("db error")).
+			Times(1)
+
+		result, err := service.GetCycleTimeStats(ctx, filter, nil)
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "db error")
+	})
+}
+
+func TestStatsService_GetTimeSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(mockStatsRepo, nil, 0)
+	ctx := context.Background()
+
+	day := func(d int) time.Time {
+		return time.Date(2025, 10, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("success - gaps between points are filled", func(t *testing.T) {
+		filter := domain.StatsFilter{}
+		mockStatsRepo.EXPECT().
+			GetAggregatedTimeSeries(ctx, filter, domain.StatsIntervalDay).
+			Return([]domain.TimeSeriesPoint{
+				{Bucket: day(1), PRsOpened: 2, Assignments: 4},
+				{Bucket: day(3), PRsMerged: 1},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetTimeSeries(ctx, filter, domain.StatsIntervalDay)
+		require.NoError(t, err)
+		require.Len(t, result, 3)
+		assert.Equal(t, int64(2), result[0].PRsOpened)
+		assert.Equal(t, domain.TimeSeriesPoint{Bucket: day(2)}, result[1])
+		assert.Equal(t, int64(1), result[2].PRsMerged)
+	})
+
+	t.Run("success - series covers requested range", func(t *testing.T) {
+		from := day(1)
+		to := day(15)
+		filter := domain.StatsFilter{From: &from, To: &to}
+		mockStatsRepo.EXPECT().
+			GetAggregatedTimeSeries(ctx, filter, domain.StatsIntervalWeek).
+			Return([]domain.TimeSeriesPoint{
+				{Bucket: day(6), PRsOpened: 1},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetTimeSeries(ctx, filter, domain.StatsIntervalWeek)
+		require.NoError(t, err)
+		require.Len(t, result, 3)
+		assert.Equal(t, time.Date(2025, 9, 29, 0, 0, 0, 0, time.UTC), result[0].Bucket)
+		assert.Equal(t, int64(1), result[1].PRsOpened)
+		assert.Equal(t, day(13), result[2].Bucket)
+	})
+
+	t.Run("success - no activity and open range", func(t *testing.T) {
+		filter := domain.StatsFilter{TeamName: "backend"}
+		mockStatsRepo.EXPECT().
+			GetAggregatedTimeSeries(ctx, filter, domain.StatsIntervalMonth).
+			Return([]domain.TimeSeriesPoint{}, nil).
+			Times(1)
+
+		result, err := service.GetTimeSeries(ctx, filter, domain.StatsIntervalMonth)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("error - range needs too many points", func(t *testing.T) {
+		from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := day(1)
+		filter := domain.StatsFilter{From: &from, To: &to}
+
+		result, err := service.GetTimeSeries(ctx, filter, domain.StatsIntervalDay)
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "interval", validationErr.Field)
+		assert.Nil(t, result)
+
+		// The same range fits with monthly points.
+		mockStatsRepo.EXPECT().
+			GetAggregatedTimeSeries(ctx, filter, domain.StatsIntervalMonth).
+			Return([]domain.TimeSeriesPoint{}, nil).
+			Times(1)
+
+		result, err = service.GetTimeSeries(ctx, filter, domain.StatsIntervalMonth)
+		require.NoError(t, err)
+		assert.Len(t, result, 309)
+	})
+
+	t.Run("error - open range with data too far apart", func(t *testing.T) {
+		filter := domain.StatsFilter{}
+		mockStatsRepo.EXPECT().
+			GetAggregatedTimeSeries(ctx, filter, domain.StatsIntervalDay).
+			Return([]domain.TimeSeriesPoint{
+				{Bucket: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), PRsOpened: 1},
+				{Bucket: day(1), PRsOpened: 1},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetTimeSeries(ctx, filter, domain.StatsIntervalDay)
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		filter := domain.StatsFilter{}
+		mockStatsRepo.EXPECT().
+			GetAggregatedTimeSeries(ctx, filter, domain.StatsIntervalDay).
+			Return(nil, errors.New("db error")).
+			Times(1)
+
+		result, err := service.GetTimeSeries(ctx, filter, domain.StatsIntervalDay)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestStatsService_GetFairnessReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(mockStatsRepo, nil, 0)
+	ctx := context.Background()
+	filter := domain.StatsFilter{}
+
+	t.Run("success - metrics per team", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetAggregatedTeamMemberAssignmentCounts(ctx, filter).
+			Return([]domain.UserAssignmentStats{
+				{UserID: "u1", Username: "Alice", TeamName: "backend", AssignmentsCount: 0},
+				{UserID: "u2", Username: "Bob", TeamName: "backend", AssignmentsCount: 4},
+				{UserID: "u3", Username: "Carol", TeamName: "backend", AssignmentsCount: 0},
+				{UserID: "u4", Username: "Dave", TeamName: "frontend", AssignmentsCount: 2},
+				{UserID: "u5", Username: "Eve", TeamName: "frontend", AssignmentsCount: 2},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetFairnessReport(ctx, filter, 50)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+
+		backend := result[0]
+		assert.Equal(t, "backend", backend.TeamName)
+		assert.Equal(t, 3, backend.MembersCount)
+		assert.Equal(t, int64(4), backend.TotalAssignments)
+		assert.Equal(t, int64(0), backend.Min)
+		assert.Equal(t, int64(4), backend.Max)
+		assert.InDelta(t, 4.0/3, backend.Mean, 1e-9)
+		assert.InDelta(t, 1.8856, backend.StdDev, 1e-4)
+		assert.InDelta(t, 2.0/3, backend.Gini, 1e-9)
+		require.Len(t, backend.Overloaded, 1)
+		assert.Equal(t, "u2", backend.Overloaded[0].UserID)
+
+		frontend := result[1]
+		assert.Equal(t, "frontend", frontend.TeamName)
+		assert.Zero(t, frontend.StdDev)
+		assert.Zero(t, frontend.Gini)
+		assert.Empty(t, frontend.Overloaded)
+	})
+
+	t.Run("success - team without assignments", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetAggregatedTeamMemberAssignmentCounts(ctx, filter).
+			Return([]domain.UserAssignmentStats{
+				{UserID: "u1", Username: "Alice", TeamName: "backend"},
+				{UserID: "u2", Username: "Bob", TeamName: "backend"},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetFairnessReport(ctx, filter, 0)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Zero(t, result[0].Gini)
+		assert.Empty(t, result[0].Overloaded)
+	})
+
+	t.Run("success - no teams", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetAggregatedTeamMemberAssignmentCounts(ctx, filter).
+			Return(nil, nil).
+			Times(1)
+
+		result, err := service.GetFairnessReport(ctx, filter, 0)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetAggregatedTeamMemberAssignmentCounts(ctx, filter).
+			Return(nil, errors.New("db error")).
+			Times(1)
+
+		result, err := service.GetFairnessReport(ctx, filter, 50)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestStatsService_GetReviewerPairs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	service := NewStatsService(mockStatsRepo, nil, 0)
+	ctx := context.Background()
+	filter := domain.StatsFilter{TeamName: "backend"}
+
+	t.Run("success - return author to reviewer counts", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetReviewerPairs(ctx, filter).
+			Return([]domain.ReviewerPair{
+				{AuthorID: "u1", AuthorUsername: "Alice", ReviewerID: "u2", ReviewerUsername: "Bob", ReviewsCount: 5},
+				{AuthorID: "u2", AuthorUsername: "Bob", ReviewerID: "u1", ReviewerUsername: "Alice", ReviewsCount: 1},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetReviewerPairs(ctx, filter)
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "u2", result[0].ReviewerID)
+		assert.Equal(t, int64(5), result[0].ReviewsCount)
+	})
+
+	t.Run("error - repository error", func(t *testing.T) {
+		mockStatsRepo.EXPECT().
+			GetReviewerPairs(ctx, filter).
+			Return(nil, errors.New("db error")).
+			Times(1)
+
+		result, err := service.GetReviewerPairs(ctx, filter)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestStatsService_GetStalePRs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	ctx := context.Background()
+	filter := domain.StatsFilter{TeamName: "backend"}
+
+	t.Run("success - default threshold is passed to repository", func(t *testing.T) {
+		service := NewStatsService(mockStatsRepo, nil, 0)
+		mockStatsRepo.EXPECT().
+			GetStalePRs(ctx, filter, time.Duration(0), DefaultStaleAfter).
+			Return([]domain.StalePR{
+				{PullRequestID: "pr-1", TeamName: "backend", AssignedReviewers: []string{"u2"}, Age: 100 * time.Hour},
+			}, nil).
+			Times(1)
+
+		result, err := service.GetStalePRs(ctx, filter, 0)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "pr-1", result[0].PullRequestID)
+	})
+
+	t.Run("success - configured default and explicit age", func(t *testing.T) {
+		service := NewStatsService(mockStatsRepo, nil, 24*time.Hour)
+		mockStatsRepo.EXPECT().
+			GetStalePRs(ctx, filter, 48*time.Hour, 24*time.Hour).
+			Return([]domain.StalePR{}, nil).
+			Times(1)
+
+		result, err := service.GetStalePRs(ctx, filter, 48*time.Hour)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
 	t.Run("error - repository error", func(t *testing.T) {
+		service := NewStatsService(mockStatsRepo, nil, 0)
 		mockStatsRepo.EXPECT().
-			GetReviewerWorkload(ctx).
+			GetStalePRs(ctx, filter, time.Duration(0), DefaultStaleAfter).
 			Return(nil, errors.New("db error")).
 			Times(1)
 
-		result, err := service.GetReviewerWorkload(ctx)
+		result, err := service.GetStalePRs(ctx, filter, 0)
 		require.Error(t, err)
 		assert.Nil(t, result)
 	})
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
 	"github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/usecase"
@@ -19,10 +21,15 @@ func NewTeamService(uow repository.UnitOfWork) *TeamService {
 
 func (s *TeamService) CreateTeam(ctx context.Context, req usecase.CreateTeamRequest) (*domain.Team, error) {
 	if req.TeamName == "" {
-		return nil, fmt.Errorf("team_name is required")
+		return nil, domain.RequiredError("team_name")
 	}
 	if len(req.Members) == 0 {
-		return nil, fmt.Errorf("members are required")
+		return nil, domain.NewValidationError("members", "required", "are required")
+	}
+	for i, member := range req.Members {
+		if member.Role != "" && !member.Role.IsValid() {
+			return nil, domain.NewValidationError(fmt.Sprintf("members[%d].role", i), "oneof", fmt.Sprintf("has invalid role %q", member.Role))
+		}
 	}
 
 	exists, err := s.uow.Teams().TeamExists(ctx, req.TeamName)
@@ -33,11 +40,33 @@ func (s *TeamService) CreateTeam(ctx context.Context, req usecase.CreateTeamRequ
 		return nil, domain.ErrTeamAlreadyExists
 	}
 
+	if req.ParentTeamName != "" {
+		parentExists, err := s.uow.Teams().TeamExists(ctx, req.ParentTeamName)
+		if err != nil {
+			return nil, fmt.Errorf("check parent team exists: %w", err)
+		}
+		if !parentExists {
+			return nil, domain.ErrParentTeamNotFound
+		}
+	}
+
 	err = s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
 		if err := s.uow.Teams().CreateTeam(txCtx, req.TeamName); err != nil {
 			return fmt.Errorf("create team: %w", err)
 		}
 
+		if req.ParentTeamName != "" {
+			if err := s.uow.Teams().SetParentTeam(txCtx, req.TeamName, req.ParentTeamName); err != nil {
+				return fmt.Errorf("set parent team: %w", err)
+			}
+		}
+
+		if req.Settings != (domain.TeamSettings{}) {
+			if err := s.uow.Teams().UpdateTeamSettings(txCtx, req.TeamName, req.Settings); err != nil {
+				return fmt.Errorf("update team settings: %w", err)
+			}
+		}
+
 		for _, member := range req.Members {
 			user := &domain.User{
 				UserID:   member.UserID,
@@ -48,6 +77,9 @@ func (s *TeamService) CreateTeam(ctx context.Context, req usecase.CreateTeamRequ
 			if err := s.uow.Users().UpsertUser(txCtx, user); err != nil {
 				return fmt.Errorf("upsert user %s: %w", member.UserID, err)
 			}
+			if err := s.uow.Teams().AddMember(txCtx, req.TeamName, member.UserID, roleOrDefault(member.Role)); err != nil {
+				return fmt.Errorf("add member %s: %w", member.UserID, err)
+			}
 		}
 
 		return nil
@@ -67,7 +99,7 @@ func (s *TeamService) CreateTeam(ctx context.Context, req usecase.CreateTeamRequ
 
 func (s *TeamService) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
 	if teamName == "" {
-		return nil, fmt.Errorf("team_name is required")
+		return nil, domain.RequiredError("team_name")
 	}
 
 	team, err := s.uow.Teams().GetTeam(ctx, teamName)
@@ -77,3 +109,213 @@ func (s *TeamService) GetTeam(ctx context.Context, teamName string) (*domain.Tea
 
 	return team, nil
 }
+
+func (s *TeamService) AddMember(ctx context.Context, req usecase.AddTeamMemberRequest) (*domain.Team, error) {
+	if req.TeamName == "" {
+		return nil, domain.RequiredError("team_name")
+	}
+	if req.UserID == "" {
+		return nil, domain.RequiredError("user_id")
+	}
+	if req.Role != "" && !req.Role.IsValid() {
+		return nil, domain.NewValidationError("role", "oneof", fmt.Sprintf("has invalid role %q", req.Role))
+	}
+
+	teamExists, err := s.uow.Teams().TeamExists(ctx, req.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("check team exists: %w", err)
+	}
+	if !teamExists {
+		return nil, domain.ErrTeamNotFound
+	}
+
+	if err := s.authorizeTeamChange(ctx, req.TeamName, req.ActorID, req.Role == domain.TeamRoleLead); err != nil {
+		return nil, err
+	}
+
+	userExists, err := s.uow.Users().UserExists(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("check user exists: %w", err)
+	}
+	if !userExists {
+		return nil, domain.ErrUserNotFound
+	}
+
+	var team *domain.Team
+	err = s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.uow.Teams().AddMember(txCtx, req.TeamName, req.UserID, roleOrDefault(req.Role)); err != nil {
+			return err
+		}
+		team, err = s.uow.Teams().GetTeam(txCtx, req.TeamName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+func (s *TeamService) SetParentTeam(ctx context.Context, req usecase.SetParentTeamRequest) (*domain.Team, error) {
+	if req.TeamName == "" {
+		return nil, domain.RequiredError("team_name")
+	}
+	if req.ParentTeamName == req.TeamName {
+		return nil, domain.ErrTeamHierarchyCycle
+	}
+
+	err := s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.authorizeTeamChange(txCtx, req.TeamName, req.ActorID, false); err != nil {
+			return err
+		}
+
+		if req.ParentTeamName != "" {
+			if err := s.uow.Teams().LockHierarchy(txCtx); err != nil {
+				return err
+			}
+			ancestors, err := s.uow.Teams().GetTeamAncestors(txCtx, req.ParentTeamName)
+			if err != nil {
+				return err
+			}
+			if slices.Contains(ancestors, req.TeamName) {
+				return domain.ErrTeamHierarchyCycle
+			}
+		}
+
+		return s.uow.Teams().SetParentTeam(txCtx, req.TeamName, req.ParentTeamName)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.uow.Teams().GetTeam(ctx, req.TeamName)
+}
+
+func (s *TeamService) UpdateSettings(ctx context.Context, req usecase.UpdateTeamSettingsRequest) (*domain.Team, error) {
+	if req.TeamName == "" {
+		return nil, domain.RequiredError("team_name")
+	}
+
+	err := s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.authorizeTeamChange(txCtx, req.TeamName, req.ActorID, false); err != nil {
+			return err
+		}
+
+		return s.uow.Teams().UpdateTeamSettings(txCtx, req.TeamName, req.Settings)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.uow.Teams().GetTeam(ctx, req.TeamName)
+}
+
+// authorizeTeamChange allows only team leads to change team settings. A team
+// without leads accepts nothing but the appointment of its first lead.
+//
+// actorID is whatever the client sent: the check guards against mistakes, it
+// does not authenticate anyone.
+func (s *TeamService) authorizeTeamChange(ctx context.Context, teamName, actorID string, appointsLead bool) error {
+	leads, err := s.uow.Teams().CountLeads(ctx, teamName)
+	if err != nil {
+		return fmt.Errorf("count team leads: %w", err)
+	}
+	if leads == 0 {
+		exists, err := s.uow.Teams().TeamExists(ctx, teamName)
+		if err != nil {
+			return fmt.Errorf("check team exists: %w", err)
+		}
+		if !exists {
+			return domain.ErrTeamNotFound
+		}
+		if appointsLead {
+			return nil
+		}
+		return domain.ErrNotTeamLead
+	}
+	if actorID == "" {
+		return domain.ErrNotTeamLead
+	}
+
+	role, err := s.uow.Teams().GetMemberRole(ctx, teamName, actorID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotTeamMember) {
+			return domain.ErrNotTeamLead
+		}
+		return err
+	}
+	if role != domain.TeamRoleLead {
+		return domain.ErrNotTeamLead
+	}
+
+	return nil
+}
+
+func roleOrDefault(role domain.TeamRole) domain.TeamRole {
+	if role == "" {
+		return domain.TeamRoleMember
+	}
+	return role
+}
+
+func (s *TeamService) GetTeamTree(ctx context.Context, rootTeamName string) ([]domain.TeamTreeNode, error) {
+	teams, err := s.uow.Teams().ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(teams))
+	for _, t := range teams {
+		known[t.TeamName] = true
+	}
+
+	children := make(map[string][]string)
+	var roots []string
+	for _, t := range teams {
+		if t.ParentTeamName == "" || !known[t.ParentTeamName] {
+			roots = append(roots, t.TeamName)
+			continue
+		}
+		children[t.ParentTeamName] = append(children[t.ParentTeamName], t.TeamName)
+	}
+
+	if rootTeamName != "" {
+		if !known[rootTeamName] {
+			return nil, domain.ErrTeamNotFound
+		}
+		return []domain.TeamTreeNode{buildTeamTreeNode(rootTeamName, children, map[string]bool{})}, nil
+	}
+
+	visited := make(map[string]bool, len(teams))
+	tree := make([]domain.TeamTreeNode, 0, len(roots))
+	for _, root := range roots {
+		tree = append(tree, buildTeamTreeNode(root, children, visited))
+	}
+
+	// Teams whose parents form a cycle have no root above them. Each such
+	// cycle is listed from its first team, so no team is left out.
+	for _, t := range teams {
+		if !visited[t.TeamName] {
+			tree = append(tree, buildTeamTreeNode(t.TeamName, children, visited))
+		}
+	}
+
+	return tree, nil
+}
+
+func buildTeamTreeNode(teamName string, children map[string][]string, visited map[string]bool) domain.TeamTreeNode {
+	visited[teamName] = true
+
+	node := domain.TeamTreeNode{
+		TeamName: teamName,
+		Children: []domain.TeamTreeNode{},
+	}
+	for _, child := range children[teamName] {
+		if visited[child] {
+			continue
+		}
+		node.Children = append(node.Children, buildTeamTreeNode(child, children, visited))
+	}
+
+	return node
+}
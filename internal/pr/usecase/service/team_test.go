@@ -74,13 +74,23 @@ func TestTeamService_CreateTeam(t *testing.T) {
 			Return(nil).
 			Times(1)
 
+		mockTeamRepo.EXPECT().
+			AddMember(ctx, "backend", "u1", domain.TeamRoleMember).
+			Return(nil).
+			Times(1)
+
+		mockTeamRepo.EXPECT().
+			AddMember(ctx, "backend", "u2", domain.TeamRoleMember).
+			Return(nil).
+			Times(1)
+
 		mockTeamRepo.EXPECT().
 			GetTeam(ctx, "backend").
 			Return(&domain.Team{
 				TeamName: "backend",
-				Members: []domain.User{
-					{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
-					{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+				Members: []domain.TeamMember{
+					{User: domain.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}, Role: domain.TeamRoleMember},
+					{User: domain.User{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}, Role: domain.TeamRoleMember},
 				},
 			}, nil).
 			Times(1)
@@ -237,9 +247,9 @@ func TestTeamService_GetTeam(t *testing.T) {
 	t.Run("success - get existing team", func(t *testing.T) {
 		expectedTeam := &domain.Team{
 			TeamName: "backend",
-			Members: []domain.User{
-				{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
-				{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+			Members: []domain.TeamMember{
+				{User: domain.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}, Role: domain.TeamRoleMember},
+				{User: domain.User{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}, Role: domain.TeamRoleMember},
 			},
 		}
 
@@ -291,3 +301,475 @@ func TestTeamService_GetTeam(t *testing.T) {
 		assert.Contains(t, err.Error(), "database error")
 	})
 }
+
+func TestTeamService_AddMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
+
+	service := NewTeamService(mockUOW)
+	ctx := context.Background()
+
+	req := usecase.AddTeamMemberRequest{TeamName: "platform", UserID: "u1", ActorID: "u7"}
+
+	t.Run("success - add user from another team", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "platform").Return(true, nil)
+		mockTeamRepo.EXPECT().CountLeads(ctx, "platform").Return(int64(1), nil)
+		mockTeamRepo.EXPECT().GetMemberRole(ctx, "platform", "u7").Return(domain.TeamRoleLead, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "u1").Return(true, nil)
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+		mockTeamRepo.EXPECT().AddMember(ctx, "platform", "u1", domain.TeamRoleMember).Return(nil)
+		mockTeamRepo.EXPECT().GetTeam(ctx, "platform").Return(&domain.Team{
+			TeamName: "platform",
+			Members: []domain.TeamMember{
+				{User: domain.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}, Role: domain.TeamRoleMember},
+				{User: domain.User{UserID: "u7", Username: "Grace", TeamName: "platform", IsActive: true}, Role: domain.TeamRoleMember},
+			},
+		}, nil)
+
+		result, err := service.AddMember(ctx, req)
+
+		require.NoError(t, err)
+		require.Len(t, result.Members, 2)
+		assert.Equal(t, "backend", result.Members[0].TeamName)
+	})
+
+	t.Run("error - team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "platform").Return(false, nil)
+
+		result, err := service.AddMember(ctx, req)
+
+		require.ErrorIs(t, err, domain.ErrTeamNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - user not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "platform").Return(true, nil)
+		mockTeamRepo.EXPECT().CountLeads(ctx, "platform").Return(int64(1), nil)
+		mockTeamRepo.EXPECT().GetMemberRole(ctx, "platform", "u7").Return(domain.TeamRoleLead, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "u1").Return(false, nil)
+
+		result, err := service.AddMember(ctx, req)
+
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("success - lead adds observer", func(t *testing.T) {
+		req := usecase.AddTeamMemberRequest{
+			TeamName: "platform",
+			UserID:   "u9",
+			Role:     domain.TeamRoleObserver,
+			ActorID:  "u7",
+		}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "platform").Return(true, nil)
+		mockTeamRepo.EXPECT().CountLeads(ctx, "platform").Return(int64(1), nil)
+		mockTeamRepo.EXPECT().GetMemberRole(ctx, "platform", "u7").Return(domain.TeamRoleLead, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "u9").Return(true, nil)
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+		mockTeamRepo.EXPECT().AddMember(ctx, "platform", "u9", domain.TeamRoleObserver).Return(nil)
+		mockTeamRepo.EXPECT().GetTeam(ctx, "platform").Return(&domain.Team{
+			TeamName: "platform",
+			Members: []domain.TeamMember{
+				{User: domain.User{UserID: "u7", Username: "Grace", TeamName: "platform", IsActive: true}, Role: domain.TeamRoleLead},
+				{User: domain.User{UserID: "u9", Username: "Ivan", TeamName: "platform", IsActive: true}, Role: domain.TeamRoleObserver},
+			},
+		}, nil)
+
+		result, err := service.AddMember(ctx, req)
+
+		require.NoError(t, err)
+		require.Len(t, result.Members, 2)
+		assert.Equal(t, domain.TeamRoleObserver, result.Members[1].Role)
+	})
+
+	t.Run("error - actor is not a lead", func(t *testing.T) {
+		req := usecase.AddTeamMemberRequest{TeamName: "platform", UserID: "u9", ActorID: "u1"}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "platform").Return(true, nil)
+		mockTeamRepo.EXPECT().CountLeads(ctx, "platform").Return(int64(1), nil)
+		mockTeamRepo.EXPECT().GetMemberRole(ctx, "platform", "u1").Return(domain.TeamRoleMember, nil)
+
+		result, err := service.AddMember(ctx, req)
+
+		require.ErrorIs(t, err, domain.ErrNotTeamLead)
+		assert.Nil(t, result)
+	})
+
+	t.Run("success - first lead appointed in a team without leads", func(t *testing.T) {
+		req := usecase.AddTeamMemberRequest{TeamName: "platform", UserID: "u7", Role: domain.TeamRoleLead}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "platform").Return(true, nil).Times(2)
+		mockTeamRepo.EXPECT().CountLeads(ctx, "platform").Return(int64(0), nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "u7").Return(true, nil)
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+		mockTeamRepo.EXPECT().AddMember(ctx, "platform", "u7", domain.TeamRoleLead).Return(nil)
+		mockTeamRepo.EXPECT().GetTeam(ctx, "platform").Return(&domain.Team{TeamName: "platform"}, nil)
+
+		_, err := service.AddMember(ctx, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error - team without leads accepts only a lead", func(t *testing.T) {
+		req := usecase.AddTeamMemberRequest{TeamName: "platform", UserID: "u9", ActorID: "u9"}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "platform").Return(true, nil).Times(2)
+		mockTeamRepo.EXPECT().CountLeads(ctx, "platform").Return(int64(0), nil)
+
+		result, err := service.AddMember(ctx, req)
+
+		require.ErrorIs(t, err, domain.ErrNotTeamLead)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - invalid role", func(t *testing.T) {
+		result, err := service.AddMember(ctx, usecase.AddTeamMemberRequest{
+			TeamName: "platform",
+			UserID:   "u9",
+			Role:     "owner",
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "invalid role")
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "role", validationErr.Field)
+		assert.Equal(t, "oneof", validationErr.Rule)
+	})
+
+	t.Run("error - empty user ID", func(t *testing.T) {
+		result, err := service.AddMember(ctx, usecase.AddTeamMemberRequest{TeamName: "platform"})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "user_id is required")
+	})
+}
+
+func TestTeamService_CreateTeamWithParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
+
+	service := NewTeamService(mockUOW)
+	ctx := context.Background()
+
+	req := usecase.CreateTeamRequest{
+		TeamName:       "payments",
+		ParentTeamName: "backend",
+		Members: []usecase.CreateTeamMember{
+			{UserID: "u5", Username: "Eve", IsActive: true},
+		},
+	}
+
+	t.Run("success - create team under parent", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "payments").Return(false, nil)
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockUOW.EXPECT().
+			WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockTeamRepo.EXPECT().CreateTeam(ctx, "payments").Return(nil)
+		mockTeamRepo.EXPECT().SetParentTeam(ctx, "payments", "backend").Return(nil)
+		mockUserRepo.EXPECT().UpsertUser(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().AddMember(ctx, "payments", "u5", domain.TeamRoleMember).Return(nil)
+		mockTeamRepo.EXPECT().GetTeam(ctx, "payments").Return(&domain.Team{
+			TeamName:       "payments",
+			ParentTeamName: "backend",
+			Members:        []domain.TeamMember{{User: domain.User{UserID: "u5", Username: "Eve", TeamName: "payments", IsActive: true}, Role: domain.TeamRoleMember}},
+		}, nil)
+
+		result, err := service.CreateTeam(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "backend", result.ParentTeamName)
+	})
+
+	t.Run("error - parent team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "payments").Return(false, nil)
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(false, nil)
+
+		result, err := service.CreateTeam(ctx, req)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrParentTeamNotFound)
+	})
+}
+
+func TestTeamService_SetParentTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+	mockUOW.EXPECT().
+		WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
+	service := NewTeamService(mockUOW)
+	ctx := context.Background()
+
+	t.Run("success - set parent team", func(t *testing.T) {
+		mockTeamRepo.EXPECT().CountLeads(ctx, "backend").Return(int64(1), nil)
+		mockTeamRepo.EXPECT().GetMemberRole(ctx, "backend", "u1").Return(domain.TeamRoleLead, nil)
+		mockTeamRepo.EXPECT().LockHierarchy(ctx).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "engineering").Return([]string{}, nil)
+		mockTeamRepo.EXPECT().SetParentTeam(ctx, "backend", "engineering").Return(nil)
+		mockTeamRepo.EXPECT().GetTeam(ctx, "backend").Return(&domain.Team{
+			TeamName:       "backend",
+			ParentTeamName: "engineering",
+		}, nil)
+
+		result, err := service.SetParentTeam(ctx, usecase.SetParentTeamRequest{
+			TeamName:       "backend",
+			ParentTeamName: "engineering",
+			ActorID:        "u1",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "engineering", result.ParentTeamName)
+	})
+
+	t.Run("success - detach from parent", func(t *testing.T) {
+		mockTeamRepo.EXPECT().CountLeads(ctx, "backend").Return(int64(1), nil)
+		mockTeamRepo.EXPECT().GetMemberRole(ctx, "backend", "u1").Return(domain.TeamRoleLead, nil)
+		mockTeamRepo.EXPECT().SetParentTeam(ctx, "backend", "").Return(nil)
+		mockTeamRepo.EXPECT().GetTeam(ctx, "backend").Return(&domain.Team{TeamName: "backend"}, nil)
+
+		result, err := service.SetParentTeam(ctx, usecase.SetParentTeamRequest{TeamName: "backend", ActorID: "u1"})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.ParentTeamName)
+	})
+
+	t.Run("error - parent is a descendant", func(t *testing.T) {
+		mockTeamRepo.EXPECT().CountLeads(ctx, "engineering").Return(int64(1), nil)
+		mockTeamRepo.EXPECT().GetMemberRole(ctx, "engineering", "u1").Return(domain.TeamRoleLead, nil)
+		mockTeamRepo.EXPECT().LockHierarchy(ctx).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamAncestors(ctx, "payments").Return([]string{"backend", "engineering"}, nil)
+
+		result, err := service.SetParentTeam(ctx, usecase.SetParentTeamRequest{
+			TeamName:       "engineering",
+			ParentTeamName: "payments",
+			ActorID:        "u1",
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrTeamHierarchyCycle)
+	})
+
+	t.Run("error - actor is not a lead", func(t *testing.T) {
+		mockTeamRepo.EXPECT().CountLeads(ctx, "backend").Return(int64(2), nil)
+
+		result, err := service.SetParentTeam(ctx, usecase.SetParentTeamRequest{
+			TeamName:       "backend",
+			ParentTeamName: "engineering",
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrNotTeamLead)
+	})
+
+	t.Run("error - parent is the team itself", func(t *testing.T) {
+		result, err := service.SetParentTeam(ctx, usecase.SetParentTeamRequest{
+			TeamName:       "backend",
+			ParentTeamName: "backend",
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrTeamHierarchyCycle)
+	})
+
+	t.Run("error - empty team name", func(t *testing.T) {
+		result, err := service.SetParentTeam(ctx, usecase.SetParentTeamRequest{ParentTeamName: "backend"})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "team_name is required")
+	})
+}
+
+func TestTeamService_UpdateSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+	mockUOW.EXPECT().
+		WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
+	service := NewTeamService(mockUOW)
+	ctx := context.Background()
+
+	req := usecase.UpdateTeamSettingsRequest{
+		TeamName: "backend",
+		Settings: domain.TeamSettings{RequireLeadReview: true},
+		ActorID:  "u1",
+	}
+
+	t.Run("success - lead enables lead review", func(t *testing.T) {
+		mockTeamRepo.EXPECT().CountLeads(ctx, "backend").Return(int64(1), nil)
+		mockTeamRepo.EXPECT().GetMemberRole(ctx, "backend", "u1").Return(domain.TeamRoleLead, nil)
+		mockTeamRepo.EXPECT().UpdateTeamSettings(ctx, "backend", req.Settings).Return(nil)
+		mockTeamRepo.EXPECT().GetTeam(ctx, "backend").Return(&domain.Team{
+			TeamName: "backend",
+			Settings: domain.TeamSettings{RequireLeadReview: true},
+		}, nil)
+
+		result, err := service.UpdateSettings(ctx, req)
+
+		require.NoError(t, err)
+		assert.True(t, result.Settings.RequireLeadReview)
+	})
+
+	t.Run("error - actor is not a team member", func(t *testing.T) {
+		mockTeamRepo.EXPECT().CountLeads(ctx, "backend").Return(int64(1), nil)
+		mockTeamRepo.EXPECT().GetMemberRole(ctx, "backend", "u1").Return(domain.TeamRole(""), domain.ErrNotTeamMember)
+
+		result, err := service.UpdateSettings(ctx, req)
+
+		require.ErrorIs(t, err, domain.ErrNotTeamLead)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - team without leads is read-only", func(t *testing.T) {
+		mockTeamRepo.EXPECT().CountLeads(ctx, "backend").Return(int64(0), nil)
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+
+		result, err := service.UpdateSettings(ctx, req)
+
+		require.ErrorIs(t, err, domain.ErrNotTeamLead)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().CountLeads(ctx, "backend").Return(int64(0), nil)
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(false, nil)
+
+		result, err := service.UpdateSettings(ctx, req)
+
+		require.ErrorIs(t, err, domain.ErrTeamNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTeamService_GetTeamTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	mockUOW.EXPECT().Teams().Return(mockTeamRepo).AnyTimes()
+
+	service := NewTeamService(mockUOW)
+	ctx := context.Background()
+
+	teams := []domain.Team{
+		{TeamName: "backend", ParentTeamName: "engineering"},
+		{TeamName: "design"},
+		{TeamName: "engineering"},
+		{TeamName: "frontend", ParentTeamName: "engineering"},
+		{TeamName: "payments", ParentTeamName: "backend"},
+	}
+
+	t.Run("success - full hierarchy", func(t *testing.T) {
+		mockTeamRepo.EXPECT().ListTeams(ctx).Return(teams, nil)
+
+		result, err := service.GetTeamTree(ctx, "")
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "design", result[0].TeamName)
+		assert.Empty(t, result[0].Children)
+		assert.Equal(t, "engineering", result[1].TeamName)
+		require.Len(t, result[1].Children, 2)
+		assert.Equal(t, "backend", result[1].Children[0].TeamName)
+		assert.Equal(t, "payments", result[1].Children[0].Children[0].TeamName)
+		assert.Equal(t, "frontend", result[1].Children[1].TeamName)
+	})
+
+	t.Run("success - teams in a parent cycle are listed", func(t *testing.T) {
+		mockTeamRepo.EXPECT().ListTeams(ctx).Return([]domain.Team{
+			{TeamName: "alpha", ParentTeamName: "gamma"},
+			{TeamName: "beta", ParentTeamName: "alpha"},
+			{TeamName: "delta", ParentTeamName: "beta"},
+			{TeamName: "design"},
+			{TeamName: "gamma", ParentTeamName: "beta"},
+		}, nil)
+
+		result, err := service.GetTeamTree(ctx, "")
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "design", result[0].TeamName)
+		assert.Equal(t, "alpha", result[1].TeamName)
+		require.Len(t, result[1].Children, 1)
+		beta := result[1].Children[0]
+		assert.Equal(t, "beta", beta.TeamName)
+		require.Len(t, beta.Children, 2)
+		assert.Equal(t, "delta", beta.Children[0].TeamName)
+		assert.Equal(t, "gamma", beta.Children[1].TeamName)
+		assert.Empty(t, beta.Children[1].Children)
+	})
+
+	t.Run("success - subtree", func(t *testing.T) {
+		mockTeamRepo.EXPECT().ListTeams(ctx).Return(teams, nil)
+
+		result, err := service.GetTeamTree(ctx, "backend")
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "backend", result[0].TeamName)
+		require.Len(t, result[0].Children, 1)
+		assert.Equal(t, "payments", result[0].Children[0].TeamName)
+	})
+
+	t.Run("error - root team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().ListTeams(ctx).Return(teams, nil)
+
+		result, err := service.GetTeamTree(ctx, "nonexistent")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+	})
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,8 +21,15 @@ func TestUserService_SetIsActive(t *testing.T) {
 
 	mockUOW := mocks.NewMockUnitOfWork(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockEventRepo := mocks.NewMockEventRepository(ctrl)
 
 	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
+	mockUOW.EXPECT().Events().Return(mockEventRepo).AnyTimes()
+	mockUOW.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
 
 	service := NewUserService(mockUOW)
 	ctx := context.Background()
@@ -39,6 +47,9 @@ func TestUserService_SetIsActive(t *testing.T) {
 			IsActive: true,
 		}
 
+		mockUserRepo.EXPECT().
+			GetUserForUpdate(ctx, "u1").
+			Return(&domain.User{UserID: "u1", TeamName: "backend", IsActive: false}, nil)
 		mockUserRepo.EXPECT().
 			SetUserIsActive(ctx, "u1", true).
 			Return(expectedUser, nil).
@@ -66,10 +77,17 @@ func TestUserService_SetIsActive(t *testing.T) {
 			IsActive: false,
 		}
 
+		mockUserRepo.EXPECT().
+			GetUserForUpdate(ctx, "u2").
+			Return(&domain.User{UserID: "u2", TeamName: "backend", IsActive: true}, nil)
 		mockUserRepo.EXPECT().
 			SetUserIsActive(ctx, "u2", false).
 			Return(expectedUser, nil).
 			Times(1)
+		mockEventRepo.EXPECT().
+			RecordEvents(ctx, []domain.Event{{Type: domain.EventUserDeactivated, TeamName: "backend", UserID: "u2"}}).
+			Return(nil).
+			Times(1)
 
 		result, err := service.SetIsActive(ctx, req)
 
@@ -79,6 +97,22 @@ func TestUserService_SetIsActive(t *testing.T) {
 		assert.False(t, result.IsActive)
 	})
 
+	t.Run("success - already inactive user is not reported again", func(t *testing.T) {
+		req := usecase.SetUserIsActiveRequest{
+			UserID:   "u3",
+			IsActive: false,
+		}
+
+		inactive := &domain.User{UserID: "u3", TeamName: "backend", IsActive: false}
+		mockUserRepo.EXPECT().GetUserForUpdate(ctx, "u3").Return(inactive, nil)
+		mockUserRepo.EXPECT().SetUserIsActive(ctx, "u3", false).Return(inactive, nil)
+
+		result, err := service.SetIsActive(ctx, req)
+
+		require.NoError(t, err)
+		assert.False(t, result.IsActive)
+	})
+
 	t.Run("error - user not found", func(t *testing.T) {
 		req := usecase.SetUserIsActiveRequest{
 			UserID:   "nonexistent",
@@ -86,7 +120,7 @@ func TestUserService_SetIsActive(t *testing.T) {
 		}
 
 		mockUserRepo.EXPECT().
-			SetUserIsActive(ctx, "nonexistent", false).
+			GetUserForUpdate(ctx, "nonexistent").
 			Return(nil, domain.ErrUserNotFound).
 			Times(1)
 
@@ -117,6 +151,9 @@ func TestUserService_SetIsActive(t *testing.T) {
 		}
 
 		dbErr := errors.New("database connection lost")
+		mockUserRepo.EXPECT().
+			GetUserForUpdate(ctx, "u1").
+			Return(&domain.User{UserID: "u1", IsActive: false}, nil)
 		mockUserRepo.EXPECT().
 			SetUserIsActive(ctx, "u1", true).
 			Return(nil, dbErr).
@@ -129,3 +166,235 @@ func TestUserService_SetIsActive(t *testing.T) {
 		assert.Contains(t, err.Error(), "database connection lost")
 	})
 }
+
+func TestUserService_GetUserProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockPRRepo := mocks.NewMockPRRepository(ctrl)
+	mockReviewerRepo := mocks.NewMockReviewerRepository(ctrl)
+
+	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
+	mockUOW.EXPECT().PullRequests().Return(mockPRRepo).AnyTimes()
+	mockUOW.EXPECT().Reviewers().Return(mockReviewerRepo).AnyTimes()
+
+	service := NewUserService(mockUOW)
+	ctx := context.Background()
+
+	t.Run("success - get user profile", func(t *testing.T) {
+		user := &domain.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+		authored := []domain.PullRequestShort{
+			{PullRequestID: "pr-1001", PullRequestName: "Add search", AuthorID: "u1", Status: domain.PRStatusOpen},
+		}
+
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(user, nil)
+		mockUserRepo.EXPECT().ListUserTeams(ctx, "u1").Return([]string{"backend", "platform"}, nil)
+		mockReviewerRepo.EXPECT().CountOpenReviews(ctx, "u1").Return(int64(3), nil)
+		mockPRRepo.EXPECT().ListOpenPRsByAuthor(ctx, "u1").Return(authored, nil)
+
+		result, err := service.GetUserProfile(ctx, "u1")
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, "Alice", result.Username)
+		assert.Equal(t, "backend", result.TeamName)
+		assert.Equal(t, []string{"backend", "platform"}, result.Teams)
+		assert.True(t, result.IsActive)
+		assert.Equal(t, int64(3), result.OpenReviewsCount)
+		assert.Len(t, result.AuthoredOpenPRs, 1)
+	})
+
+	t.Run("error - user not found", func(t *testing.T) {
+		mockUserRepo.EXPECT().GetUser(ctx, "nonexistent").Return(nil, domain.ErrUserNotFound)
+
+		result, err := service.GetUserProfile(ctx, "nonexistent")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+
+	t.Run("error - empty user ID", func(t *testing.T) {
+		result, err := service.GetUserProfile(ctx, "")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "user_id is required")
+	})
+
+	t.Run("error - database error", func(t *testing.T) {
+		user := &domain.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+
+		mockUserRepo.EXPECT().GetUser(ctx, "u1").Return(user, nil)
+		mockUserRepo.EXPECT().ListUserTeams(ctx, "u1").Return([]string{"backend"}, nil)
+		mockReviewerRepo.EXPECT().CountOpenReviews(ctx, "u1").Return(int64(0), errors.New("database connection lost"))
+
+		result, err := service.GetUserProfile(ctx, "u1")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "database connection lost")
+	})
+}
+
+func TestUserService_UpdateUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+
+	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
+
+	service := NewUserService(mockUOW)
+	ctx := context.Background()
+
+	t.Run("success - update username", func(t *testing.T) {
+		req := usecase.UpdateUserRequest{
+			UserID:   "u1",
+			Username: "  Alice Cooper  ",
+		}
+
+		expectedUser := &domain.User{UserID: "u1", Username: "Alice Cooper", TeamName: "backend", IsActive: true}
+		mockUserRepo.EXPECT().
+			UpdateUsername(ctx, "u1", "Alice Cooper").
+			Return(expectedUser, nil).
+			Times(1)
+
+		result, err := service.UpdateUser(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Alice Cooper", result.Username)
+	})
+
+	t.Run("error - user not found", func(t *testing.T) {
+		req := usecase.UpdateUserRequest{UserID: "nonexistent", Username: "Ghost"}
+
+		mockUserRepo.EXPECT().
+			UpdateUsername(ctx, "nonexistent", "Ghost").
+			Return(nil, domain.ErrUserNotFound).
+			Times(1)
+
+		result, err := service.UpdateUser(ctx, req)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+
+	t.Run("error - empty username", func(t *testing.T) {
+		req := usecase.UpdateUserRequest{UserID: "u1", Username: "   "}
+
+		result, err := service.UpdateUser(ctx, req)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "username is required")
+	})
+
+	t.Run("error - username too long", func(t *testing.T) {
+		req := usecase.UpdateUserRequest{UserID: "u1", Username: strings.Repeat("a", domain.MaxUsernameLength+1)}
+
+		result, err := service.UpdateUser(ctx, req)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "username must be at most")
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "max", validationErr.Rule)
+	})
+
+	t.Run("error - empty user ID", func(t *testing.T) {
+		req := usecase.UpdateUserRequest{Username: "Alice"}
+
+		result, err := service.UpdateUser(ctx, req)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "user_id is required")
+	})
+}
+
+func TestUserService_PatchUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUOW := mocks.NewMockUnitOfWork(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockEventRepo := mocks.NewMockEventRepository(ctrl)
+
+	mockUOW.EXPECT().Users().Return(mockUserRepo).AnyTimes()
+	mockUOW.EXPECT().Events().Return(mockEventRepo).AnyTimes()
+
+	service := NewUserService(mockUOW)
+	ctx := context.Background()
+
+	username := "  Alice Cooper  "
+	inactive := false
+
+	t.Run("success - both fields change in one transaction", func(t *testing.T) {
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).
+			Times(1)
+		mockUserRepo.EXPECT().
+			UpdateUsername(ctx, "u1", "Alice Cooper").
+			Return(&domain.User{UserID: "u1", Username: "Alice Cooper", TeamName: "backend", IsActive: true}, nil)
+		mockUserRepo.EXPECT().
+			GetUserForUpdate(ctx, "u1").
+			Return(&domain.User{UserID: "u1", Username: "Alice Cooper", TeamName: "backend", IsActive: true}, nil)
+		mockUserRepo.EXPECT().
+			SetUserIsActive(ctx, "u1", false).
+			Return(&domain.User{UserID: "u1", Username: "Alice Cooper", TeamName: "backend", IsActive: false}, nil)
+		mockEventRepo.EXPECT().RecordEvents(ctx, gomock.Len(1)).Return(nil)
+
+		result, err := service.PatchUser(ctx, usecase.PatchUserRequest{UserID: "u1", Username: &username, IsActive: &inactive})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Alice Cooper", result.Username)
+		assert.False(t, result.IsActive)
+	})
+
+	t.Run("error - failed deactivation rolls back the rename", func(t *testing.T) {
+		mockUOW.EXPECT().WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).
+			Times(1)
+		mockUserRepo.EXPECT().
+			UpdateUsername(ctx, "u1", "Alice Cooper").
+			Return(&domain.User{UserID: "u1", Username: "Alice Cooper", IsActive: true}, nil)
+		mockUserRepo.EXPECT().
+			GetUserForUpdate(ctx, "u1").
+			Return(nil, errors.New("database error"))
+
+		result, err := service.PatchUser(ctx, usecase.PatchUserRequest{UserID: "u1", Username: &username, IsActive: &inactive})
+
+		require.EqualError(t, err, "database error")
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - invalid username is rejected before the transaction", func(t *testing.T) {
+		blank := "   "
+
+		result, err := service.PatchUser(ctx, usecase.PatchUserRequest{UserID: "u1", Username: &blank, IsActive: &inactive})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "username is required")
+	})
+
+	t.Run("error - nothing to change", func(t *testing.T) {
+		result, err := service.PatchUser(ctx, usecase.PatchUserRequest{UserID: "u1"})
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "required_without", validationErr.Rule)
+		assert.Nil(t, result)
+	})
+}
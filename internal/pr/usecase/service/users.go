@@ -19,10 +19,129 @@ func NewUserService(uow repository.UnitOfWork) *UserService {
 
 func (s *UserService) SetIsActive(ctx context.Context, req usecase.SetUserIsActiveRequest) (*domain.User, error) {
 	if req.UserID == "" {
-		return nil, fmt.Errorf("user_id is required")
+		return nil, domain.RequiredError("user_id")
 	}
 
-	user, err := s.uow.Users().SetUserIsActive(ctx, req.UserID, req.IsActive)
+	var user *domain.User
+	err := s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		user, err = s.setIsActive(txCtx, req.UserID, req.IsActive)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// setIsActive must run in a transaction: the user row stays locked until the
+// deactivation event is recorded.
+func (s *UserService) setIsActive(txCtx context.Context, userID string, isActive bool) (*domain.User, error) {
+	current, err := s.uow.Users().GetUserForUpdate(txCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.uow.Users().SetUserIsActive(txCtx, userID, isActive)
+	if err != nil {
+		return nil, err
+	}
+
+	if !current.IsActive || user.IsActive {
+		return user, nil
+	}
+	if err := s.uow.Events().RecordEvents(txCtx, []domain.Event{userDeactivatedEvent(user)}); err != nil {
+		return nil, fmt.Errorf("record deactivation event: %w", err)
+	}
+	return user, nil
+}
+
+func (s *UserService) GetUserProfile(ctx context.Context, userID string) (*domain.UserProfile, error) {
+	if userID == "" {
+		return nil, domain.RequiredError("user_id")
+	}
+
+	user, err := s.uow.Users().GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.uow.Users().ListUserTeams(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list user teams: %w", err)
+	}
+
+	openReviews, err := s.uow.Reviewers().CountOpenReviews(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("count open reviews: %w", err)
+	}
+
+	authoredPRs, err := s.uow.PullRequests().ListOpenPRsByAuthor(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list authored PRs: %w", err)
+	}
+
+	return &domain.UserProfile{
+		User:             *user,
+		Teams:            teams,
+		OpenReviewsCount: openReviews,
+		AuthoredOpenPRs:  authoredPRs,
+	}, nil
+}
+
+func (s *UserService) UpdateUser(ctx context.Context, req usecase.UpdateUserRequest) (*domain.User, error) {
+	if req.UserID == "" {
+		return nil, domain.RequiredError("user_id")
+	}
+
+	username, err := domain.NormalizeUsername(req.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.uow.Users().UpdateUsername(ctx, req.UserID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *UserService) PatchUser(ctx context.Context, req usecase.PatchUserRequest) (*domain.User, error) {
+	if req.UserID == "" {
+		return nil, domain.RequiredError("user_id")
+	}
+	if req.Username == nil && req.IsActive == nil {
+		return nil, domain.NewValidationError("username", "required_without", "is required when is_active is not set")
+	}
+
+	var username string
+	if req.Username != nil {
+		var err error
+		username, err = domain.NormalizeUsername(*req.Username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var user *domain.User
+	err := s.uow.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		if req.Username != nil {
+			user, err = s.uow.Users().UpdateUsername(txCtx, req.UserID, username)
+			if err != nil {
+				return err
+			}
+		}
+		if req.IsActive != nil {
+			user, err = s.setIsActive(txCtx, req.UserID, *req.IsActive)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1,12 +1,36 @@
 package usecase
 
+import "github.com/NutsBalls/Backend-trainee-assignment-autumn-2025/internal/pr/domain"
+
 type CreateTeamRequest struct {
-	TeamName string
-	Members  []CreateTeamMember
+	TeamName       string
+	ParentTeamName string
+	Settings       domain.TeamSettings
+	Members        []CreateTeamMember
 }
 
 type CreateTeamMember struct {
 	UserID   string
 	Username string
 	IsActive bool
+	Role     domain.TeamRole
+}
+
+type AddTeamMemberRequest struct {
+	TeamName string
+	UserID   string
+	Role     domain.TeamRole
+	ActorID  string
+}
+
+type SetParentTeamRequest struct {
+	TeamName       string
+	ParentTeamName string
+	ActorID        string
+}
+
+type UpdateTeamSettingsRequest struct {
+	TeamName string
+	Settings domain.TeamSettings
+	ActorID  string
 }
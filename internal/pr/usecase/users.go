@@ -4,3 +4,15 @@ type SetUserIsActiveRequest struct {
 	UserID   string
 	IsActive bool
 }
+
+type UpdateUserRequest struct {
+	UserID   string
+	Username string
+}
+
+// PatchUserRequest changes only the fields that are set.
+type PatchUserRequest struct {
+	UserID   string
+	Username *string
+	IsActive *bool
+}
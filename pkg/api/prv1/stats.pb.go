@@ -0,0 +1,2261 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: pr/v1/stats.proto
+
+package prv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WorkloadSort int32
+
+const (
+	WorkloadSort_WORKLOAD_SORT_UNSPECIFIED        WorkloadSort = 0
+	WorkloadSort_WORKLOAD_SORT_OPEN_PRS           WorkloadSort = 1
+	WorkloadSort_WORKLOAD_SORT_OLDEST_OPEN        WorkloadSort = 2
+	WorkloadSort_WORKLOAD_SORT_RECENT_ASSIGNMENTS WorkloadSort = 3
+	WorkloadSort_WORKLOAD_SORT_USERNAME           WorkloadSort = 4
+)
+
+// Enum value maps for WorkloadSort.
+var (
+	WorkloadSort_name = map[int32]string{
+		0: "WORKLOAD_SORT_UNSPECIFIED",
+		1: "WORKLOAD_SORT_OPEN_PRS",
+		2: "WORKLOAD_SORT_OLDEST_OPEN",
+		3: "WORKLOAD_SORT_RECENT_ASSIGNMENTS",
+		4: "WORKLOAD_SORT_USERNAME",
+	}
+	WorkloadSort_value = map[string]int32{
+		"WORKLOAD_SORT_UNSPECIFIED":        0,
+		"WORKLOAD_SORT_OPEN_PRS":           1,
+		"WORKLOAD_SORT_OLDEST_OPEN":        2,
+		"WORKLOAD_SORT_RECENT_ASSIGNMENTS": 3,
+		"WORKLOAD_SORT_USERNAME":           4,
+	}
+)
+
+func (x WorkloadSort) Enum() *WorkloadSort {
+	p := new(WorkloadSort)
+	*p = x
+	return p
+}
+
+func (x WorkloadSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkloadSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_pr_v1_stats_proto_enumTypes[0].Descriptor()
+}
+
+func (WorkloadSort) Type() protoreflect.EnumType {
+	return &file_pr_v1_stats_proto_enumTypes[0]
+}
+
+func (x WorkloadSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkloadSort.Descriptor instead.
+func (WorkloadSort) EnumDescriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{0}
+}
+
+type StatsInterval int32
+
+const (
+	StatsInterval_STATS_INTERVAL_UNSPECIFIED StatsInterval = 0
+	StatsInterval_STATS_INTERVAL_DAY         StatsInterval = 1
+	StatsInterval_STATS_INTERVAL_WEEK        StatsInterval = 2
+	StatsInterval_STATS_INTERVAL_MONTH       StatsInterval = 3
+)
+
+// Enum value maps for StatsInterval.
+var (
+	StatsInterval_name = map[int32]string{
+		0: "STATS_INTERVAL_UNSPECIFIED",
+		1: "STATS_INTERVAL_DAY",
+		2: "STATS_INTERVAL_WEEK",
+		3: "STATS_INTERVAL_MONTH",
+	}
+	StatsInterval_value = map[string]int32{
+		"STATS_INTERVAL_UNSPECIFIED": 0,
+		"STATS_INTERVAL_DAY":         1,
+		"STATS_INTERVAL_WEEK":        2,
+		"STATS_INTERVAL_MONTH":       3,
+	}
+)
+
+func (x StatsInterval) Enum() *StatsInterval {
+	p := new(StatsInterval)
+	*p = x
+	return p
+}
+
+func (x StatsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_pr_v1_stats_proto_enumTypes[1].Descriptor()
+}
+
+func (StatsInterval) Type() protoreflect.EnumType {
+	return &file_pr_v1_stats_proto_enumTypes[1]
+}
+
+func (x StatsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsInterval.Descriptor instead.
+func (StatsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{1}
+}
+
+// StatsFilter narrows a report to the half-open [from, to) window and a
+// single team. Unset fields mean no restriction.
+type StatsFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsFilter) Reset() {
+	*x = StatsFilter{}
+	mi := &file_pr_v1_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsFilter) ProtoMessage() {}
+
+func (x *StatsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsFilter.ProtoReflect.Descriptor instead.
+func (*StatsFilter) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StatsFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type UserAssignmentStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username         string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName         string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	AssignmentsCount int64                  `protobuf:"varint,4,opt,name=assignments_count,json=assignmentsCount,proto3" json:"assignments_count,omitempty"`
+	// Reviews of PRs merged in the range and their mean time from assignment
+	// to merge; only set by GetUserStats.
+	ReviewsMerged    int64                `protobuf:"varint,5,opt,name=reviews_merged,json=reviewsMerged,proto3" json:"reviews_merged,omitempty"`
+	MeanTimeInReview *durationpb.Duration `protobuf:"bytes,6,opt,name=mean_time_in_review,json=meanTimeInReview,proto3" json:"mean_time_in_review,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserAssignmentStats) Reset() {
+	*x = UserAssignmentStats{}
+	mi := &file_pr_v1_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAssignmentStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAssignmentStats) ProtoMessage() {}
+
+func (x *UserAssignmentStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAssignmentStats.ProtoReflect.Descriptor instead.
+func (*UserAssignmentStats) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserAssignmentStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAssignmentStats) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserAssignmentStats) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *UserAssignmentStats) GetAssignmentsCount() int64 {
+	if x != nil {
+		return x.AssignmentsCount
+	}
+	return 0
+}
+
+func (x *UserAssignmentStats) GetReviewsMerged() int64 {
+	if x != nil {
+		return x.ReviewsMerged
+	}
+	return 0
+}
+
+func (x *UserAssignmentStats) GetMeanTimeInReview() *durationpb.Duration {
+	if x != nil {
+		return x.MeanTimeInReview
+	}
+	return nil
+}
+
+type GetUserStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserStatsRequest) Reset() {
+	*x = GetUserStatsRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsRequest) ProtoMessage() {}
+
+func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUserStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetUserStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserAssignmentStats `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserStatsResponse) Reset() {
+	*x = GetUserStatsResponse{}
+	mi := &file_pr_v1_stats_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsResponse) ProtoMessage() {}
+
+func (x *GetUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserStatsResponse) GetUsers() []*UserAssignmentStats {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetPullRequestStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestStatsRequest) Reset() {
+	*x = GetPullRequestStatsRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestStatsRequest) ProtoMessage() {}
+
+func (x *GetPullRequestStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPullRequestStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type PullRequestStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Open          int64                  `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	Merged        int64                  `protobuf:"varint,3,opt,name=merged,proto3" json:"merged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestStats) Reset() {
+	*x = PullRequestStats{}
+	mi := &file_pr_v1_stats_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestStats) ProtoMessage() {}
+
+func (x *PullRequestStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestStats.ProtoReflect.Descriptor instead.
+func (*PullRequestStats) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PullRequestStats) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PullRequestStats) GetOpen() int64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *PullRequestStats) GetMerged() int64 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+type ReviewerWorkload struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username              string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName              string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	OpenPrsCount          int64                  `protobuf:"varint,4,opt,name=open_prs_count,json=openPrsCount,proto3" json:"open_prs_count,omitempty"`
+	OldestOpenAge         *durationpb.Duration   `protobuf:"bytes,5,opt,name=oldest_open_age,json=oldestOpenAge,proto3" json:"oldest_open_age,omitempty"`
+	AssignmentsLast_7Days int64                  `protobuf:"varint,6,opt,name=assignments_last_7_days,json=assignmentsLast7Days,proto3" json:"assignments_last_7_days,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ReviewerWorkload) Reset() {
+	*x = ReviewerWorkload{}
+	mi := &file_pr_v1_stats_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerWorkload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerWorkload) ProtoMessage() {}
+
+func (x *ReviewerWorkload) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerWorkload.ProtoReflect.Descriptor instead.
+func (*ReviewerWorkload) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReviewerWorkload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerWorkload) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReviewerWorkload) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ReviewerWorkload) GetOpenPrsCount() int64 {
+	if x != nil {
+		return x.OpenPrsCount
+	}
+	return 0
+}
+
+func (x *ReviewerWorkload) GetOldestOpenAge() *durationpb.Duration {
+	if x != nil {
+		return x.OldestOpenAge
+	}
+	return nil
+}
+
+func (x *ReviewerWorkload) GetAssignmentsLast_7Days() int64 {
+	if x != nil {
+		return x.AssignmentsLast_7Days
+	}
+	return 0
+}
+
+type GetReviewerWorkloadRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Unspecified sorts by open PRs.
+	Sort          WorkloadSort `protobuf:"varint,2,opt,name=sort,proto3,enum=pr.v1.WorkloadSort" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerWorkloadRequest) Reset() {
+	*x = GetReviewerWorkloadRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerWorkloadRequest) ProtoMessage() {}
+
+func (x *GetReviewerWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewerWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetReviewerWorkloadRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetReviewerWorkloadRequest) GetSort() WorkloadSort {
+	if x != nil {
+		return x.Sort
+	}
+	return WorkloadSort_WORKLOAD_SORT_UNSPECIFIED
+}
+
+type GetReviewerWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviewers     []*ReviewerWorkload    `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerWorkloadResponse) Reset() {
+	*x = GetReviewerWorkloadResponse{}
+	mi := &file_pr_v1_stats_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerWorkloadResponse) ProtoMessage() {}
+
+func (x *GetReviewerWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewerWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetReviewerWorkloadResponse) GetReviewers() []*ReviewerWorkload {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type TeamRollupStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TeamName         string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ParentTeamName   string                 `protobuf:"bytes,2,opt,name=parent_team_name,json=parentTeamName,proto3" json:"parent_team_name,omitempty"`
+	TeamsCount       int64                  `protobuf:"varint,3,opt,name=teams_count,json=teamsCount,proto3" json:"teams_count,omitempty"`
+	MembersCount     int64                  `protobuf:"varint,4,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	AssignmentsCount int64                  `protobuf:"varint,5,opt,name=assignments_count,json=assignmentsCount,proto3" json:"assignments_count,omitempty"`
+	OpenPrs          int64                  `protobuf:"varint,6,opt,name=open_prs,json=openPrs,proto3" json:"open_prs,omitempty"`
+	MergedPrs        int64                  `protobuf:"varint,7,opt,name=merged_prs,json=mergedPrs,proto3" json:"merged_prs,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TeamRollupStats) Reset() {
+	*x = TeamRollupStats{}
+	mi := &file_pr_v1_stats_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamRollupStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamRollupStats) ProtoMessage() {}
+
+func (x *TeamRollupStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamRollupStats.ProtoReflect.Descriptor instead.
+func (*TeamRollupStats) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TeamRollupStats) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamRollupStats) GetParentTeamName() string {
+	if x != nil {
+		return x.ParentTeamName
+	}
+	return ""
+}
+
+func (x *TeamRollupStats) GetTeamsCount() int64 {
+	if x != nil {
+		return x.TeamsCount
+	}
+	return 0
+}
+
+func (x *TeamRollupStats) GetMembersCount() int64 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *TeamRollupStats) GetAssignmentsCount() int64 {
+	if x != nil {
+		return x.AssignmentsCount
+	}
+	return 0
+}
+
+func (x *TeamRollupStats) GetOpenPrs() int64 {
+	if x != nil {
+		return x.OpenPrs
+	}
+	return 0
+}
+
+func (x *TeamRollupStats) GetMergedPrs() int64 {
+	if x != nil {
+		return x.MergedPrs
+	}
+	return 0
+}
+
+type GetTeamStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsRequest) Reset() {
+	*x = GetTeamStatsRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsRequest) ProtoMessage() {}
+
+func (x *GetTeamStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTeamStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetTeamStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamRollupStats     `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsResponse) Reset() {
+	*x = GetTeamStatsResponse{}
+	mi := &file_pr_v1_stats_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsResponse) ProtoMessage() {}
+
+func (x *GetTeamStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTeamStatsResponse) GetTeams() []*TeamRollupStats {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type ReviewerPair struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId         string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorUsername   string                 `protobuf:"bytes,2,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	ReviewerId       string                 `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	ReviewerUsername string                 `protobuf:"bytes,4,opt,name=reviewer_username,json=reviewerUsername,proto3" json:"reviewer_username,omitempty"`
+	ReviewsCount     int64                  `protobuf:"varint,5,opt,name=reviews_count,json=reviewsCount,proto3" json:"reviews_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReviewerPair) Reset() {
+	*x = ReviewerPair{}
+	mi := &file_pr_v1_stats_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerPair) ProtoMessage() {}
+
+func (x *ReviewerPair) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerPair.ProtoReflect.Descriptor instead.
+func (*ReviewerPair) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReviewerPair) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ReviewerPair) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *ReviewerPair) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewerPair) GetReviewerUsername() string {
+	if x != nil {
+		return x.ReviewerUsername
+	}
+	return ""
+}
+
+func (x *ReviewerPair) GetReviewsCount() int64 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+type GetReviewerPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerPairsRequest) Reset() {
+	*x = GetReviewerPairsRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerPairsRequest) ProtoMessage() {}
+
+func (x *GetReviewerPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerPairsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewerPairsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetReviewerPairsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetReviewerPairsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairs         []*ReviewerPair        `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerPairsResponse) Reset() {
+	*x = GetReviewerPairsResponse{}
+	mi := &file_pr_v1_stats_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerPairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerPairsResponse) ProtoMessage() {}
+
+func (x *GetReviewerPairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerPairsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewerPairsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetReviewerPairsResponse) GetPairs() []*ReviewerPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+type TeamFairness struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TeamName          string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MembersCount      int64                  `protobuf:"varint,2,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	TotalAssignments  int64                  `protobuf:"varint,3,opt,name=total_assignments,json=totalAssignments,proto3" json:"total_assignments,omitempty"`
+	MinAssignments    int64                  `protobuf:"varint,4,opt,name=min_assignments,json=minAssignments,proto3" json:"min_assignments,omitempty"`
+	MaxAssignments    int64                  `protobuf:"varint,5,opt,name=max_assignments,json=maxAssignments,proto3" json:"max_assignments,omitempty"`
+	MeanAssignments   float64                `protobuf:"fixed64,6,opt,name=mean_assignments,json=meanAssignments,proto3" json:"mean_assignments,omitempty"`
+	StddevAssignments float64                `protobuf:"fixed64,7,opt,name=stddev_assignments,json=stddevAssignments,proto3" json:"stddev_assignments,omitempty"`
+	Gini              float64                `protobuf:"fixed64,8,opt,name=gini,proto3" json:"gini,omitempty"`
+	OverloadedMembers []*UserAssignmentStats `protobuf:"bytes,9,rep,name=overloaded_members,json=overloadedMembers,proto3" json:"overloaded_members,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TeamFairness) Reset() {
+	*x = TeamFairness{}
+	mi := &file_pr_v1_stats_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamFairness) ProtoMessage() {}
+
+func (x *TeamFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamFairness.ProtoReflect.Descriptor instead.
+func (*TeamFairness) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TeamFairness) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamFairness) GetMembersCount() int64 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetTotalAssignments() int64 {
+	if x != nil {
+		return x.TotalAssignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMinAssignments() int64 {
+	if x != nil {
+		return x.MinAssignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMaxAssignments() int64 {
+	if x != nil {
+		return x.MaxAssignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMeanAssignments() float64 {
+	if x != nil {
+		return x.MeanAssignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetStddevAssignments() float64 {
+	if x != nil {
+		return x.StddevAssignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetGini() float64 {
+	if x != nil {
+		return x.Gini
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetOverloadedMembers() []*UserAssignmentStats {
+	if x != nil {
+		return x.OverloadedMembers
+	}
+	return nil
+}
+
+type GetFairnessReportRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Members above the team mean by this percentage are reported as
+	// overloaded; 50 when unset.
+	ThresholdPct  *float64 `protobuf:"fixed64,2,opt,name=threshold_pct,json=thresholdPct,proto3,oneof" json:"threshold_pct,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFairnessReportRequest) Reset() {
+	*x = GetFairnessReportRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFairnessReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFairnessReportRequest) ProtoMessage() {}
+
+func (x *GetFairnessReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFairnessReportRequest.ProtoReflect.Descriptor instead.
+func (*GetFairnessReportRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetFairnessReportRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetFairnessReportRequest) GetThresholdPct() float64 {
+	if x != nil && x.ThresholdPct != nil {
+		return *x.ThresholdPct
+	}
+	return 0
+}
+
+type GetFairnessReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThresholdPct  float64                `protobuf:"fixed64,1,opt,name=threshold_pct,json=thresholdPct,proto3" json:"threshold_pct,omitempty"`
+	Teams         []*TeamFairness        `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFairnessReportResponse) Reset() {
+	*x = GetFairnessReportResponse{}
+	mi := &file_pr_v1_stats_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFairnessReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFairnessReportResponse) ProtoMessage() {}
+
+func (x *GetFairnessReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFairnessReportResponse.ProtoReflect.Descriptor instead.
+func (*GetFairnessReportResponse) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetFairnessReportResponse) GetThresholdPct() float64 {
+	if x != nil {
+		return x.ThresholdPct
+	}
+	return 0
+}
+
+func (x *GetFairnessReportResponse) GetTeams() []*TeamFairness {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type CycleTimeSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MergedPrs     int64                  `protobuf:"varint,1,opt,name=merged_prs,json=mergedPrs,proto3" json:"merged_prs,omitempty"`
+	Mean          *durationpb.Duration   `protobuf:"bytes,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	Median        *durationpb.Duration   `protobuf:"bytes,3,opt,name=median,proto3" json:"median,omitempty"`
+	P90           *durationpb.Duration   `protobuf:"bytes,4,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99           *durationpb.Duration   `protobuf:"bytes,5,opt,name=p99,proto3" json:"p99,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleTimeSummary) Reset() {
+	*x = CycleTimeSummary{}
+	mi := &file_pr_v1_stats_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleTimeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleTimeSummary) ProtoMessage() {}
+
+func (x *CycleTimeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleTimeSummary.ProtoReflect.Descriptor instead.
+func (*CycleTimeSummary) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CycleTimeSummary) GetMergedPrs() int64 {
+	if x != nil {
+		return x.MergedPrs
+	}
+	return 0
+}
+
+func (x *CycleTimeSummary) GetMean() *durationpb.Duration {
+	if x != nil {
+		return x.Mean
+	}
+	return nil
+}
+
+func (x *CycleTimeSummary) GetMedian() *durationpb.Duration {
+	if x != nil {
+		return x.Median
+	}
+	return nil
+}
+
+func (x *CycleTimeSummary) GetP90() *durationpb.Duration {
+	if x != nil {
+		return x.P90
+	}
+	return nil
+}
+
+func (x *CycleTimeSummary) GetP99() *durationpb.Duration {
+	if x != nil {
+		return x.P99
+	}
+	return nil
+}
+
+type TeamCycleTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Summary       *CycleTimeSummary      `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamCycleTime) Reset() {
+	*x = TeamCycleTime{}
+	mi := &file_pr_v1_stats_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamCycleTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamCycleTime) ProtoMessage() {}
+
+func (x *TeamCycleTime) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamCycleTime.ProtoReflect.Descriptor instead.
+func (*TeamCycleTime) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TeamCycleTime) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamCycleTime) GetSummary() *CycleTimeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type AuthorCycleTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Summary       *CycleTimeSummary      `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorCycleTime) Reset() {
+	*x = AuthorCycleTime{}
+	mi := &file_pr_v1_stats_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorCycleTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorCycleTime) ProtoMessage() {}
+
+func (x *AuthorCycleTime) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorCycleTime.ProtoReflect.Descriptor instead.
+func (*AuthorCycleTime) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuthorCycleTime) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthorCycleTime) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AuthorCycleTime) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AuthorCycleTime) GetSummary() *CycleTimeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type CycleTimeBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *durationpb.Duration   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Unset for the open-ended last bucket.
+	To            *durationpb.Duration `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Count         int64                `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleTimeBucket) Reset() {
+	*x = CycleTimeBucket{}
+	mi := &file_pr_v1_stats_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleTimeBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleTimeBucket) ProtoMessage() {}
+
+func (x *CycleTimeBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleTimeBucket.ProtoReflect.Descriptor instead.
+func (*CycleTimeBucket) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CycleTimeBucket) GetFrom() *durationpb.Duration {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CycleTimeBucket) GetTo() *durationpb.Duration {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *CycleTimeBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetCycleTimeStatsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Ascending histogram bounds; the default buckets when empty.
+	Buckets       []*durationpb.Duration `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCycleTimeStatsRequest) Reset() {
+	*x = GetCycleTimeStatsRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCycleTimeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCycleTimeStatsRequest) ProtoMessage() {}
+
+func (x *GetCycleTimeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCycleTimeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCycleTimeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCycleTimeStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetCycleTimeStatsRequest) GetBuckets() []*durationpb.Duration {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type CycleTimeStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamCycleTime       `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	Authors       []*AuthorCycleTime     `protobuf:"bytes,2,rep,name=authors,proto3" json:"authors,omitempty"`
+	Histogram     []*CycleTimeBucket     `protobuf:"bytes,3,rep,name=histogram,proto3" json:"histogram,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleTimeStats) Reset() {
+	*x = CycleTimeStats{}
+	mi := &file_pr_v1_stats_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleTimeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleTimeStats) ProtoMessage() {}
+
+func (x *CycleTimeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleTimeStats.ProtoReflect.Descriptor instead.
+func (*CycleTimeStats) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CycleTimeStats) GetTeams() []*TeamCycleTime {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *CycleTimeStats) GetAuthors() []*AuthorCycleTime {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *CycleTimeStats) GetHistogram() []*CycleTimeBucket {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+type TimeSeriesPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	PrsOpened     int64                  `protobuf:"varint,2,opt,name=prs_opened,json=prsOpened,proto3" json:"prs_opened,omitempty"`
+	PrsMerged     int64                  `protobuf:"varint,3,opt,name=prs_merged,json=prsMerged,proto3" json:"prs_merged,omitempty"`
+	Assignments   int64                  `protobuf:"varint,4,opt,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeSeriesPoint) Reset() {
+	*x = TimeSeriesPoint{}
+	mi := &file_pr_v1_stats_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSeriesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSeriesPoint) ProtoMessage() {}
+
+func (x *TimeSeriesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSeriesPoint.ProtoReflect.Descriptor instead.
+func (*TimeSeriesPoint) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TimeSeriesPoint) GetBucket() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *TimeSeriesPoint) GetPrsOpened() int64 {
+	if x != nil {
+		return x.PrsOpened
+	}
+	return 0
+}
+
+func (x *TimeSeriesPoint) GetPrsMerged() int64 {
+	if x != nil {
+		return x.PrsMerged
+	}
+	return 0
+}
+
+func (x *TimeSeriesPoint) GetAssignments() int64 {
+	if x != nil {
+		return x.Assignments
+	}
+	return 0
+}
+
+type GetTimeSeriesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Unspecified means daily buckets.
+	Interval      StatsInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=pr.v1.StatsInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimeSeriesRequest) Reset() {
+	*x = GetTimeSeriesRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTimeSeriesRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetTimeSeriesRequest) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+type GetTimeSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      StatsInterval          `protobuf:"varint,1,opt,name=interval,proto3,enum=pr.v1.StatsInterval" json:"interval,omitempty"`
+	Points        []*TimeSeriesPoint     `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimeSeriesResponse) Reset() {
+	*x = GetTimeSeriesResponse{}
+	mi := &file_pr_v1_stats_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTimeSeriesResponse) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetTimeSeriesResponse) GetPoints() []*TimeSeriesPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type StalePullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	TeamName          string                 `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Age               *durationpb.Duration   `protobuf:"bytes,7,opt,name=age,proto3" json:"age,omitempty"`
+	Threshold         *durationpb.Duration   `protobuf:"bytes,8,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	FlaggedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=flagged_at,json=flaggedAt,proto3" json:"flagged_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StalePullRequest) Reset() {
+	*x = StalePullRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StalePullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StalePullRequest) ProtoMessage() {}
+
+func (x *StalePullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StalePullRequest.ProtoReflect.Descriptor instead.
+func (*StalePullRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StalePullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *StalePullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *StalePullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *StalePullRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *StalePullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *StalePullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StalePullRequest) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *StalePullRequest) GetThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *StalePullRequest) GetFlaggedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FlaggedAt
+	}
+	return nil
+}
+
+type GetStalePullRequestsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Overrides the per-team thresholds when set.
+	OlderThan     *durationpb.Duration `protobuf:"bytes,2,opt,name=older_than,json=olderThan,proto3" json:"older_than,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStalePullRequestsRequest) Reset() {
+	*x = GetStalePullRequestsRequest{}
+	mi := &file_pr_v1_stats_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStalePullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStalePullRequestsRequest) ProtoMessage() {}
+
+func (x *GetStalePullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStalePullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetStalePullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetStalePullRequestsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetStalePullRequestsRequest) GetOlderThan() *durationpb.Duration {
+	if x != nil {
+		return x.OlderThan
+	}
+	return nil
+}
+
+type GetStalePullRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests  []*StalePullRequest    `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStalePullRequestsResponse) Reset() {
+	*x = GetStalePullRequestsResponse{}
+	mi := &file_pr_v1_stats_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStalePullRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStalePullRequestsResponse) ProtoMessage() {}
+
+func (x *GetStalePullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_stats_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStalePullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetStalePullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_v1_stats_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetStalePullRequestsResponse) GetPullRequests() []*StalePullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+var File_pr_v1_stats_proto protoreflect.FileDescriptor
+
+const file_pr_v1_stats_proto_rawDesc = "" +
+	"\n" +
+	"\x11pr/v1/stats.proto\x12\x05pr.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x01\n" +
+	"\vStatsFilter\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\"\x85\x02\n" +
+	"\x13UserAssignmentStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12+\n" +
+	"\x11assignments_count\x18\x04 \x01(\x03R\x10assignmentsCount\x12%\n" +
+	"\x0ereviews_merged\x18\x05 \x01(\x03R\rreviewsMerged\x12H\n" +
+	"\x13mean_time_in_review\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x10meanTimeInReview\"A\n" +
+	"\x13GetUserStatsRequest\x12*\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.pr.v1.StatsFilterR\x06filter\"H\n" +
+	"\x14GetUserStatsResponse\x120\n" +
+	"\x05users\x18\x01 \x03(\v2\x1a.pr.v1.UserAssignmentStatsR\x05users\"H\n" +
+	"\x1aGetPullRequestStatsRequest\x12*\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.pr.v1.StatsFilterR\x06filter\"T\n" +
+	"\x10PullRequestStats\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x03R\x04open\x12\x16\n" +
+	"\x06merged\x18\x03 \x01(\x03R\x06merged\"\x84\x02\n" +
+	"\x10ReviewerWorkload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12$\n" +
+	"\x0eopen_prs_count\x18\x04 \x01(\x03R\fopenPrsCount\x12A\n" +
+	"\x0foldest_open_age\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\roldestOpenAge\x125\n" +
+	"\x17assignments_last_7_days\x18\x06 \x01(\x03R\x14assignmentsLast7Days\"q\n" +
+	"\x1aGetReviewerWorkloadRequest\x12*\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.pr.v1.StatsFilterR\x06filter\x12'\n" +
+	"\x04sort\x18\x02 \x01(\x0e2\x13.pr.v1.WorkloadSortR\x04sort\"T\n" +
+	"\x1bGetReviewerWorkloadResponse\x125\n" +
+	"\treviewers\x18\x01 \x03(\v2\x17.pr.v1.ReviewerWorkloadR\treviewers\"\x85\x02\n" +
+	"\x0fTeamRollupStats\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12(\n" +
+	"\x10parent_team_name\x18\x02 \x01(\tR\x0eparentTeamName\x12\x1f\n" +
+	"\vteams_count\x18\x03 \x01(\x03R\n" +
+	"teamsCount\x12#\n" +
+	"\rmembers_count\x18\x04 \x01(\x03R\fmembersCount\x12+\n" +
+	"\x11assignments_count\x18\x05 \x01(\x03R\x10assignmentsCount\x12\x19\n" +
+	"\bopen_prs\x18\x06 \x01(\x03R\aopenPrs\x12\x1d\n" +
+	"\n" +
+	"merged_prs\x18\a \x01(\x03R\tmergedPrs\"A\n" +
+	"\x13GetTeamStatsRequest\x12*\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.pr.v1.StatsFilterR\x06filter\"D\n" +
+	"\x14GetTeamStatsResponse\x12,\n" +
+	"\x05teams\x18\x01 \x03(\v2\x16.pr.v1.TeamRollupStatsR\x05teams\"\xc7\x01\n" +
+	"\fReviewerPair\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12'\n" +
+	"\x0fauthor_username\x18\x02 \x01(\tR\x0eauthorUsername\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x12+\n" +
+	"\x11reviewer_username\x18\x04 \x01(\tR\x10reviewerUsername\x12#\n" +
+	"\rreviews_count\x18\x05 \x01(\x03R\freviewsCount\"E\n" +
+	"\x17GetReviewerPairsRequest\x12*\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.pr.v1.StatsFilterR\x06filter\"E\n" +
+	"\x18GetReviewerPairsResponse\x12)\n" +
+	"\x05pairs\x18\x01 \x03(\v2\x13.pr.v1.ReviewerPairR\x05pairs\"\x88\x03\n" +
+	"\fTeamFairness\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12#\n" +
+	"\rmembers_count\x18\x02 \x01(\x03R\fmembersCount\x12+\n" +
+	"\x11total_assignments\x18\x03 \x01(\x03R\x10totalAssignments\x12'\n" +
+	"\x0fmin_assignments\x18\x04 \x01(\x03R\x0eminAssignments\x12'\n" +
+	"\x0fmax_assignments\x18\x05 \x01(\x03R\x0emaxAssignments\x12)\n" +
+	"\x10mean_assignments\x18\x06 \x01(\x01R\x0fmeanAssignments\x12-\n" +
+	"\x12stddev_assignments\x18\a \x01(\x01R\x11stddevAssignments\x12\x12\n" +
+	"\x04gini\x18\b \x01(\x01R\x04gini\x12I\n" +
+	"\x12overloaded_members\x18\t \x03(\v2\x1a.pr.v1.UserAssignmentStatsR\x11overloadedMembers\"\x82\x01\n" +
+	"\x18GetFairnessReportRequest\x12*\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.pr.v1.StatsFilterR\x06filter\x12(\n" +
+	"\rthreshold_pct\x18\x02 \x01(\x01H\x00R\fthresholdPct\x88\x01\x01B\x10\n" +
+	"\x0e_threshold_pct\"k\n" +
+	"\x19GetFairnessReportResponse\x12#\n" +
+	"\rthreshold_pct\x18\x01 \x01(\x01R\fthresholdPct\x12)\n" +
+	"\x05teams\x18\x02 \x03(\v2\x13.pr.v1.TeamFairnessR\x05teams\"\xed\x01\n" +
+	"\x10CycleTimeSummary\x12\x1d\n" +
+	"\n" +
+	"merged_prs\x18\x01 \x01(\x03R\tmergedPrs\x12-\n" +
+	"\x04mean\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x04mean\x121\n" +
+	"\x06median\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06median\x12+\n" +
+	"\x03p90\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03p90\x12+\n" +
+	"\x03p99\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x03p99\"_\n" +
+	"\rTeamCycleTime\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\asummary\x18\x02 \x01(\v2\x17.pr.v1.CycleTimeSummaryR\asummary\"\x96\x01\n" +
+	"\x0fAuthorCycleTime\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x121\n" +
+	"\asummary\x18\x04 \x01(\v2\x17.pr.v1.CycleTimeSummaryR\asummary\"\x81\x01\n" +
+	"\x0fCycleTimeBucket\x12-\n" +
+	"\x04from\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x04from\x12)\n" +
+	"\x02to\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x02to\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"{\n" +
+	"\x18GetCycleTimeStatsRequest\x12*\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.pr.v1.StatsFilterR\x06filter\x123\n" +
+	"\abuckets\x18\x02 \x03(\v2\x19.google.protobuf.DurationR\abuckets\"\xa4\x01\n" +
+	"\x0eCycleTimeStats\x12*\n" +
+	"\x05teams\x18\x01 \x03(\v2\x14.pr.v1.TeamCycleTimeR\x05teams\x120\n" +
+	"\aauthors\x18\x02 \x03(\v2\x16.pr.v1.AuthorCycleTimeR\aauthors\x124\n" +
+	"\thistogram\x18\x03 \x03(\v2\x16.pr.v1.CycleTimeBucketR\thistogram\"\xa5\x01\n" +
+	"\x0fTimeSeriesPoint\x122\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06bucket\x12\x1d\n" +
+	"\n" +
+	"prs_opened\x18\x02 \x01(\x03R\tprsOpened\x12\x1d\n" +
+	"\n" +
+	"prs_merged\x18\x03 \x01(\x03R\tprsMerged\x12 \n" +
+	"\vassignments\x18\x04 \x01(\x03R\vassignments\"t\n" +
+	"\x14GetTimeSeriesRequest\x12*\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.pr.v1.StatsFilterR\x06filter\x120\n" +
+	"\binterval\x18\x02 \x01(\x0e2\x14.pr.v1.StatsIntervalR\binterval\"y\n" +
+	"\x15GetTimeSeriesResponse\x120\n" +
+	"\binterval\x18\x01 \x01(\x0e2\x14.pr.v1.StatsIntervalR\binterval\x12.\n" +
+	"\x06points\x18\x02 \x03(\v2\x16.pr.v1.TimeSeriesPointR\x06points\"\xab\x03\n" +
+	"\x10StalePullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12+\n" +
+	"\x03age\x18\a \x01(\v2\x19.google.protobuf.DurationR\x03age\x127\n" +
+	"\tthreshold\x18\b \x01(\v2\x19.google.protobuf.DurationR\tthreshold\x129\n" +
+	"\n" +
+	"flagged_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tflaggedAt\"\x83\x01\n" +
+	"\x1bGetStalePullRequestsRequest\x12*\n" +
+	"\x06filter\x18\x01 \x01(\v2\x12.pr.v1.StatsFilterR\x06filter\x128\n" +
+	"\n" +
+	"older_than\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\tolderThan\"\\\n" +
+	"\x1cGetStalePullRequestsResponse\x12<\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x17.pr.v1.StalePullRequestR\fpullRequests*\xaa\x01\n" +
+	"\fWorkloadSort\x12\x1d\n" +
+	"\x19WORKLOAD_SORT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16WORKLOAD_SORT_OPEN_PRS\x10\x01\x12\x1d\n" +
+	"\x19WORKLOAD_SORT_OLDEST_OPEN\x10\x02\x12$\n" +
+	" WORKLOAD_SORT_RECENT_ASSIGNMENTS\x10\x03\x12\x1a\n" +
+	"\x16WORKLOAD_SORT_USERNAME\x10\x04*z\n" +
+	"\rStatsInterval\x12\x1e\n" +
+	"\x1aSTATS_INTERVAL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12STATS_INTERVAL_DAY\x10\x01\x12\x17\n" +
+	"\x13STATS_INTERVAL_WEEK\x10\x02\x12\x18\n" +
+	"\x14STATS_INTERVAL_MONTH\x10\x032\xf8\x05\n" +
+	"\fStatsService\x12G\n" +
+	"\fGetUserStats\x12\x1a.pr.v1.GetUserStatsRequest\x1a\x1b.pr.v1.GetUserStatsResponse\x12Q\n" +
+	"\x13GetPullRequestStats\x12!.pr.v1.GetPullRequestStatsRequest\x1a\x17.pr.v1.PullRequestStats\x12\\\n" +
+	"\x13GetReviewerWorkload\x12!.pr.v1.GetReviewerWorkloadRequest\x1a\".pr.v1.GetReviewerWorkloadResponse\x12G\n" +
+	"\fGetTeamStats\x12\x1a.pr.v1.GetTeamStatsRequest\x1a\x1b.pr.v1.GetTeamStatsResponse\x12S\n" +
+	"\x10GetReviewerPairs\x12\x1e.pr.v1.GetReviewerPairsRequest\x1a\x1f.pr.v1.GetReviewerPairsResponse\x12V\n" +
+	"\x11GetFairnessReport\x12\x1f.pr.v1.GetFairnessReportRequest\x1a .pr.v1.GetFairnessReportResponse\x12K\n" +
+	"\x11GetCycleTimeStats\x12\x1f.pr.v1.GetCycleTimeStatsRequest\x1a\x15.pr.v1.CycleTimeStats\x12J\n" +
+	"\rGetTimeSeries\x12\x1b.pr.v1.GetTimeSeriesRequest\x1a\x1c.pr.v1.GetTimeSeriesResponse\x12_\n" +
+	"\x14GetStalePullRequests\x12\".pr.v1.GetStalePullRequestsRequest\x1a#.pr.v1.GetStalePullRequestsResponseBOZMgithub.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/api/prv1;prv1b\x06proto3"
+
+var (
+	file_pr_v1_stats_proto_rawDescOnce sync.Once
+	file_pr_v1_stats_proto_rawDescData []byte
+)
+
+func file_pr_v1_stats_proto_rawDescGZIP() []byte {
+	file_pr_v1_stats_proto_rawDescOnce.Do(func() {
+		file_pr_v1_stats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pr_v1_stats_proto_rawDesc), len(file_pr_v1_stats_proto_rawDesc)))
+	})
+	return file_pr_v1_stats_proto_rawDescData
+}
+
+var file_pr_v1_stats_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pr_v1_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_pr_v1_stats_proto_goTypes = []any{
+	(WorkloadSort)(0),                    // 0: pr.v1.WorkloadSort
+	(StatsInterval)(0),                   // 1: pr.v1.StatsInterval
+	(*StatsFilter)(nil),                  // 2: pr.v1.StatsFilter
+	(*UserAssignmentStats)(nil),          // 3: pr.v1.UserAssignmentStats
+	(*GetUserStatsRequest)(nil),          // 4: pr.v1.GetUserStatsRequest
+	(*GetUserStatsResponse)(nil),         // 5: pr.v1.GetUserStatsResponse
+	(*GetPullRequestStatsRequest)(nil),   // 6: pr.v1.GetPullRequestStatsRequest
+	(*PullRequestStats)(nil),             // 7: pr.v1.PullRequestStats
+	(*ReviewerWorkload)(nil),             // 8: pr.v1.ReviewerWorkload
+	(*GetReviewerWorkloadRequest)(nil),   // 9: pr.v1.GetReviewerWorkloadRequest
+	(*GetReviewerWorkloadResponse)(nil),  // 10: pr.v1.GetReviewerWorkloadResponse
+	(*TeamRollupStats)(nil),              // 11: pr.v1.TeamRollupStats
+	(*GetTeamStatsRequest)(nil),          // 12: pr.v1.GetTeamStatsRequest
+	(*GetTeamStatsResponse)(nil),         // 13: pr.v1.GetTeamStatsResponse
+	(*ReviewerPair)(nil),                 // 14: pr.v1.ReviewerPair
+	(*GetReviewerPairsRequest)(nil),      // 15: pr.v1.GetReviewerPairsRequest
+	(*GetReviewerPairsResponse)(nil),     // 16: pr.v1.GetReviewerPairsResponse
+	(*TeamFairness)(nil),                 // 17: pr.v1.TeamFairness
+	(*GetFairnessReportRequest)(nil),     // 18: pr.v1.GetFairnessReportRequest
+	(*GetFairnessReportResponse)(nil),    // 19: pr.v1.GetFairnessReportResponse
+	(*CycleTimeSummary)(nil),             // 20: pr.v1.CycleTimeSummary
+	(*TeamCycleTime)(nil),                // 21: pr.v1.TeamCycleTime
+	(*AuthorCycleTime)(nil),              // 22: pr.v1.AuthorCycleTime
+	(*CycleTimeBucket)(nil),              // 23: pr.v1.CycleTimeBucket
+	(*GetCycleTimeStatsRequest)(nil),     // 24: pr.v1.GetCycleTimeStatsRequest
+	(*CycleTimeStats)(nil),               // 25: pr.v1.CycleTimeStats
+	(*TimeSeriesPoint)(nil),              // 26: pr.v1.TimeSeriesPoint
+	(*GetTimeSeriesRequest)(nil),         // 27: pr.v1.GetTimeSeriesRequest
+	(*GetTimeSeriesResponse)(nil),        // 28: pr.v1.GetTimeSeriesResponse
+	(*StalePullRequest)(nil),             // 29: pr.v1.StalePullRequest
+	(*GetStalePullRequestsRequest)(nil),  // 30: pr.v1.GetStalePullRequestsRequest
+	(*GetStalePullRequestsResponse)(nil), // 31: pr.v1.GetStalePullRequestsResponse
+	(*timestamppb.Timestamp)(nil),        // 32: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 33: google.protobuf.Duration
+}
+var file_pr_v1_stats_proto_depIdxs = []int32{
+	32, // 0: pr.v1.StatsFilter.from:type_name -> google.protobuf.Timestamp
+	32, // 1: pr.v1.StatsFilter.to:type_name -> google.protobuf.Timestamp
+	33, // 2: pr.v1.UserAssignmentStats.mean_time_in_review:type_name -> google.protobuf.Duration
+	2,  // 3: pr.v1.GetUserStatsRequest.filter:type_name -> pr.v1.StatsFilter
+	3,  // 4: pr.v1.GetUserStatsResponse.users:type_name -> pr.v1.UserAssignmentStats
+	2,  // 5: pr.v1.GetPullRequestStatsRequest.filter:type_name -> pr.v1.StatsFilter
+	33, // 6: pr.v1.ReviewerWorkload.oldest_open_age:type_name -> google.protobuf.Duration
+	2,  // 7: pr.v1.GetReviewerWorkloadRequest.filter:type_name -> pr.v1.StatsFilter
+	0,  // 8: pr.v1.GetReviewerWorkloadRequest.sort:type_name -> pr.v1.WorkloadSort
+	8,  // 9: pr.v1.GetReviewerWorkloadResponse.reviewers:type_name -> pr.v1.ReviewerWorkload
+	2,  // 10: pr.v1.GetTeamStatsRequest.filter:type_name -> pr.v1.StatsFilter
+	11, // 11: pr.v1.GetTeamStatsResponse.teams:type_name -> pr.v1.TeamRollupStats
+	2,  // 12: pr.v1.GetReviewerPairsRequest.filter:type_name -> pr.v1.StatsFilter
+	14, // 13: pr.v1.GetReviewerPairsResponse.pairs:type_name -> pr.v1.ReviewerPair
+	3,  // 14: pr.v1.TeamFairness.overloaded_members:type_name -> pr.v1.UserAssignmentStats
+	2,  // 15: pr.v1.GetFairnessReportRequest.filter:type_name -> pr.v1.StatsFilter
+	17, // 16: pr.v1.GetFairnessReportResponse.teams:type_name -> pr.v1.TeamFairness
+	33, // 17: pr.v1.CycleTimeSummary.mean:type_name -> google.protobuf.Duration
+	33, // 18: pr.v1.CycleTimeSummary.median:type_name -> google.protobuf.Duration
+	33, // 19: pr.v1.CycleTimeSummary.p90:type_name -> google.protobuf.Duration
+	33, // 20: pr.v1.CycleTimeSummary.p99:type_name -> google.protobuf.Duration
+	20, // 21: pr.v1.TeamCycleTime.summary:type_name -> pr.v1.CycleTimeSummary
+	20, // 22: pr.v1.AuthorCycleTime.summary:type_name -> pr.v1.CycleTimeSummary
+	33, // 23: pr.v1.CycleTimeBucket.from:type_name -> google.protobuf.Duration
+	33, // 24: pr.v1.CycleTimeBucket.to:type_name -> google.protobuf.Duration
+	2,  // 25: pr.v1.GetCycleTimeStatsRequest.filter:type_name -> pr.v1.StatsFilter
+	33, // 26: pr.v1.GetCycleTimeStatsRequest.buckets:type_name -> google.protobuf.Duration
+	21, // 27: pr.v1.CycleTimeStats.teams:type_name -> pr.v1.TeamCycleTime
+	22, // 28: pr.v1.CycleTimeStats.authors:type_name -> pr.v1.AuthorCycleTime
+	23, // 29: pr.v1.CycleTimeStats.histogram:type_name -> pr.v1.CycleTimeBucket
+	32, // 30: pr.v1.TimeSeriesPoint.bucket:type_name -> google.protobuf.Timestamp
+	2,  // 31: pr.v1.GetTimeSeriesRequest.filter:type_name -> pr.v1.StatsFilter
+	1,  // 32: pr.v1.GetTimeSeriesRequest.interval:type_name -> pr.v1.StatsInterval
+	1,  // 33: pr.v1.GetTimeSeriesResponse.interval:type_name -> pr.v1.StatsInterval
+	26, // 34: pr.v1.GetTimeSeriesResponse.points:type_name -> pr.v1.TimeSeriesPoint
+	32, // 35: pr.v1.StalePullRequest.created_at:type_name -> google.protobuf.Timestamp
+	33, // 36: pr.v1.StalePullRequest.age:type_name -> google.protobuf.Duration
+	33, // 37: pr.v1.StalePullRequest.threshold:type_name -> google.protobuf.Duration
+	32, // 38: pr.v1.StalePullRequest.flagged_at:type_name -> google.protobuf.Timestamp
+	2,  // 39: pr.v1.GetStalePullRequestsRequest.filter:type_name -> pr.v1.StatsFilter
+	33, // 40: pr.v1.GetStalePullRequestsRequest.older_than:type_name -> google.protobuf.Duration
+	29, // 41: pr.v1.GetStalePullRequestsResponse.pull_requests:type_name -> pr.v1.StalePullRequest
+	4,  // 42: pr.v1.StatsService.GetUserStats:input_type -> pr.v1.GetUserStatsRequest
+	6,  // 43: pr.v1.StatsService.GetPullRequestStats:input_type -> pr.v1.GetPullRequestStatsRequest
+	9,  // 44: pr.v1.StatsService.GetReviewerWorkload:input_type -> pr.v1.GetReviewerWorkloadRequest
+	12, // 45: pr.v1.StatsService.GetTeamStats:input_type -> pr.v1.GetTeamStatsRequest
+	15, // 46: pr.v1.StatsService.GetReviewerPairs:input_type -> pr.v1.GetReviewerPairsRequest
+	18, // 47: pr.v1.StatsService.GetFairnessReport:input_type -> pr.v1.GetFairnessReportRequest
+	24, // 48: pr.v1.StatsService.GetCycleTimeStats:input_type -> pr.v1.GetCycleTimeStatsRequest
+	27, // 49: pr.v1.StatsService.GetTimeSeries:input_type -> pr.v1.GetTimeSeriesRequest
+	30, // 50: pr.v1.StatsService.GetStalePullRequests:input_type -> pr.v1.GetStalePullRequestsRequest
+	5,  // 51: pr.v1.StatsService.GetUserStats:output_type -> pr.v1.GetUserStatsResponse
+	7,  // 52: pr.v1.StatsService.GetPullRequestStats:output_type -> pr.v1.PullRequestStats
+	10, // 53: pr.v1.StatsService.GetReviewerWorkload:output_type -> pr.v1.GetReviewerWorkloadResponse
+	13, // 54: pr.v1.StatsService.GetTeamStats:output_type -> pr.v1.GetTeamStatsResponse
+	16, // 55: pr.v1.StatsService.GetReviewerPairs:output_type -> pr.v1.GetReviewerPairsResponse
+	19, // 56: pr.v1.StatsService.GetFairnessReport:output_type -> pr.v1.GetFairnessReportResponse
+	25, // 57: pr.v1.StatsService.GetCycleTimeStats:output_type -> pr.v1.CycleTimeStats
+	28, // 58: pr.v1.StatsService.GetTimeSeries:output_type -> pr.v1.GetTimeSeriesResponse
+	31, // 59: pr.v1.StatsService.GetStalePullRequests:output_type -> pr.v1.GetStalePullRequestsResponse
+	51, // [51:60] is the sub-list for method output_type
+	42, // [42:51] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_pr_v1_stats_proto_init() }
+func file_pr_v1_stats_proto_init() {
+	if File_pr_v1_stats_proto != nil {
+		return
+	}
+	file_pr_v1_stats_proto_msgTypes[16].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pr_v1_stats_proto_rawDesc), len(file_pr_v1_stats_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pr_v1_stats_proto_goTypes,
+		DependencyIndexes: file_pr_v1_stats_proto_depIdxs,
+		EnumInfos:         file_pr_v1_stats_proto_enumTypes,
+		MessageInfos:      file_pr_v1_stats_proto_msgTypes,
+	}.Build()
+	File_pr_v1_stats_proto = out.File
+	file_pr_v1_stats_proto_goTypes = nil
+	file_pr_v1_stats_proto_depIdxs = nil
+}
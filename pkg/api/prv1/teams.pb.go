@@ -0,0 +1,852 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: pr/v1/teams.proto
+
+package prv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TeamRole int32
+
+const (
+	TeamRole_TEAM_ROLE_UNSPECIFIED TeamRole = 0
+	TeamRole_TEAM_ROLE_LEAD        TeamRole = 1
+	TeamRole_TEAM_ROLE_MEMBER      TeamRole = 2
+	TeamRole_TEAM_ROLE_OBSERVER    TeamRole = 3
+)
+
+// Enum value maps for TeamRole.
+var (
+	TeamRole_name = map[int32]string{
+		0: "TEAM_ROLE_UNSPECIFIED",
+		1: "TEAM_ROLE_LEAD",
+		2: "TEAM_ROLE_MEMBER",
+		3: "TEAM_ROLE_OBSERVER",
+	}
+	TeamRole_value = map[string]int32{
+		"TEAM_ROLE_UNSPECIFIED": 0,
+		"TEAM_ROLE_LEAD":        1,
+		"TEAM_ROLE_MEMBER":      2,
+		"TEAM_ROLE_OBSERVER":    3,
+	}
+)
+
+func (x TeamRole) Enum() *TeamRole {
+	p := new(TeamRole)
+	*p = x
+	return p
+}
+
+func (x TeamRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TeamRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_pr_v1_teams_proto_enumTypes[0].Descriptor()
+}
+
+func (TeamRole) Type() protoreflect.EnumType {
+	return &file_pr_v1_teams_proto_enumTypes[0]
+}
+
+func (x TeamRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TeamRole.Descriptor instead.
+func (TeamRole) EnumDescriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{0}
+}
+
+type TeamMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Unspecified means member.
+	Role          TeamRole `protobuf:"varint,4,opt,name=role,proto3,enum=pr.v1.TeamRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_pr_v1_teams_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TeamMember) GetRole() TeamRole {
+	if x != nil {
+		return x.Role
+	}
+	return TeamRole_TEAM_ROLE_UNSPECIFIED
+}
+
+type TeamSettings struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RequireLeadReview bool                   `protobuf:"varint,1,opt,name=require_lead_review,json=requireLeadReview,proto3" json:"require_lead_review,omitempty"`
+	// Age after which an open PR of the team is stale; unset or zero means the
+	// service default.
+	StaleAfter    *durationpb.Duration `protobuf:"bytes,2,opt,name=stale_after,json=staleAfter,proto3" json:"stale_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamSettings) Reset() {
+	*x = TeamSettings{}
+	mi := &file_pr_v1_teams_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSettings) ProtoMessage() {}
+
+func (x *TeamSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSettings.ProtoReflect.Descriptor instead.
+func (*TeamSettings) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TeamSettings) GetRequireLeadReview() bool {
+	if x != nil {
+		return x.RequireLeadReview
+	}
+	return false
+}
+
+func (x *TeamSettings) GetStaleAfter() *durationpb.Duration {
+	if x != nil {
+		return x.StaleAfter
+	}
+	return nil
+}
+
+type Team struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TeamName       string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ParentTeamName string                 `protobuf:"bytes,2,opt,name=parent_team_name,json=parentTeamName,proto3" json:"parent_team_name,omitempty"`
+	Settings       *TeamSettings          `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	Members        []*TeamMember          `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_pr_v1_teams_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetParentTeamName() string {
+	if x != nil {
+		return x.ParentTeamName
+	}
+	return ""
+}
+
+func (x *Team) GetSettings() *TeamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type CreateTeamRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TeamName       string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ParentTeamName string                 `protobuf:"bytes,2,opt,name=parent_team_name,json=parentTeamName,proto3" json:"parent_team_name,omitempty"`
+	Settings       *TeamSettings          `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	Members        []*TeamMember          `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_pr_v1_teams_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetParentTeamName() string {
+	if x != nil {
+		return x.ParentTeamName
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetSettings() *TeamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *CreateTeamRequest) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_pr_v1_teams_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootTeamName  string                 `protobuf:"bytes,1,opt,name=root_team_name,json=rootTeamName,proto3" json:"root_team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamTreeRequest) Reset() {
+	*x = GetTeamTreeRequest{}
+	mi := &file_pr_v1_teams_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamTreeRequest) ProtoMessage() {}
+
+func (x *GetTeamTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamTreeRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTeamTreeRequest) GetRootTeamName() string {
+	if x != nil {
+		return x.RootTeamName
+	}
+	return ""
+}
+
+type GetTeamTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamTreeNode        `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamTreeResponse) Reset() {
+	*x = GetTeamTreeResponse{}
+	mi := &file_pr_v1_teams_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamTreeResponse) ProtoMessage() {}
+
+func (x *GetTeamTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamTreeResponse) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTeamTreeResponse) GetTeams() []*TeamTreeNode {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type TeamTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Children      []*TeamTreeNode        `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamTreeNode) Reset() {
+	*x = TeamTreeNode{}
+	mi := &file_pr_v1_teams_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamTreeNode) ProtoMessage() {}
+
+func (x *TeamTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamTreeNode.ProtoReflect.Descriptor instead.
+func (*TeamTreeNode) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TeamTreeNode) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamTreeNode) GetChildren() []*TeamTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type SetParentTeamRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Empty detaches the team from its parent.
+	ParentTeamName string `protobuf:"bytes,2,opt,name=parent_team_name,json=parentTeamName,proto3" json:"parent_team_name,omitempty"`
+	ActorId        string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetParentTeamRequest) Reset() {
+	*x = SetParentTeamRequest{}
+	mi := &file_pr_v1_teams_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParentTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParentTeamRequest) ProtoMessage() {}
+
+func (x *SetParentTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParentTeamRequest.ProtoReflect.Descriptor instead.
+func (*SetParentTeamRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetParentTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetParentTeamRequest) GetParentTeamName() string {
+	if x != nil {
+		return x.ParentTeamName
+	}
+	return ""
+}
+
+func (x *SetParentTeamRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type AddTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          TeamRole               `protobuf:"varint,3,opt,name=role,proto3,enum=pr.v1.TeamRole" json:"role,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMemberRequest) Reset() {
+	*x = AddTeamMemberRequest{}
+	mi := &file_pr_v1_teams_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMemberRequest) ProtoMessage() {}
+
+func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddTeamMemberRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AddTeamMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddTeamMemberRequest) GetRole() TeamRole {
+	if x != nil {
+		return x.Role
+	}
+	return TeamRole_TEAM_ROLE_UNSPECIFIED
+}
+
+func (x *AddTeamMemberRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type UpdateTeamSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Settings      *TeamSettings          `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamSettingsRequest) Reset() {
+	*x = UpdateTeamSettingsRequest{}
+	mi := &file_pr_v1_teams_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateTeamSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_teams_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_teams_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTeamSettingsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *UpdateTeamSettingsRequest) GetSettings() *TeamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateTeamSettingsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+var File_pr_v1_teams_proto protoreflect.FileDescriptor
+
+const file_pr_v1_teams_proto_rawDesc = "" +
+	"\n" +
+	"\x11pr/v1/teams.proto\x12\x05pr.v1\x1a\x1egoogle/protobuf/duration.proto\"\x83\x01\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12#\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x0f.pr.v1.TeamRoleR\x04role\"z\n" +
+	"\fTeamSettings\x12.\n" +
+	"\x13require_lead_review\x18\x01 \x01(\bR\x11requireLeadReview\x12:\n" +
+	"\vstale_after\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"staleAfter\"\xab\x01\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12(\n" +
+	"\x10parent_team_name\x18\x02 \x01(\tR\x0eparentTeamName\x12/\n" +
+	"\bsettings\x18\x03 \x01(\v2\x13.pr.v1.TeamSettingsR\bsettings\x12+\n" +
+	"\amembers\x18\x04 \x03(\v2\x11.pr.v1.TeamMemberR\amembers\"\xb8\x01\n" +
+	"\x11CreateTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12(\n" +
+	"\x10parent_team_name\x18\x02 \x01(\tR\x0eparentTeamName\x12/\n" +
+	"\bsettings\x18\x03 \x01(\v2\x13.pr.v1.TeamSettingsR\bsettings\x12+\n" +
+	"\amembers\x18\x04 \x03(\v2\x11.pr.v1.TeamMemberR\amembers\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\":\n" +
+	"\x12GetTeamTreeRequest\x12$\n" +
+	"\x0eroot_team_name\x18\x01 \x01(\tR\frootTeamName\"@\n" +
+	"\x13GetTeamTreeResponse\x12)\n" +
+	"\x05teams\x18\x01 \x03(\v2\x13.pr.v1.TeamTreeNodeR\x05teams\"\\\n" +
+	"\fTeamTreeNode\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\bchildren\x18\x02 \x03(\v2\x13.pr.v1.TeamTreeNodeR\bchildren\"x\n" +
+	"\x14SetParentTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12(\n" +
+	"\x10parent_team_name\x18\x02 \x01(\tR\x0eparentTeamName\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\"\x8c\x01\n" +
+	"\x14AddTeamMemberRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12#\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x0f.pr.v1.TeamRoleR\x04role\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\"\x84\x01\n" +
+	"\x19UpdateTeamSettingsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\bsettings\x18\x02 \x01(\v2\x13.pr.v1.TeamSettingsR\bsettings\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId*g\n" +
+	"\bTeamRole\x12\x19\n" +
+	"\x15TEAM_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eTEAM_ROLE_LEAD\x10\x01\x12\x14\n" +
+	"\x10TEAM_ROLE_MEMBER\x10\x02\x12\x16\n" +
+	"\x12TEAM_ROLE_OBSERVER\x10\x032\xf2\x02\n" +
+	"\vTeamService\x123\n" +
+	"\n" +
+	"CreateTeam\x12\x18.pr.v1.CreateTeamRequest\x1a\v.pr.v1.Team\x12-\n" +
+	"\aGetTeam\x12\x15.pr.v1.GetTeamRequest\x1a\v.pr.v1.Team\x12D\n" +
+	"\vGetTeamTree\x12\x19.pr.v1.GetTeamTreeRequest\x1a\x1a.pr.v1.GetTeamTreeResponse\x129\n" +
+	"\rSetParentTeam\x12\x1b.pr.v1.SetParentTeamRequest\x1a\v.pr.v1.Team\x129\n" +
+	"\rAddTeamMember\x12\x1b.pr.v1.AddTeamMemberRequest\x1a\v.pr.v1.Team\x12C\n" +
+	"\x12UpdateTeamSettings\x12 .pr.v1.UpdateTeamSettingsRequest\x1a\v.pr.v1.TeamBOZMgithub.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/api/prv1;prv1b\x06proto3"
+
+var (
+	file_pr_v1_teams_proto_rawDescOnce sync.Once
+	file_pr_v1_teams_proto_rawDescData []byte
+)
+
+func file_pr_v1_teams_proto_rawDescGZIP() []byte {
+	file_pr_v1_teams_proto_rawDescOnce.Do(func() {
+		file_pr_v1_teams_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pr_v1_teams_proto_rawDesc), len(file_pr_v1_teams_proto_rawDesc)))
+	})
+	return file_pr_v1_teams_proto_rawDescData
+}
+
+var file_pr_v1_teams_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pr_v1_teams_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_pr_v1_teams_proto_goTypes = []any{
+	(TeamRole)(0),                     // 0: pr.v1.TeamRole
+	(*TeamMember)(nil),                // 1: pr.v1.TeamMember
+	(*TeamSettings)(nil),              // 2: pr.v1.TeamSettings
+	(*Team)(nil),                      // 3: pr.v1.Team
+	(*CreateTeamRequest)(nil),         // 4: pr.v1.CreateTeamRequest
+	(*GetTeamRequest)(nil),            // 5: pr.v1.GetTeamRequest
+	(*GetTeamTreeRequest)(nil),        // 6: pr.v1.GetTeamTreeRequest
+	(*GetTeamTreeResponse)(nil),       // 7: pr.v1.GetTeamTreeResponse
+	(*TeamTreeNode)(nil),              // 8: pr.v1.TeamTreeNode
+	(*SetParentTeamRequest)(nil),      // 9: pr.v1.SetParentTeamRequest
+	(*AddTeamMemberRequest)(nil),      // 10: pr.v1.AddTeamMemberRequest
+	(*UpdateTeamSettingsRequest)(nil), // 11: pr.v1.UpdateTeamSettingsRequest
+	(*durationpb.Duration)(nil),       // 12: google.protobuf.Duration
+}
+var file_pr_v1_teams_proto_depIdxs = []int32{
+	0,  // 0: pr.v1.TeamMember.role:type_name -> pr.v1.TeamRole
+	12, // 1: pr.v1.TeamSettings.stale_after:type_name -> google.protobuf.Duration
+	2,  // 2: pr.v1.Team.settings:type_name -> pr.v1.TeamSettings
+	1,  // 3: pr.v1.Team.members:type_name -> pr.v1.TeamMember
+	2,  // 4: pr.v1.CreateTeamRequest.settings:type_name -> pr.v1.TeamSettings
+	1,  // 5: pr.v1.CreateTeamRequest.members:type_name -> pr.v1.TeamMember
+	8,  // 6: pr.v1.GetTeamTreeResponse.teams:type_name -> pr.v1.TeamTreeNode
+	8,  // 7: pr.v1.TeamTreeNode.children:type_name -> pr.v1.TeamTreeNode
+	0,  // 8: pr.v1.AddTeamMemberRequest.role:type_name -> pr.v1.TeamRole
+	2,  // 9: pr.v1.UpdateTeamSettingsRequest.settings:type_name -> pr.v1.TeamSettings
+	4,  // 10: pr.v1.TeamService.CreateTeam:input_type -> pr.v1.CreateTeamRequest
+	5,  // 11: pr.v1.TeamService.GetTeam:input_type -> pr.v1.GetTeamRequest
+	6,  // 12: pr.v1.TeamService.GetTeamTree:input_type -> pr.v1.GetTeamTreeRequest
+	9,  // 13: pr.v1.TeamService.SetParentTeam:input_type -> pr.v1.SetParentTeamRequest
+	10, // 14: pr.v1.TeamService.AddTeamMember:input_type -> pr.v1.AddTeamMemberRequest
+	11, // 15: pr.v1.TeamService.UpdateTeamSettings:input_type -> pr.v1.UpdateTeamSettingsRequest
+	3,  // 16: pr.v1.TeamService.CreateTeam:output_type -> pr.v1.Team
+	3,  // 17: pr.v1.TeamService.GetTeam:output_type -> pr.v1.Team
+	7,  // 18: pr.v1.TeamService.GetTeamTree:output_type -> pr.v1.GetTeamTreeResponse
+	3,  // 19: pr.v1.TeamService.SetParentTeam:output_type -> pr.v1.Team
+	3,  // 20: pr.v1.TeamService.AddTeamMember:output_type -> pr.v1.Team
+	3,  // 21: pr.v1.TeamService.UpdateTeamSettings:output_type -> pr.v1.Team
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_pr_v1_teams_proto_init() }
+func file_pr_v1_teams_proto_init() {
+	if File_pr_v1_teams_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pr_v1_teams_proto_rawDesc), len(file_pr_v1_teams_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pr_v1_teams_proto_goTypes,
+		DependencyIndexes: file_pr_v1_teams_proto_depIdxs,
+		EnumInfos:         file_pr_v1_teams_proto_enumTypes,
+		MessageInfos:      file_pr_v1_teams_proto_msgTypes,
+	}.Build()
+	File_pr_v1_teams_proto = out.File
+	file_pr_v1_teams_proto_goTypes = nil
+	file_pr_v1_teams_proto_depIdxs = nil
+}
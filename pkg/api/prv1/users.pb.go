@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: pr/v1/users.proto
+
+package prv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_pr_v1_users_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_users_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_pr_v1_users_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UserProfile struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	User                     *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Teams                    []string               `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	OpenReviewsCount         int64                  `protobuf:"varint,3,opt,name=open_reviews_count,json=openReviewsCount,proto3" json:"open_reviews_count,omitempty"`
+	AuthoredOpenPullRequests []*PullRequestShort    `protobuf:"bytes,4,rep,name=authored_open_pull_requests,json=authoredOpenPullRequests,proto3" json:"authored_open_pull_requests,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_pr_v1_users_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_users_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_pr_v1_users_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserProfile) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserProfile) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *UserProfile) GetOpenReviewsCount() int64 {
+	if x != nil {
+		return x.OpenReviewsCount
+	}
+	return 0
+}
+
+func (x *UserProfile) GetAuthoredOpenPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.AuthoredOpenPullRequests
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_pr_v1_users_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_users_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_users_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      *string                `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_pr_v1_users_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_users_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_users_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type GetReviewerPullRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerPullRequestsRequest) Reset() {
+	*x = GetReviewerPullRequestsRequest{}
+	mi := &file_pr_v1_users_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerPullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerPullRequestsRequest) ProtoMessage() {}
+
+func (x *GetReviewerPullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_users_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerPullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewerPullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_v1_users_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetReviewerPullRequestsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetReviewerPullRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerPullRequestsResponse) Reset() {
+	*x = GetReviewerPullRequestsResponse{}
+	mi := &file_pr_v1_users_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerPullRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerPullRequestsResponse) ProtoMessage() {}
+
+func (x *GetReviewerPullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_v1_users_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerPullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewerPullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_v1_users_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetReviewerPullRequestsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReviewerPullRequestsResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+var File_pr_v1_users_proto protoreflect.FileDescriptor
+
+const file_pr_v1_users_proto_rawDesc = "" +
+	"\n" +
+	"\x11pr/v1/users.proto\x12\x05pr.v1\x1a\x19pr/v1/pull_requests.proto\"u\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\"\xca\x01\n" +
+	"\vUserProfile\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.pr.v1.UserR\x04user\x12\x14\n" +
+	"\x05teams\x18\x02 \x03(\tR\x05teams\x12,\n" +
+	"\x12open_reviews_count\x18\x03 \x01(\x03R\x10openReviewsCount\x12V\n" +
+	"\x1bauthored_open_pull_requests\x18\x04 \x03(\v2\x17.pr.v1.PullRequestShortR\x18authoredOpenPullRequests\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x8a\x01\n" +
+	"\x11UpdateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\busername\x18\x02 \x01(\tH\x00R\busername\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x03 \x01(\bH\x01R\bisActive\x88\x01\x01B\v\n" +
+	"\t_usernameB\f\n" +
+	"\n" +
+	"_is_active\"9\n" +
+	"\x1eGetReviewerPullRequestsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"x\n" +
+	"\x1fGetReviewerPullRequestsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12<\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x17.pr.v1.PullRequestShortR\fpullRequests2\xe2\x01\n" +
+	"\vUserService\x124\n" +
+	"\aGetUser\x12\x15.pr.v1.GetUserRequest\x1a\x12.pr.v1.UserProfile\x123\n" +
+	"\n" +
+	"UpdateUser\x12\x18.pr.v1.UpdateUserRequest\x1a\v.pr.v1.User\x12h\n" +
+	"\x17GetReviewerPullRequests\x12%.pr.v1.GetReviewerPullRequestsRequest\x1a&.pr.v1.GetReviewerPullRequestsResponseBOZMgithub.com/NutsBalls/Backend-trainee-assignment-autumn-2025/pkg/api/prv1;prv1b\x06proto3"
+
+var (
+	file_pr_v1_users_proto_rawDescOnce sync.Once
+	file_pr_v1_users_proto_rawDescData []byte
+)
+
+func file_pr_v1_users_proto_rawDescGZIP() []byte {
+	file_pr_v1_users_proto_rawDescOnce.Do(func() {
+		file_pr_v1_users_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pr_v1_users_proto_rawDesc), len(file_pr_v1_users_proto_rawDesc)))
+	})
+	return file_pr_v1_users_proto_rawDescData
+}
+
+var file_pr_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pr_v1_users_proto_goTypes = []any{
+	(*User)(nil),                            // 0: pr.v1.User
+	(*UserProfile)(nil),                     // 1: pr.v1.UserProfile
+	(*GetUserRequest)(nil),                  // 2: pr.v1.GetUserRequest
+	(*UpdateUserRequest)(nil),               // 3: pr.v1.UpdateUserRequest
+	(*GetReviewerPullRequestsRequest)(nil),  // 4: pr.v1.GetReviewerPullRequestsRequest
+	(*GetReviewerPullRequestsResponse)(nil), // 5: pr.v1.GetReviewerPullRequestsResponse
+	(*PullRequestShort)(nil),                // 6: pr.v1.PullRequestShort
+}
+var file_pr_v1_users_proto_depIdxs = []int32{
+	0, // 0: pr.v1.UserProfile.user:type_name -> pr.v1.User
+	6, // 1: pr.v1.UserProfile.authored_open_pull_requests:type_name -> pr.v1.PullRequestShort
+	6, // 2: pr.v1.GetReviewerPullRequestsResponse.pull_requests:type_name -> pr.v1.PullRequestShort
+	2, // 3: pr.v1.UserService.GetUser:input_type -> pr.v1.GetUserRequest
+	3, // 4: pr.v1.UserService.UpdateUser:input_type -> pr.v1.UpdateUserRequest
+	4, // 5: pr.v1.UserService.GetReviewerPullRequests:input_type -> pr.v1.GetReviewerPullRequestsRequest
+	1, // 6: pr.v1.UserService.GetUser:output_type -> pr.v1.UserProfile
+	0, // 7: pr.v1.UserService.UpdateUser:output_type -> pr.v1.User
+	5, // 8: pr.v1.UserService.GetReviewerPullRequests:output_type -> pr.v1.GetReviewerPullRequestsResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_pr_v1_users_proto_init() }
+func file_pr_v1_users_proto_init() {
+	if File_pr_v1_users_proto != nil {
+		return
+	}
+	file_pr_v1_pull_requests_proto_init()
+	file_pr_v1_users_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pr_v1_users_proto_rawDesc), len(file_pr_v1_users_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pr_v1_users_proto_goTypes,
+		DependencyIndexes: file_pr_v1_users_proto_depIdxs,
+		MessageInfos:      file_pr_v1_users_proto_msgTypes,
+	}.Build()
+	File_pr_v1_users_proto = out.File
+	file_pr_v1_users_proto_goTypes = nil
+	file_pr_v1_users_proto_depIdxs = nil
+}
@@ -3,13 +3,30 @@ package config
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Port  string
-	DBURL string
+	Port     string
+	GRPCPort string
+	DBURL    string
+
+	// StalePRAge is the default age after which an open PR is stale;
+	// zero leaves the service default.
+	StalePRAge time.Duration
+	// StalePRCheckInterval enables the background job that flags stale
+	// PRs; zero disables it.
+	StalePRCheckInterval time.Duration
+
+	// IdempotencyKeyTTL is how long responses to requests with an
+	// Idempotency-Key are replayed; zero leaves the service default.
+	IdempotencyKeyTTL time.Duration
+
+	// EventsPollInterval is how often new events are read from the event
+	// log for /events streams; zero leaves the default.
+	EventsPollInterval time.Duration
 }
 
 func Load() *Config {
@@ -23,13 +40,36 @@ func Load() *Config {
 		port = "8080"
 	}
 
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9090"
+	}
+
 	dbURL := os.Getenv("DB_CONN")
 	if dbURL == "" {
 		log.Fatal("DATABASE_URL environment variable is required")
 	}
 
 	return &Config{
-		Port:  port,
-		DBURL: dbURL,
+		Port:                 port,
+		GRPCPort:             grpcPort,
+		DBURL:                dbURL,
+		StalePRAge:           durationEnv("STALE_PR_AGE"),
+		StalePRCheckInterval: durationEnv("STALE_PR_CHECK_INTERVAL"),
+		IdempotencyKeyTTL:    durationEnv("IDEMPOTENCY_KEY_TTL"),
+		EventsPollInterval:   durationEnv("EVENTS_POLL_INTERVAL"),
+	}
+}
+
+func durationEnv(key string) time.Duration {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return 0
+	}
+
+	d, err := time.ParseDuration(raw)
+	if err != nil || d < 0 {
+		log.Fatalf("%s must be a non-negative duration such as 72h, got %q", key, raw)
 	}
+	return d
 }